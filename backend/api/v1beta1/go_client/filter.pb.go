@@ -59,20 +59,26 @@ const (
 	// Checks if the value contains |string_value| as a substring match. Only
 	// applies to |string_value|.
 	Predicate_IS_SUBSTRING Predicate_Op = 9
+	// Checks if the value is null. No value should be set.
+	Predicate_IS_NULL Predicate_Op = 10
+	// Checks if the value is not null. No value should be set.
+	Predicate_IS_NOT_NULL Predicate_Op = 11
 )
 
 // Enum value maps for Predicate_Op.
 var (
 	Predicate_Op_name = map[int32]string{
-		0: "UNKNOWN",
-		1: "EQUALS",
-		2: "NOT_EQUALS",
-		3: "GREATER_THAN",
-		5: "GREATER_THAN_EQUALS",
-		6: "LESS_THAN",
-		7: "LESS_THAN_EQUALS",
-		8: "IN",
-		9: "IS_SUBSTRING",
+		0:  "UNKNOWN",
+		1:  "EQUALS",
+		2:  "NOT_EQUALS",
+		3:  "GREATER_THAN",
+		5:  "GREATER_THAN_EQUALS",
+		6:  "LESS_THAN",
+		7:  "LESS_THAN_EQUALS",
+		8:  "IN",
+		9:  "IS_SUBSTRING",
+		10: "IS_NULL",
+		11: "IS_NOT_NULL",
 	}
 	Predicate_Op_value = map[string]int32{
 		"UNKNOWN":             0,
@@ -84,6 +90,8 @@ var (
 		"LESS_THAN_EQUALS":    7,
 		"IN":                  8,
 		"IS_SUBSTRING":        9,
+		"IS_NULL":             10,
+		"IS_NOT_NULL":         11,
 	}
 )
 
@@ -114,6 +122,62 @@ func (Predicate_Op) EnumDescriptor() ([]byte, []int) {
 	return file_backend_api_v1beta1_filter_proto_rawDescGZIP(), []int{0, 0}
 }
 
+// Op is the logical operator to apply.
+type PredicateGroup_Op int32
+
+const (
+	PredicateGroup_UNKNOWN PredicateGroup_Op = 0
+	// All of the predicates and groups must be true.
+	PredicateGroup_AND PredicateGroup_Op = 1
+	// At least one of the predicates and groups must be true.
+	PredicateGroup_OR PredicateGroup_Op = 2
+	// Not all of the predicates and groups are true, i.e. NOT (p1 AND p2 ...).
+	PredicateGroup_NOT PredicateGroup_Op = 3
+)
+
+// Enum value maps for PredicateGroup_Op.
+var (
+	PredicateGroup_Op_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "AND",
+		2: "OR",
+		3: "NOT",
+	}
+	PredicateGroup_Op_value = map[string]int32{
+		"UNKNOWN": 0,
+		"AND":     1,
+		"OR":      2,
+		"NOT":     3,
+	}
+)
+
+func (x PredicateGroup_Op) Enum() *PredicateGroup_Op {
+	p := new(PredicateGroup_Op)
+	*p = x
+	return p
+}
+
+func (x PredicateGroup_Op) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PredicateGroup_Op) Descriptor() protoreflect.EnumDescriptor {
+	return file_backend_api_v1beta1_filter_proto_enumTypes[1].Descriptor()
+}
+
+func (PredicateGroup_Op) Type() protoreflect.EnumType {
+	return &file_backend_api_v1beta1_filter_proto_enumTypes[1]
+}
+
+func (x PredicateGroup_Op) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PredicateGroup_Op.Descriptor instead.
+func (PredicateGroup_Op) EnumDescriptor() ([]byte, []int) {
+	return file_backend_api_v1beta1_filter_proto_rawDescGZIP(), []int{1, 0}
+}
+
 // Predicate captures individual conditions that must be true for a resource
 // being filtered.
 type Predicate struct {
@@ -285,6 +349,71 @@ func (*Predicate_LongValues) isPredicate_Value() {}
 
 func (*Predicate_StringValues) isPredicate_Value() {}
 
+// PredicateGroup combines predicates and nested groups with a logical
+// operator.
+type PredicateGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op         PredicateGroup_Op `protobuf:"varint,1,opt,name=op,proto3,enum=v1beta1.PredicateGroup_Op" json:"op,omitempty"`
+	Predicates []*Predicate      `protobuf:"bytes,2,rep,name=predicates,proto3" json:"predicates,omitempty"`
+	Groups     []*PredicateGroup `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *PredicateGroup) Reset() {
+	*x = PredicateGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_api_v1beta1_filter_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PredicateGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PredicateGroup) ProtoMessage() {}
+
+func (x *PredicateGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v1beta1_filter_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PredicateGroup.ProtoReflect.Descriptor instead.
+func (*PredicateGroup) Descriptor() ([]byte, []int) {
+	return file_backend_api_v1beta1_filter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PredicateGroup) GetOp() PredicateGroup_Op {
+	if x != nil {
+		return x.Op
+	}
+	return PredicateGroup_UNKNOWN
+}
+
+func (x *PredicateGroup) GetPredicates() []*Predicate {
+	if x != nil {
+		return x.Predicates
+	}
+	return nil
+}
+
+func (x *PredicateGroup) GetGroups() []*PredicateGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 type IntValues struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -296,7 +425,7 @@ type IntValues struct {
 func (x *IntValues) Reset() {
 	*x = IntValues{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_backend_api_v1beta1_filter_proto_msgTypes[1]
+		mi := &file_backend_api_v1beta1_filter_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -309,7 +438,7 @@ func (x *IntValues) String() string {
 func (*IntValues) ProtoMessage() {}
 
 func (x *IntValues) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v1beta1_filter_proto_msgTypes[1]
+	mi := &file_backend_api_v1beta1_filter_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -322,7 +451,7 @@ func (x *IntValues) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntValues.ProtoReflect.Descriptor instead.
 func (*IntValues) Descriptor() ([]byte, []int) {
-	return file_backend_api_v1beta1_filter_proto_rawDescGZIP(), []int{1}
+	return file_backend_api_v1beta1_filter_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *IntValues) GetValues() []int32 {
@@ -343,7 +472,7 @@ type StringValues struct {
 func (x *StringValues) Reset() {
 	*x = StringValues{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_backend_api_v1beta1_filter_proto_msgTypes[2]
+		mi := &file_backend_api_v1beta1_filter_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -356,7 +485,7 @@ func (x *StringValues) String() string {
 func (*StringValues) ProtoMessage() {}
 
 func (x *StringValues) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v1beta1_filter_proto_msgTypes[2]
+	mi := &file_backend_api_v1beta1_filter_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -369,7 +498,7 @@ func (x *StringValues) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringValues.ProtoReflect.Descriptor instead.
 func (*StringValues) Descriptor() ([]byte, []int) {
-	return file_backend_api_v1beta1_filter_proto_rawDescGZIP(), []int{2}
+	return file_backend_api_v1beta1_filter_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *StringValues) GetValues() []string {
@@ -390,7 +519,7 @@ type LongValues struct {
 func (x *LongValues) Reset() {
 	*x = LongValues{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_backend_api_v1beta1_filter_proto_msgTypes[3]
+		mi := &file_backend_api_v1beta1_filter_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -403,7 +532,7 @@ func (x *LongValues) String() string {
 func (*LongValues) ProtoMessage() {}
 
 func (x *LongValues) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v1beta1_filter_proto_msgTypes[3]
+	mi := &file_backend_api_v1beta1_filter_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +545,7 @@ func (x *LongValues) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LongValues.ProtoReflect.Descriptor instead.
 func (*LongValues) Descriptor() ([]byte, []int) {
-	return file_backend_api_v1beta1_filter_proto_rawDescGZIP(), []int{3}
+	return file_backend_api_v1beta1_filter_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *LongValues) GetValues() []int64 {
@@ -466,6 +595,31 @@ func (x *LongValues) GetValues() []int64 {
 //     }
 //   }
 // }
+//
+// 4) Filter runs that failed or errored, excluding those without a finish time
+//
+// filter {
+//   groups {
+//     op: OR
+//     predicates {
+//       key: "status"
+//       op: EQUALS
+//       string_value: "Failed"
+//     }
+//     predicates {
+//       key: "status"
+//       op: EQUALS
+//       string_value: "Error"
+//     }
+//   }
+//   groups {
+//     op: NOT
+//     predicates {
+//       key: "finished_at"
+//       op: IS_NULL
+//     }
+//   }
+// }
 type Filter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -473,12 +627,14 @@ type Filter struct {
 
 	// All predicates are AND-ed when this filter is applied.
 	Predicates []*Predicate `protobuf:"bytes,1,rep,name=predicates,proto3" json:"predicates,omitempty"`
+	// All groups are AND-ed with each other and with the predicates above.
+	Groups []*PredicateGroup `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
 }
 
 func (x *Filter) Reset() {
 	*x = Filter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_backend_api_v1beta1_filter_proto_msgTypes[4]
+		mi := &file_backend_api_v1beta1_filter_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -491,7 +647,7 @@ func (x *Filter) String() string {
 func (*Filter) ProtoMessage() {}
 
 func (x *Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v1beta1_filter_proto_msgTypes[4]
+	mi := &file_backend_api_v1beta1_filter_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,7 +660,7 @@ func (x *Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Filter.ProtoReflect.Descriptor instead.
 func (*Filter) Descriptor() ([]byte, []int) {
-	return file_backend_api_v1beta1_filter_proto_rawDescGZIP(), []int{4}
+	return file_backend_api_v1beta1_filter_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Filter) GetPredicates() []*Predicate {
@@ -514,6 +670,13 @@ func (x *Filter) GetPredicates() []*Predicate {
 	return nil
 }
 
+func (x *Filter) GetGroups() []*PredicateGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 var File_backend_api_v1beta1_filter_proto protoreflect.FileDescriptor
 
 var file_backend_api_v1beta1_filter_proto_rawDesc = []byte{
@@ -523,7 +686,7 @@ var file_backend_api_v1beta1_filter_proto_rawDesc = []byte{
 	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbe, 0x04, 0x0a, 0x09, 0x50,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdc, 0x04, 0x0a, 0x09, 0x50,
 	0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
 	0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x2e, 0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70, 0x12,
@@ -549,7 +712,7 @@ var file_backend_api_v1beta1_filter_proto_rawDesc = []byte{
 	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x15, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69,
 	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x02, 0x4f, 0x70, 0x12,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x02, 0x4f, 0x70, 0x12,
 	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
 	0x45, 0x51, 0x55, 0x41, 0x4c, 0x53, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x54, 0x5f,
 	0x45, 0x51, 0x55, 0x41, 0x4c, 0x53, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x52, 0x45, 0x41,
@@ -559,18 +722,36 @@ var file_backend_api_v1beta1_filter_proto_rawDesc = []byte{
 	0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f,
 	0x45, 0x51, 0x55, 0x41, 0x4c, 0x53, 0x10, 0x07, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x4e, 0x10, 0x08,
 	0x12, 0x10, 0x0a, 0x0c, 0x49, 0x53, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47,
-	0x10, 0x09, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x23, 0x0a, 0x09, 0x49,
-	0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x22, 0x26, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x24, 0x0a, 0x0a, 0x4c, 0x6f, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x3c,
-	0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x64,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76,
+	0x10, 0x09, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x53, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x0a, 0x12,
+	0x0f, 0x0a, 0x0b, 0x49, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x0b,
+	0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xce, 0x01, 0x0a, 0x0e, 0x50, 0x72,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a, 0x0a, 0x02,
+	0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x2e, 0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x64,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76,
 	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x52, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x32, 0x45, 0x0a, 0x12,
+	0x52, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x2b, 0x0a,
+	0x02, 0x4f, 0x70, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x52, 0x10,
+	0x02, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x4f, 0x54, 0x10, 0x03, 0x22, 0x23, 0x0a, 0x09, 0x49, 0x6e,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22,
+	0x26, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x24, 0x0a, 0x0a, 0x4c, 0x6f, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x6d, 0x0a,
+	0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52,
+	0x0a, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x32, 0x45, 0x0a, 0x12,
 	0x44, 0x75, 0x6d, 0x6d, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
 	0x0f, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
@@ -594,31 +775,37 @@ func file_backend_api_v1beta1_filter_proto_rawDescGZIP() []byte {
 	return file_backend_api_v1beta1_filter_proto_rawDescData
 }
 
-var file_backend_api_v1beta1_filter_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_backend_api_v1beta1_filter_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_backend_api_v1beta1_filter_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_backend_api_v1beta1_filter_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_backend_api_v1beta1_filter_proto_goTypes = []interface{}{
 	(Predicate_Op)(0),             // 0: v1beta1.Predicate.Op
-	(*Predicate)(nil),             // 1: v1beta1.Predicate
-	(*IntValues)(nil),             // 2: v1beta1.IntValues
-	(*StringValues)(nil),          // 3: v1beta1.StringValues
-	(*LongValues)(nil),            // 4: v1beta1.LongValues
-	(*Filter)(nil),                // 5: v1beta1.Filter
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(PredicateGroup_Op)(0),        // 1: v1beta1.PredicateGroup.Op
+	(*Predicate)(nil),             // 2: v1beta1.Predicate
+	(*PredicateGroup)(nil),        // 3: v1beta1.PredicateGroup
+	(*IntValues)(nil),             // 4: v1beta1.IntValues
+	(*StringValues)(nil),          // 5: v1beta1.StringValues
+	(*LongValues)(nil),            // 6: v1beta1.LongValues
+	(*Filter)(nil),                // 7: v1beta1.Filter
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
 }
 var file_backend_api_v1beta1_filter_proto_depIdxs = []int32{
-	0, // 0: v1beta1.Predicate.op:type_name -> v1beta1.Predicate.Op
-	6, // 1: v1beta1.Predicate.timestamp_value:type_name -> google.protobuf.Timestamp
-	2, // 2: v1beta1.Predicate.int_values:type_name -> v1beta1.IntValues
-	4, // 3: v1beta1.Predicate.long_values:type_name -> v1beta1.LongValues
-	3, // 4: v1beta1.Predicate.string_values:type_name -> v1beta1.StringValues
-	1, // 5: v1beta1.Filter.predicates:type_name -> v1beta1.Predicate
-	5, // 6: v1beta1.DummyFilterService.GetFilter:input_type -> v1beta1.Filter
-	5, // 7: v1beta1.DummyFilterService.GetFilter:output_type -> v1beta1.Filter
-	7, // [7:8] is the sub-list for method output_type
-	6, // [6:7] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	0,  // 0: v1beta1.Predicate.op:type_name -> v1beta1.Predicate.Op
+	8,  // 1: v1beta1.Predicate.timestamp_value:type_name -> google.protobuf.Timestamp
+	4,  // 2: v1beta1.Predicate.int_values:type_name -> v1beta1.IntValues
+	6,  // 3: v1beta1.Predicate.long_values:type_name -> v1beta1.LongValues
+	5,  // 4: v1beta1.Predicate.string_values:type_name -> v1beta1.StringValues
+	1,  // 5: v1beta1.PredicateGroup.op:type_name -> v1beta1.PredicateGroup.Op
+	2,  // 6: v1beta1.PredicateGroup.predicates:type_name -> v1beta1.Predicate
+	3,  // 7: v1beta1.PredicateGroup.groups:type_name -> v1beta1.PredicateGroup
+	2,  // 8: v1beta1.Filter.predicates:type_name -> v1beta1.Predicate
+	3,  // 9: v1beta1.Filter.groups:type_name -> v1beta1.PredicateGroup
+	7,  // 10: v1beta1.DummyFilterService.GetFilter:input_type -> v1beta1.Filter
+	7,  // 11: v1beta1.DummyFilterService.GetFilter:output_type -> v1beta1.Filter
+	11, // [11:12] is the sub-list for method output_type
+	10, // [10:11] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_backend_api_v1beta1_filter_proto_init() }
@@ -640,7 +827,7 @@ func file_backend_api_v1beta1_filter_proto_init() {
 			}
 		}
 		file_backend_api_v1beta1_filter_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IntValues); i {
+			switch v := v.(*PredicateGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -652,7 +839,7 @@ func file_backend_api_v1beta1_filter_proto_init() {
 			}
 		}
 		file_backend_api_v1beta1_filter_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StringValues); i {
+			switch v := v.(*IntValues); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -664,7 +851,7 @@ func file_backend_api_v1beta1_filter_proto_init() {
 			}
 		}
 		file_backend_api_v1beta1_filter_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LongValues); i {
+			switch v := v.(*StringValues); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -676,6 +863,18 @@ func file_backend_api_v1beta1_filter_proto_init() {
 			}
 		}
 		file_backend_api_v1beta1_filter_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LongValues); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_api_v1beta1_filter_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Filter); i {
 			case 0:
 				return &v.state
@@ -702,8 +901,8 @@ func file_backend_api_v1beta1_filter_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_backend_api_v1beta1_filter_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   5,
+			NumEnums:      2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
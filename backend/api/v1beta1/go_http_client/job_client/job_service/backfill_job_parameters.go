@@ -0,0 +1,158 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	job_model "github.com/kubeflow/pipelines/backend/api/v1beta1/go_http_client/job_model"
+)
+
+// NewBackfillJobParams creates a new BackfillJobParams object
+// with the default values initialized.
+func NewBackfillJobParams() *BackfillJobParams {
+	var ()
+	return &BackfillJobParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewBackfillJobParamsWithTimeout creates a new BackfillJobParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewBackfillJobParamsWithTimeout(timeout time.Duration) *BackfillJobParams {
+	var ()
+	return &BackfillJobParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewBackfillJobParamsWithContext creates a new BackfillJobParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewBackfillJobParamsWithContext(ctx context.Context) *BackfillJobParams {
+	var ()
+	return &BackfillJobParams{
+
+		Context: ctx,
+	}
+}
+
+// NewBackfillJobParamsWithHTTPClient creates a new BackfillJobParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewBackfillJobParamsWithHTTPClient(client *http.Client) *BackfillJobParams {
+	var ()
+	return &BackfillJobParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+BackfillJobParams contains all the parameters to send to the API endpoint
+for the backfill job operation typically these are written to a http.Request
+*/
+type BackfillJobParams struct {
+
+	/*Body*/
+	Body *job_model.V1beta1BackfillJobRequest
+	/*ID
+	  The ID of the job to be backfilled
+
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the backfill job params
+func (o *BackfillJobParams) WithTimeout(timeout time.Duration) *BackfillJobParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the backfill job params
+func (o *BackfillJobParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the backfill job params
+func (o *BackfillJobParams) WithContext(ctx context.Context) *BackfillJobParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the backfill job params
+func (o *BackfillJobParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the backfill job params
+func (o *BackfillJobParams) WithHTTPClient(client *http.Client) *BackfillJobParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the backfill job params
+func (o *BackfillJobParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the backfill job params
+func (o *BackfillJobParams) WithBody(body *job_model.V1beta1BackfillJobRequest) *BackfillJobParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the backfill job params
+func (o *BackfillJobParams) SetBody(body *job_model.V1beta1BackfillJobRequest) {
+	o.Body = body
+}
+
+// WithID adds the id to the backfill job params
+func (o *BackfillJobParams) WithID(id string) *BackfillJobParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the backfill job params
+func (o *BackfillJobParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *BackfillJobParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
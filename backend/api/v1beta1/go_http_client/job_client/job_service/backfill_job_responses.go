@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	job_model "github.com/kubeflow/pipelines/backend/api/v1beta1/go_http_client/job_model"
+)
+
+// BackfillJobReader is a Reader for the BackfillJob structure.
+type BackfillJobReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BackfillJobReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewBackfillJobOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewBackfillJobDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewBackfillJobOK creates a BackfillJobOK with default headers values
+func NewBackfillJobOK() *BackfillJobOK {
+	return &BackfillJobOK{}
+}
+
+/*
+BackfillJobOK handles this case with default header values.
+
+A successful response.
+*/
+type BackfillJobOK struct {
+	Payload interface{}
+}
+
+func (o *BackfillJobOK) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/jobs/{id}/backfill][%d] backfillJobOK  %+v", 200, o.Payload)
+}
+
+func (o *BackfillJobOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackfillJobDefault creates a BackfillJobDefault with default headers values
+func NewBackfillJobDefault(code int) *BackfillJobDefault {
+	return &BackfillJobDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+BackfillJobDefault handles this case with default header values.
+
+BackfillJobDefault backfill job default
+*/
+type BackfillJobDefault struct {
+	_statusCode int
+
+	Payload *job_model.V1beta1Status
+}
+
+// Code gets the status code for the backfill job default response
+func (o *BackfillJobDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *BackfillJobDefault) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/jobs/{id}/backfill][%d] BackfillJob default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *BackfillJobDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(job_model.V1beta1Status)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
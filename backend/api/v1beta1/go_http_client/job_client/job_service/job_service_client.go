@@ -24,6 +24,35 @@ type Client struct {
 	formats   strfmt.Registry
 }
 
+/*
+BackfillJob creates runs of a job for every time its schedule fires within a time range in the past the runs are created in order and respect the max concurrency of the job
+*/
+func (a *Client) BackfillJob(params *BackfillJobParams, authInfo runtime.ClientAuthInfoWriter) (*BackfillJobOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackfillJobParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "BackfillJob",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/jobs/{id}/backfill",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &BackfillJobReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*BackfillJobOK), nil
+
+}
+
 /*
 CreateJob creates a new job
 */
@@ -198,6 +227,64 @@ func (a *Client) ListJobs(params *ListJobsParams, authInfo runtime.ClientAuthInf
 
 }
 
+/*
+TriggerJob creates a run of a job immediately outside of its schedule the run is created by the job s controller and doesn t move the schedule
+*/
+func (a *Client) TriggerJob(params *TriggerJobParams, authInfo runtime.ClientAuthInfoWriter) (*TriggerJobOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTriggerJobParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "TriggerJob",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/jobs/{id}/trigger",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &TriggerJobReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*TriggerJobOK), nil
+
+}
+
+/*
+UpdateJob updates a job in place the job keeps its ID experiment and the history of runs it has triggered
+*/
+func (a *Client) UpdateJob(params *UpdateJobParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateJobOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateJobParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdateJob",
+		Method:             "PUT",
+		PathPattern:        "/apis/v1beta1/jobs/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateJobReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateJobOK), nil
+
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
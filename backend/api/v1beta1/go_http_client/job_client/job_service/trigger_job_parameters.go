@@ -0,0 +1,137 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewTriggerJobParams creates a new TriggerJobParams object
+// with the default values initialized.
+func NewTriggerJobParams() *TriggerJobParams {
+	var ()
+	return &TriggerJobParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewTriggerJobParamsWithTimeout creates a new TriggerJobParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewTriggerJobParamsWithTimeout(timeout time.Duration) *TriggerJobParams {
+	var ()
+	return &TriggerJobParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewTriggerJobParamsWithContext creates a new TriggerJobParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewTriggerJobParamsWithContext(ctx context.Context) *TriggerJobParams {
+	var ()
+	return &TriggerJobParams{
+
+		Context: ctx,
+	}
+}
+
+// NewTriggerJobParamsWithHTTPClient creates a new TriggerJobParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewTriggerJobParamsWithHTTPClient(client *http.Client) *TriggerJobParams {
+	var ()
+	return &TriggerJobParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+TriggerJobParams contains all the parameters to send to the API endpoint
+for the trigger job operation typically these are written to a http.Request
+*/
+type TriggerJobParams struct {
+
+	/*ID
+	  The ID of the job to be triggered
+
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the trigger job params
+func (o *TriggerJobParams) WithTimeout(timeout time.Duration) *TriggerJobParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the trigger job params
+func (o *TriggerJobParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the trigger job params
+func (o *TriggerJobParams) WithContext(ctx context.Context) *TriggerJobParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the trigger job params
+func (o *TriggerJobParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the trigger job params
+func (o *TriggerJobParams) WithHTTPClient(client *http.Client) *TriggerJobParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the trigger job params
+func (o *TriggerJobParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the trigger job params
+func (o *TriggerJobParams) WithID(id string) *TriggerJobParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the trigger job params
+func (o *TriggerJobParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *TriggerJobParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	job_model "github.com/kubeflow/pipelines/backend/api/v1beta1/go_http_client/job_model"
+)
+
+// NewUpdateJobParams creates a new UpdateJobParams object
+// with the default values initialized.
+func NewUpdateJobParams() *UpdateJobParams {
+	var ()
+	return &UpdateJobParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdateJobParamsWithTimeout creates a new UpdateJobParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewUpdateJobParamsWithTimeout(timeout time.Duration) *UpdateJobParams {
+	var ()
+	return &UpdateJobParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewUpdateJobParamsWithContext creates a new UpdateJobParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewUpdateJobParamsWithContext(ctx context.Context) *UpdateJobParams {
+	var ()
+	return &UpdateJobParams{
+
+		Context: ctx,
+	}
+}
+
+// NewUpdateJobParamsWithHTTPClient creates a new UpdateJobParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewUpdateJobParamsWithHTTPClient(client *http.Client) *UpdateJobParams {
+	var ()
+	return &UpdateJobParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UpdateJobParams contains all the parameters to send to the API endpoint
+for the update job operation typically these are written to a http.Request
+*/
+type UpdateJobParams struct {
+
+	/*Body
+	  The new definition of the job. The ID and the experiment of the job
+	cannot be changed.
+
+	*/
+	Body *job_model.V1beta1Job
+	/*ID
+	  The ID of the job to be updated
+
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the update job params
+func (o *UpdateJobParams) WithTimeout(timeout time.Duration) *UpdateJobParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update job params
+func (o *UpdateJobParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update job params
+func (o *UpdateJobParams) WithContext(ctx context.Context) *UpdateJobParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update job params
+func (o *UpdateJobParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update job params
+func (o *UpdateJobParams) WithHTTPClient(client *http.Client) *UpdateJobParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update job params
+func (o *UpdateJobParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the update job params
+func (o *UpdateJobParams) WithBody(body *job_model.V1beta1Job) *UpdateJobParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the update job params
+func (o *UpdateJobParams) SetBody(body *job_model.V1beta1Job) {
+	o.Body = body
+}
+
+// WithID adds the id to the update job params
+func (o *UpdateJobParams) WithID(id string) *UpdateJobParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the update job params
+func (o *UpdateJobParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdateJobParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	job_model "github.com/kubeflow/pipelines/backend/api/v1beta1/go_http_client/job_model"
+)
+
+// UpdateJobReader is a Reader for the UpdateJob structure.
+type UpdateJobReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateJobReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewUpdateJobOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewUpdateJobDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUpdateJobOK creates a UpdateJobOK with default headers values
+func NewUpdateJobOK() *UpdateJobOK {
+	return &UpdateJobOK{}
+}
+
+/*
+UpdateJobOK handles this case with default header values.
+
+A successful response.
+*/
+type UpdateJobOK struct {
+	Payload *job_model.V1beta1Job
+}
+
+func (o *UpdateJobOK) Error() string {
+	return fmt.Sprintf("[PUT /apis/v1beta1/jobs/{id}][%d] updateJobOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateJobOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(job_model.V1beta1Job)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateJobDefault creates a UpdateJobDefault with default headers values
+func NewUpdateJobDefault(code int) *UpdateJobDefault {
+	return &UpdateJobDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+UpdateJobDefault handles this case with default header values.
+
+UpdateJobDefault update job default
+*/
+type UpdateJobDefault struct {
+	_statusCode int
+
+	Payload *job_model.V1beta1Status
+}
+
+// Code gets the status code for the update job default response
+func (o *UpdateJobDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UpdateJobDefault) Error() string {
+	return fmt.Sprintf("[PUT /apis/v1beta1/jobs/{id}][%d] UpdateJob default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UpdateJobDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(job_model.V1beta1Status)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -28,6 +28,11 @@ type V1beta1CronSchedule struct {
 	// The start time of the cron job
 	// Format: date-time
 	StartTime strfmt.DateTime `json:"start_time,omitempty"`
+
+	// The IANA time zone in which the cron string is interpreted, e.g.
+	// "America/New_York". Daylight saving time transitions of the time zone are
+	// honored. Defaults to the time zone of the scheduled workflow controller.
+	TimeZone string `json:"time_zone,omitempty"`
 }
 
 // Validate validates this v1beta1 cron schedule
@@ -19,6 +19,12 @@ import (
 // swagger:model v1beta1Job
 type V1beta1Job struct {
 
+	// Optional input field. Specify how a run is triggered while max_concurrency
+	// runs of the job are still active. One of "Allow", "Forbid" or "Replace".
+	// Allow waits for an active run to complete, Forbid skips the trigger and
+	// Replace terminates the oldest active run. Defaults to "Allow".
+	ConcurrencyPolicy string `json:"concurrency_policy,omitempty"`
+
 	// Output. The time this job is created.
 	// Format: date-time
 	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
@@ -63,6 +69,11 @@ type V1beta1Job struct {
 	// Optional input field. Specify which Kubernetes service account this job uses.
 	ServiceAccount string `json:"service_account,omitempty"`
 
+	// Optional input field. Deadline in seconds for starting a run after it is
+	// scheduled. Runs that would start later are skipped. If 0, there is no
+	// deadline.
+	StartingDeadlineSeconds string `json:"starting_deadline_seconds,omitempty"`
+
 	// Output. The status of the job.
 	// One of [Enable, Disable, Error]
 	Status string `json:"status,omitempty"`
@@ -0,0 +1,79 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// V1beta1RunCompletionTrigger RunCompletionTrigger allow starting the job whenever a run of an upstream
+// job or pipeline version completes
+// swagger:model v1beta1RunCompletionTrigger
+type V1beta1RunCompletionTrigger struct {
+
+	// The terminal states of the upstream runs that start a run. Supported
+	// states are "Succeeded", "Failed" and "Error". Defaults to "Succeeded".
+	// The ID of the upstream run is substituted for [[UpstreamRunUUID]] in the
+	// parameters of the started run.
+	States []string `json:"states"`
+
+	// The upstream job or pipeline version. Only JOB and PIPELINE_VERSION are
+	// supported.
+	Upstream *V1beta1ResourceKey `json:"upstream,omitempty"`
+}
+
+// Validate validates this v1beta1 run completion trigger
+func (m *V1beta1RunCompletionTrigger) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateUpstream(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1beta1RunCompletionTrigger) validateUpstream(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Upstream) { // not required
+		return nil
+	}
+
+	if m.Upstream != nil {
+		if err := m.Upstream.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("upstream")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *V1beta1RunCompletionTrigger) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *V1beta1RunCompletionTrigger) UnmarshalBinary(b []byte) error {
+	var res V1beta1RunCompletionTrigger
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -21,6 +21,9 @@ type V1beta1Trigger struct {
 
 	// periodic schedule
 	PeriodicSchedule *V1beta1PeriodicSchedule `json:"periodic_schedule,omitempty"`
+
+	// run completion trigger
+	RunCompletionTrigger *V1beta1RunCompletionTrigger `json:"run_completion_trigger,omitempty"`
 }
 
 // Validate validates this v1beta1 trigger
@@ -35,6 +38,10 @@ func (m *V1beta1Trigger) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRunCompletionTrigger(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -77,6 +84,24 @@ func (m *V1beta1Trigger) validatePeriodicSchedule(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *V1beta1Trigger) validateRunCompletionTrigger(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RunCompletionTrigger) { // not required
+		return nil
+	}
+
+	if m.RunCompletionTrigger != nil {
+		if err := m.RunCompletionTrigger.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("run_completion_trigger")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *V1beta1Trigger) MarshalBinary() ([]byte, error) {
 	if m == nil {
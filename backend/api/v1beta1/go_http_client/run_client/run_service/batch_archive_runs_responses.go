@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	run_model "github.com/kubeflow/pipelines/backend/api/v1beta1/go_http_client/run_model"
+)
+
+// BatchArchiveRunsReader is a Reader for the BatchArchiveRuns structure.
+type BatchArchiveRunsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BatchArchiveRunsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewBatchArchiveRunsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewBatchArchiveRunsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewBatchArchiveRunsOK creates a BatchArchiveRunsOK with default headers values
+func NewBatchArchiveRunsOK() *BatchArchiveRunsOK {
+	return &BatchArchiveRunsOK{}
+}
+
+/*
+BatchArchiveRunsOK handles this case with default header values.
+
+A successful response.
+*/
+type BatchArchiveRunsOK struct {
+	Payload *run_model.V1beta1BatchRunsResponse
+}
+
+func (o *BatchArchiveRunsOK) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/runs:batchArchive][%d] batchArchiveRunsOK  %+v", 200, o.Payload)
+}
+
+func (o *BatchArchiveRunsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.V1beta1BatchRunsResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchArchiveRunsDefault creates a BatchArchiveRunsDefault with default headers values
+func NewBatchArchiveRunsDefault(code int) *BatchArchiveRunsDefault {
+	return &BatchArchiveRunsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+BatchArchiveRunsDefault handles this case with default header values.
+
+BatchArchiveRunsDefault batch archive runs default
+*/
+type BatchArchiveRunsDefault struct {
+	_statusCode int
+
+	Payload *run_model.V1beta1Status
+}
+
+// Code gets the status code for the batch archive runs default response
+func (o *BatchArchiveRunsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *BatchArchiveRunsDefault) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/runs:batchArchive][%d] BatchArchiveRuns default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *BatchArchiveRunsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.V1beta1Status)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
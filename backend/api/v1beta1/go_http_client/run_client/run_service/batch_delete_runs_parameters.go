@@ -0,0 +1,137 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	run_model "github.com/kubeflow/pipelines/backend/api/v1beta1/go_http_client/run_model"
+)
+
+// NewBatchDeleteRunsParams creates a new BatchDeleteRunsParams object
+// with the default values initialized.
+func NewBatchDeleteRunsParams() *BatchDeleteRunsParams {
+	var ()
+	return &BatchDeleteRunsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewBatchDeleteRunsParamsWithTimeout creates a new BatchDeleteRunsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewBatchDeleteRunsParamsWithTimeout(timeout time.Duration) *BatchDeleteRunsParams {
+	var ()
+	return &BatchDeleteRunsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewBatchDeleteRunsParamsWithContext creates a new BatchDeleteRunsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewBatchDeleteRunsParamsWithContext(ctx context.Context) *BatchDeleteRunsParams {
+	var ()
+	return &BatchDeleteRunsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewBatchDeleteRunsParamsWithHTTPClient creates a new BatchDeleteRunsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewBatchDeleteRunsParamsWithHTTPClient(client *http.Client) *BatchDeleteRunsParams {
+	var ()
+	return &BatchDeleteRunsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+BatchDeleteRunsParams contains all the parameters to send to the API endpoint
+for the batch delete runs operation typically these are written to a http.Request
+*/
+type BatchDeleteRunsParams struct {
+
+	/*Body*/
+	Body *run_model.V1beta1BatchRunsRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the batch delete runs params
+func (o *BatchDeleteRunsParams) WithTimeout(timeout time.Duration) *BatchDeleteRunsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the batch delete runs params
+func (o *BatchDeleteRunsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the batch delete runs params
+func (o *BatchDeleteRunsParams) WithContext(ctx context.Context) *BatchDeleteRunsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the batch delete runs params
+func (o *BatchDeleteRunsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the batch delete runs params
+func (o *BatchDeleteRunsParams) WithHTTPClient(client *http.Client) *BatchDeleteRunsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the batch delete runs params
+func (o *BatchDeleteRunsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the batch delete runs params
+func (o *BatchDeleteRunsParams) WithBody(body *run_model.V1beta1BatchRunsRequest) *BatchDeleteRunsParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the batch delete runs params
+func (o *BatchDeleteRunsParams) SetBody(body *run_model.V1beta1BatchRunsRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *BatchDeleteRunsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
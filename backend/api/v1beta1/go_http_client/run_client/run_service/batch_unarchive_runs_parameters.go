@@ -0,0 +1,137 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	run_model "github.com/kubeflow/pipelines/backend/api/v1beta1/go_http_client/run_model"
+)
+
+// NewBatchUnarchiveRunsParams creates a new BatchUnarchiveRunsParams object
+// with the default values initialized.
+func NewBatchUnarchiveRunsParams() *BatchUnarchiveRunsParams {
+	var ()
+	return &BatchUnarchiveRunsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewBatchUnarchiveRunsParamsWithTimeout creates a new BatchUnarchiveRunsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewBatchUnarchiveRunsParamsWithTimeout(timeout time.Duration) *BatchUnarchiveRunsParams {
+	var ()
+	return &BatchUnarchiveRunsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewBatchUnarchiveRunsParamsWithContext creates a new BatchUnarchiveRunsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewBatchUnarchiveRunsParamsWithContext(ctx context.Context) *BatchUnarchiveRunsParams {
+	var ()
+	return &BatchUnarchiveRunsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewBatchUnarchiveRunsParamsWithHTTPClient creates a new BatchUnarchiveRunsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewBatchUnarchiveRunsParamsWithHTTPClient(client *http.Client) *BatchUnarchiveRunsParams {
+	var ()
+	return &BatchUnarchiveRunsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+BatchUnarchiveRunsParams contains all the parameters to send to the API endpoint
+for the batch unarchive runs operation typically these are written to a http.Request
+*/
+type BatchUnarchiveRunsParams struct {
+
+	/*Body*/
+	Body *run_model.V1beta1BatchRunsRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the batch unarchive runs params
+func (o *BatchUnarchiveRunsParams) WithTimeout(timeout time.Duration) *BatchUnarchiveRunsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the batch unarchive runs params
+func (o *BatchUnarchiveRunsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the batch unarchive runs params
+func (o *BatchUnarchiveRunsParams) WithContext(ctx context.Context) *BatchUnarchiveRunsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the batch unarchive runs params
+func (o *BatchUnarchiveRunsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the batch unarchive runs params
+func (o *BatchUnarchiveRunsParams) WithHTTPClient(client *http.Client) *BatchUnarchiveRunsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the batch unarchive runs params
+func (o *BatchUnarchiveRunsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the batch unarchive runs params
+func (o *BatchUnarchiveRunsParams) WithBody(body *run_model.V1beta1BatchRunsRequest) *BatchUnarchiveRunsParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the batch unarchive runs params
+func (o *BatchUnarchiveRunsParams) SetBody(body *run_model.V1beta1BatchRunsRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *BatchUnarchiveRunsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
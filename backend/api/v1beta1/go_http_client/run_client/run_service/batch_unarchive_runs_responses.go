@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	run_model "github.com/kubeflow/pipelines/backend/api/v1beta1/go_http_client/run_model"
+)
+
+// BatchUnarchiveRunsReader is a Reader for the BatchUnarchiveRuns structure.
+type BatchUnarchiveRunsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BatchUnarchiveRunsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewBatchUnarchiveRunsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewBatchUnarchiveRunsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewBatchUnarchiveRunsOK creates a BatchUnarchiveRunsOK with default headers values
+func NewBatchUnarchiveRunsOK() *BatchUnarchiveRunsOK {
+	return &BatchUnarchiveRunsOK{}
+}
+
+/*
+BatchUnarchiveRunsOK handles this case with default header values.
+
+A successful response.
+*/
+type BatchUnarchiveRunsOK struct {
+	Payload *run_model.V1beta1BatchRunsResponse
+}
+
+func (o *BatchUnarchiveRunsOK) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/runs:batchUnarchive][%d] batchUnarchiveRunsOK  %+v", 200, o.Payload)
+}
+
+func (o *BatchUnarchiveRunsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.V1beta1BatchRunsResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchUnarchiveRunsDefault creates a BatchUnarchiveRunsDefault with default headers values
+func NewBatchUnarchiveRunsDefault(code int) *BatchUnarchiveRunsDefault {
+	return &BatchUnarchiveRunsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+BatchUnarchiveRunsDefault handles this case with default header values.
+
+BatchUnarchiveRunsDefault batch unarchive runs default
+*/
+type BatchUnarchiveRunsDefault struct {
+	_statusCode int
+
+	Payload *run_model.V1beta1Status
+}
+
+// Code gets the status code for the batch unarchive runs default response
+func (o *BatchUnarchiveRunsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *BatchUnarchiveRunsDefault) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/runs:batchUnarchive][%d] BatchUnarchiveRuns default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *BatchUnarchiveRunsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.V1beta1Status)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
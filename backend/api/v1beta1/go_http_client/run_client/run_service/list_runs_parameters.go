@@ -77,6 +77,9 @@ type ListRunsParams struct {
 	/*Filter
 	  A url-encoded, JSON-serialized Filter protocol buffer (see
 	[filter.proto](https://github.com/kubeflow/pipelines/blob/master/backend/api/filter.proto)).
+	Besides the run fields, predicates can use the keys "metric:<name>", the
+	largest value of the metric reported by the run, and "parameter:<name>",
+	the value of the parameter of the run as a string.
 
 	*/
 	Filter *string
@@ -107,6 +110,7 @@ type ListRunsParams struct {
 	/*SortBy
 	  Can be format of "field_name", "field_name asc" or "field_name desc"
 	(Example, "name asc" or "id desc"). Ascending by default.
+	Runs can also be sorted by "metric:<name>" and "parameter:<name>".
 
 	*/
 	SortBy *string
@@ -53,6 +53,122 @@ func (a *Client) ArchiveRun(params *ArchiveRunParams, authInfo runtime.ClientAut
 
 }
 
+/*
+BatchArchiveRuns archives a batch of runs selected either by ids or by a filter each run is archived on its own so this API accepts partial failures
+*/
+func (a *Client) BatchArchiveRuns(params *BatchArchiveRunsParams, authInfo runtime.ClientAuthInfoWriter) (*BatchArchiveRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchArchiveRunsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "BatchArchiveRuns",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/runs:batchArchive",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &BatchArchiveRunsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*BatchArchiveRunsOK), nil
+
+}
+
+/*
+BatchDeleteRuns deletes a batch of runs selected either by ids or by a filter each run is deleted on its own so this API accepts partial failures
+*/
+func (a *Client) BatchDeleteRuns(params *BatchDeleteRunsParams, authInfo runtime.ClientAuthInfoWriter) (*BatchDeleteRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchDeleteRunsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "BatchDeleteRuns",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/runs:batchDelete",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &BatchDeleteRunsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*BatchDeleteRunsOK), nil
+
+}
+
+/*
+BatchTerminateRuns terminates a batch of active runs selected either by ids or by a filter each run is terminated on its own so this API accepts partial failures
+*/
+func (a *Client) BatchTerminateRuns(params *BatchTerminateRunsParams, authInfo runtime.ClientAuthInfoWriter) (*BatchTerminateRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchTerminateRunsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "BatchTerminateRuns",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/runs:batchTerminate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &BatchTerminateRunsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*BatchTerminateRunsOK), nil
+
+}
+
+/*
+BatchUnarchiveRuns restores a batch of archived runs selected either by ids or by a filter each run is restored on its own so this API accepts partial failures
+*/
+func (a *Client) BatchUnarchiveRuns(params *BatchUnarchiveRunsParams, authInfo runtime.ClientAuthInfoWriter) (*BatchUnarchiveRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchUnarchiveRunsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "BatchUnarchiveRuns",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/runs:batchUnarchive",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &BatchUnarchiveRunsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*BatchUnarchiveRunsOK), nil
+
+}
+
 /*
 CreateRun creates a new run
 */
@@ -0,0 +1,81 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// BatchRunsResponseBatchRunResultStatus  - UNSPECIFIED: Default value if not present.
+//   - OK: Indicates the operation succeeded.
+//   - INVALID_ARGUMENT: Indicates that the run can't be operated on in its current state.
+//   - NOT_FOUND: Indicates that the run doesn't exist.
+//   - PERMISSION_DENIED: Indicates that the caller isn't authorized to operate on the run.
+//   - INTERNAL_ERROR: Indicates that something went wrong in the server.
+//
+// swagger:model BatchRunsResponseBatchRunResultStatus
+type BatchRunsResponseBatchRunResultStatus string
+
+const (
+
+	// BatchRunsResponseBatchRunResultStatusUNSPECIFIED captures enum value "UNSPECIFIED"
+	BatchRunsResponseBatchRunResultStatusUNSPECIFIED BatchRunsResponseBatchRunResultStatus = "UNSPECIFIED"
+
+	// BatchRunsResponseBatchRunResultStatusOK captures enum value "OK"
+	BatchRunsResponseBatchRunResultStatusOK BatchRunsResponseBatchRunResultStatus = "OK"
+
+	// BatchRunsResponseBatchRunResultStatusINVALIDARGUMENT captures enum value "INVALID_ARGUMENT"
+	BatchRunsResponseBatchRunResultStatusINVALIDARGUMENT BatchRunsResponseBatchRunResultStatus = "INVALID_ARGUMENT"
+
+	// BatchRunsResponseBatchRunResultStatusNOTFOUND captures enum value "NOT_FOUND"
+	BatchRunsResponseBatchRunResultStatusNOTFOUND BatchRunsResponseBatchRunResultStatus = "NOT_FOUND"
+
+	// BatchRunsResponseBatchRunResultStatusPERMISSIONDENIED captures enum value "PERMISSION_DENIED"
+	BatchRunsResponseBatchRunResultStatusPERMISSIONDENIED BatchRunsResponseBatchRunResultStatus = "PERMISSION_DENIED"
+
+	// BatchRunsResponseBatchRunResultStatusINTERNALERROR captures enum value "INTERNAL_ERROR"
+	BatchRunsResponseBatchRunResultStatusINTERNALERROR BatchRunsResponseBatchRunResultStatus = "INTERNAL_ERROR"
+)
+
+// for schema
+var batchRunsResponseBatchRunResultStatusEnum []interface{}
+
+func init() {
+	var res []BatchRunsResponseBatchRunResultStatus
+	if err := json.Unmarshal([]byte(`["UNSPECIFIED","OK","INVALID_ARGUMENT","NOT_FOUND","PERMISSION_DENIED","INTERNAL_ERROR"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		batchRunsResponseBatchRunResultStatusEnum = append(batchRunsResponseBatchRunResultStatusEnum, v)
+	}
+}
+
+func (m BatchRunsResponseBatchRunResultStatus) validateBatchRunsResponseBatchRunResultStatusEnum(path, location string, value BatchRunsResponseBatchRunResultStatus) error {
+	if err := validate.Enum(path, location, value, batchRunsResponseBatchRunResultStatusEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this batch runs response batch run result status
+func (m BatchRunsResponseBatchRunResultStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateBatchRunsResponseBatchRunResultStatusEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// V1beta1BatchRunsRequest v1beta1 batch runs request
+// swagger:model v1beta1BatchRunsRequest
+type V1beta1BatchRunsRequest struct {
+
+	// The filter selecting the runs to operate on, using the same keys as
+	// ListRuns. Required and must not be empty when run_ids is not set, and
+	// can't be combined with run_ids.
+	Filter *V1beta1Filter `json:"filter,omitempty"`
+
+	// What resource reference to select runs from. Required when runs are
+	// selected by filter, e.g. the experiment of a hyperparameter sweep.
+	ResourceReferenceKey *V1beta1ResourceKey `json:"resource_reference_key,omitempty"`
+
+	// The IDs of the runs to operate on. Can't be combined with filter.
+	RunIds []string `json:"run_ids"`
+}
+
+// Validate validates this v1beta1 batch runs request
+func (m *V1beta1BatchRunsRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFilter(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResourceReferenceKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1beta1BatchRunsRequest) validateFilter(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Filter) { // not required
+		return nil
+	}
+
+	if m.Filter != nil {
+		if err := m.Filter.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("filter")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V1beta1BatchRunsRequest) validateResourceReferenceKey(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ResourceReferenceKey) { // not required
+		return nil
+	}
+
+	if m.ResourceReferenceKey != nil {
+		if err := m.ResourceReferenceKey.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("resource_reference_key")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *V1beta1BatchRunsRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *V1beta1BatchRunsRequest) UnmarshalBinary(b []byte) error {
+	var res V1beta1BatchRunsRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,179 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// V1beta1Filter Filter is used to filter resources returned from a ListXXX request.
+//
+// Example filters:
+// 1) Filter runs with status = 'Running'
+//
+//	filter {
+//	  predicate {
+//	    key: "status"
+//	    op: EQUALS
+//	    string_value: "Running"
+//	  }
+//	}
+//
+// 2) Filter runs that succeeded since Dec 1, 2018
+//
+//	filter {
+//	  predicate {
+//	    key: "status"
+//	    op: EQUALS
+//	    string_value: "Succeeded"
+//	  }
+//	  predicate {
+//	    key: "created_at"
+//	    op: GREATER_THAN
+//	    timestamp_value {
+//	      seconds: 1543651200
+//	    }
+//	  }
+//	}
+//
+// 3) Filter runs with one of labels 'label_1' or 'label_2'
+//
+//	filter {
+//	  predicate {
+//	    key: "label"
+//	    op: IN
+//	    string_values {
+//	      value: 'label_1'
+//	      value: 'label_2'
+//	    }
+//	  }
+//	}
+//
+// 4) Filter runs that failed or errored, excluding those without a finish time
+//
+//	filter {
+//	  groups {
+//	    op: OR
+//	    predicates {
+//	      key: "status"
+//	      op: EQUALS
+//	      string_value: "Failed"
+//	    }
+//	    predicates {
+//	      key: "status"
+//	      op: EQUALS
+//	      string_value: "Error"
+//	    }
+//	  }
+//	  groups {
+//	    op: NOT
+//	    predicates {
+//	      key: "finished_at"
+//	      op: IS_NULL
+//	    }
+//	  }
+//	}
+//
+// swagger:model v1beta1Filter
+type V1beta1Filter struct {
+
+	// All groups are AND-ed with each other and with the predicates above.
+	Groups []*V1beta1PredicateGroup `json:"groups"`
+
+	// All predicates are AND-ed when this filter is applied.
+	Predicates []*V1beta1Predicate `json:"predicates"`
+}
+
+// Validate validates this v1beta1 filter
+func (m *V1beta1Filter) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateGroups(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePredicates(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1beta1Filter) validateGroups(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Groups) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Groups); i++ {
+		if swag.IsZero(m.Groups[i]) { // not required
+			continue
+		}
+
+		if m.Groups[i] != nil {
+			if err := m.Groups[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("groups" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *V1beta1Filter) validatePredicates(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Predicates) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Predicates); i++ {
+		if swag.IsZero(m.Predicates[i]) { // not required
+			continue
+		}
+
+		if m.Predicates[i] != nil {
+			if err := m.Predicates[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("predicates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *V1beta1Filter) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *V1beta1Filter) UnmarshalBinary(b []byte) error {
+	var res V1beta1Filter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,180 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// V1beta1Predicate Predicate captures individual conditions that must be true for a resource
+// being filtered.
+// swagger:model v1beta1Predicate
+type V1beta1Predicate struct {
+
+	// int value
+	IntValue int32 `json:"int_value,omitempty"`
+
+	// Array values below are only meant to be used by the IN operator.
+	IntValues *V1beta1IntValues `json:"int_values,omitempty"`
+
+	// key
+	Key string `json:"key,omitempty"`
+
+	// long value
+	LongValue string `json:"long_value,omitempty"`
+
+	// long values
+	LongValues *V1beta1LongValues `json:"long_values,omitempty"`
+
+	// op
+	Op V1beta1PredicateOp `json:"op,omitempty"`
+
+	// string value
+	StringValue string `json:"string_value,omitempty"`
+
+	// string values
+	StringValues *V1beta1StringValues `json:"string_values,omitempty"`
+
+	// Timestamp values will be converted to Unix time (seconds since the epoch)
+	// prior to being used in a filtering operation.
+	// Format: date-time
+	TimestampValue strfmt.DateTime `json:"timestamp_value,omitempty"`
+}
+
+// Validate validates this v1beta1 predicate
+func (m *V1beta1Predicate) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateIntValues(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLongValues(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOp(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStringValues(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimestampValue(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1beta1Predicate) validateIntValues(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.IntValues) { // not required
+		return nil
+	}
+
+	if m.IntValues != nil {
+		if err := m.IntValues.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("int_values")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V1beta1Predicate) validateLongValues(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LongValues) { // not required
+		return nil
+	}
+
+	if m.LongValues != nil {
+		if err := m.LongValues.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("long_values")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V1beta1Predicate) validateOp(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Op) { // not required
+		return nil
+	}
+
+	if err := m.Op.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("op")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *V1beta1Predicate) validateStringValues(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.StringValues) { // not required
+		return nil
+	}
+
+	if m.StringValues != nil {
+		if err := m.StringValues.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("string_values")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V1beta1Predicate) validateTimestampValue(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TimestampValue) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("timestamp_value", "body", "date-time", m.TimestampValue.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *V1beta1Predicate) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *V1beta1Predicate) UnmarshalBinary(b []byte) error {
+	var res V1beta1Predicate
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
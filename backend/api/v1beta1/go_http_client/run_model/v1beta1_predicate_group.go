@@ -0,0 +1,136 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// V1beta1PredicateGroup PredicateGroup combines predicates and nested groups with a logical
+// operator.
+// swagger:model v1beta1PredicateGroup
+type V1beta1PredicateGroup struct {
+
+	// groups
+	Groups []*V1beta1PredicateGroup `json:"groups"`
+
+	// op
+	Op V1beta1PredicateGroupOp `json:"op,omitempty"`
+
+	// predicates
+	Predicates []*V1beta1Predicate `json:"predicates"`
+}
+
+// Validate validates this v1beta1 predicate group
+func (m *V1beta1PredicateGroup) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateGroups(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOp(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePredicates(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1beta1PredicateGroup) validateGroups(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Groups) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Groups); i++ {
+		if swag.IsZero(m.Groups[i]) { // not required
+			continue
+		}
+
+		if m.Groups[i] != nil {
+			if err := m.Groups[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("groups" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *V1beta1PredicateGroup) validateOp(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Op) { // not required
+		return nil
+	}
+
+	if err := m.Op.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("op")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *V1beta1PredicateGroup) validatePredicates(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Predicates) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Predicates); i++ {
+		if swag.IsZero(m.Predicates[i]) { // not required
+			continue
+		}
+
+		if m.Predicates[i] != nil {
+			if err := m.Predicates[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("predicates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *V1beta1PredicateGroup) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *V1beta1PredicateGroup) UnmarshalBinary(b []byte) error {
+	var res V1beta1PredicateGroup
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
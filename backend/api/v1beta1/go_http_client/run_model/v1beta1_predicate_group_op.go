@@ -0,0 +1,74 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// V1beta1PredicateGroupOp Op is the logical operator to apply.
+//
+//   - AND: All of the predicates and groups must be true.
+//   - OR: At least one of the predicates and groups must be true.
+//   - NOT: Not all of the predicates and groups are true, i.e. NOT (p1 AND p2 ...).
+//
+// swagger:model v1beta1PredicateGroupOp
+type V1beta1PredicateGroupOp string
+
+const (
+
+	// V1beta1PredicateGroupOpUNKNOWN captures enum value "UNKNOWN"
+	V1beta1PredicateGroupOpUNKNOWN V1beta1PredicateGroupOp = "UNKNOWN"
+
+	// V1beta1PredicateGroupOpAND captures enum value "AND"
+	V1beta1PredicateGroupOpAND V1beta1PredicateGroupOp = "AND"
+
+	// V1beta1PredicateGroupOpOR captures enum value "OR"
+	V1beta1PredicateGroupOpOR V1beta1PredicateGroupOp = "OR"
+
+	// V1beta1PredicateGroupOpNOT captures enum value "NOT"
+	V1beta1PredicateGroupOpNOT V1beta1PredicateGroupOp = "NOT"
+)
+
+// for schema
+var v1beta1PredicateGroupOpEnum []interface{}
+
+func init() {
+	var res []V1beta1PredicateGroupOp
+	if err := json.Unmarshal([]byte(`["UNKNOWN","AND","OR","NOT"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		v1beta1PredicateGroupOpEnum = append(v1beta1PredicateGroupOpEnum, v)
+	}
+}
+
+func (m V1beta1PredicateGroupOp) validateV1beta1PredicateGroupOpEnum(path, location string, value V1beta1PredicateGroupOp) error {
+	if err := validate.Enum(path, location, value, v1beta1PredicateGroupOpEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this v1beta1 predicate group op
+func (m V1beta1PredicateGroupOp) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateV1beta1PredicateGroupOpEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
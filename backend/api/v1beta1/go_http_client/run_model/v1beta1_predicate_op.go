@@ -0,0 +1,103 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// V1beta1PredicateOp Op is the operation to apply.
+//
+//   - EQUALS: Operators on scalar values. Only applies to one of |int_value|,
+//
+// |long_value|, |string_value| or |timestamp_value|.
+//   - IN: Checks if the value is a member of a given array, which should be one of
+//
+// |int_values|, |long_values| or |string_values|.
+//   - IS_SUBSTRING: Checks if the value contains |string_value| as a substring match. Only
+//
+// applies to |string_value|.
+//   - IS_NULL: Checks if the value is null. No value should be set.
+//   - IS_NOT_NULL: Checks if the value is not null. No value should be set.
+//
+// swagger:model v1beta1PredicateOp
+type V1beta1PredicateOp string
+
+const (
+
+	// V1beta1PredicateOpUNKNOWN captures enum value "UNKNOWN"
+	V1beta1PredicateOpUNKNOWN V1beta1PredicateOp = "UNKNOWN"
+
+	// V1beta1PredicateOpEQUALS captures enum value "EQUALS"
+	V1beta1PredicateOpEQUALS V1beta1PredicateOp = "EQUALS"
+
+	// V1beta1PredicateOpNOTEQUALS captures enum value "NOT_EQUALS"
+	V1beta1PredicateOpNOTEQUALS V1beta1PredicateOp = "NOT_EQUALS"
+
+	// V1beta1PredicateOpGREATERTHAN captures enum value "GREATER_THAN"
+	V1beta1PredicateOpGREATERTHAN V1beta1PredicateOp = "GREATER_THAN"
+
+	// V1beta1PredicateOpGREATERTHANEQUALS captures enum value "GREATER_THAN_EQUALS"
+	V1beta1PredicateOpGREATERTHANEQUALS V1beta1PredicateOp = "GREATER_THAN_EQUALS"
+
+	// V1beta1PredicateOpLESSTHAN captures enum value "LESS_THAN"
+	V1beta1PredicateOpLESSTHAN V1beta1PredicateOp = "LESS_THAN"
+
+	// V1beta1PredicateOpLESSTHANEQUALS captures enum value "LESS_THAN_EQUALS"
+	V1beta1PredicateOpLESSTHANEQUALS V1beta1PredicateOp = "LESS_THAN_EQUALS"
+
+	// V1beta1PredicateOpIN captures enum value "IN"
+	V1beta1PredicateOpIN V1beta1PredicateOp = "IN"
+
+	// V1beta1PredicateOpISSUBSTRING captures enum value "IS_SUBSTRING"
+	V1beta1PredicateOpISSUBSTRING V1beta1PredicateOp = "IS_SUBSTRING"
+
+	// V1beta1PredicateOpISNULL captures enum value "IS_NULL"
+	V1beta1PredicateOpISNULL V1beta1PredicateOp = "IS_NULL"
+
+	// V1beta1PredicateOpISNOTNULL captures enum value "IS_NOT_NULL"
+	V1beta1PredicateOpISNOTNULL V1beta1PredicateOp = "IS_NOT_NULL"
+)
+
+// for schema
+var v1beta1PredicateOpEnum []interface{}
+
+func init() {
+	var res []V1beta1PredicateOp
+	if err := json.Unmarshal([]byte(`["UNKNOWN","EQUALS","NOT_EQUALS","GREATER_THAN","GREATER_THAN_EQUALS","LESS_THAN","LESS_THAN_EQUALS","IN","IS_SUBSTRING","IS_NULL","IS_NOT_NULL"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		v1beta1PredicateOpEnum = append(v1beta1PredicateOpEnum, v)
+	}
+}
+
+func (m V1beta1PredicateOp) validateV1beta1PredicateOpEnum(path, location string, value V1beta1PredicateOp) error {
+	if err := validate.Enum(path, location, value, v1beta1PredicateOpEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this v1beta1 predicate op
+func (m V1beta1PredicateOp) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateV1beta1PredicateOpEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
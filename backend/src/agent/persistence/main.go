@@ -43,6 +43,7 @@ var (
 	numWorker                     int
 	clientQPS                     float64
 	clientBurst                   int
+	executionType                 string
 )
 
 const (
@@ -59,6 +60,7 @@ const (
 	numWorkerName                         = "numWorker"
 	clientQPSFlagName                     = "clientQPS"
 	clientBurstFlagName                   = "clientBurst"
+	executionTypeFlagName                 = "executionType"
 )
 
 const (
@@ -68,6 +70,11 @@ const (
 func main() {
 	flag.Parse()
 
+	// The API server only reports the runs of Argo Workflows for now.
+	if util.ExecutionType(executionType) != util.ArgoWorkflow {
+		log.Fatalf("Not supported type of Execution %s: the API server only reports %s", executionType, util.ArgoWorkflow)
+	}
+
 	// set up signals so we handle the first shutdown signal gracefully
 	stopCh := signals.SetupSignalHandler()
 
@@ -84,7 +91,7 @@ func main() {
 	}
 
 	clientParam := util.ClientParameters{QPS: float64(cfg.QPS), Burst: cfg.Burst}
-	execInformer := util.NewExecutionInformerOrFatal(util.ArgoWorkflow, namespace, time.Second*30, clientParam)
+	execInformer := util.NewExecutionInformerOrFatal(util.ExecutionType(executionType), namespace, time.Second*30, clientParam)
 
 	var swfInformerFactory swfinformers.SharedInformerFactory
 	if namespace == "" {
@@ -140,4 +147,5 @@ func init() {
 	// k8s.io/client-go/rest/config.go#RESTClientFor
 	flag.Float64Var(&clientQPS, clientQPSFlagName, 5, "The maximum QPS to the master from this client.")
 	flag.IntVar(&clientBurst, clientBurstFlagName, 10, "Maximum burst for throttle from this client.")
+	flag.StringVar(&executionType, executionTypeFlagName, string(util.ArgoWorkflow), "Custom Resource's name of the backend orchestration engine. Only Workflow is supported for now.")
 }
@@ -16,7 +16,9 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
@@ -54,8 +56,22 @@ func (c *FakeScheduledWorkflowClient) Delete(ctx context.Context, name string, o
 	return k8errors.NewNotFound(k8schema.ParseGroupResource("scheduledworkflows.kubeflow.org"), name)
 }
 
+// Patch supports merge patches, which are applied by unmarshalling the patch
+// onto a copy of the scheduled workflow. Patching a scheduled workflow that doesn't exist is a no-op.
 func (c *FakeScheduledWorkflowClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.ScheduledWorkflow, err error) {
-	return nil, nil
+	scheduledWorkflow, ok := c.scheduledWorkflows[name]
+	if !ok {
+		return nil, nil
+	}
+	if pt != types.MergePatchType {
+		return nil, fmt.Errorf("unsupported patch type %s", pt)
+	}
+	patched := scheduledWorkflow.DeepCopy()
+	if err := json.Unmarshal(data, patched); err != nil {
+		return nil, err
+	}
+	c.scheduledWorkflows[name] = patched
+	return patched, nil
 }
 
 func (c *FakeScheduledWorkflowClient) Get(ctx context.Context, name string, options v1.GetOptions) (*v1beta1.ScheduledWorkflow, error) {
@@ -66,9 +82,12 @@ func (c *FakeScheduledWorkflowClient) Get(ctx context.Context, name string, opti
 	return nil, k8errors.NewNotFound(k8schema.ParseGroupResource("scheduledworkflows.kubeflow.org"), name)
 }
 
-func (c *FakeScheduledWorkflowClient) Update(context.Context, *v1beta1.ScheduledWorkflow) (*v1beta1.ScheduledWorkflow, error) {
-	glog.Error("This fake method is not yet implemented.")
-	return nil, nil
+func (c *FakeScheduledWorkflowClient) Update(ctx context.Context, scheduledWorkflow *v1beta1.ScheduledWorkflow) (*v1beta1.ScheduledWorkflow, error) {
+	if _, ok := c.scheduledWorkflows[scheduledWorkflow.Name]; !ok {
+		return nil, k8errors.NewNotFound(k8schema.ParseGroupResource("scheduledworkflows.kubeflow.org"), scheduledWorkflow.Name)
+	}
+	c.scheduledWorkflows[scheduledWorkflow.Name] = scheduledWorkflow
+	return scheduledWorkflow, nil
 }
 
 func (c *FakeScheduledWorkflowClient) DeleteCollection(ctx context.Context, options *v1.DeleteOptions, listOptions v1.ListOptions) error {
@@ -98,6 +117,10 @@ func (FakeBadScheduledWorkflowClient) Get(ctx context.Context, name string, opti
 	return nil, errors.New("some error")
 }
 
+func (FakeBadScheduledWorkflowClient) Update(ctx context.Context, scheduledWorkflow *v1beta1.ScheduledWorkflow) (*v1beta1.ScheduledWorkflow, error) {
+	return nil, errors.New("some error")
+}
+
 func (FakeBadScheduledWorkflowClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.ScheduledWorkflow, err error) {
 	return nil, errors.New("some error")
 }
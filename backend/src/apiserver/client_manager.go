@@ -30,6 +30,8 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	cachemodel "github.com/kubeflow/pipelines/backend/src/cache/model"
+	cachestorage "github.com/kubeflow/pipelines/backend/src/cache/storage"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/minio/minio-go/v6"
 )
@@ -46,6 +48,7 @@ const (
 	mysqlUser              = "DBConfig.User"
 	mysqlPassword          = "DBConfig.Password"
 	mysqlDBName            = "DBConfig.DBName"
+	mysqlCacheDBName       = "DBConfig.CacheDBName"
 	mysqlGroupConcatMaxLen = "DBConfig.GroupConcatMaxLen"
 	mysqlExtraParams       = "DBConfig.ExtraParams"
 	archiveLogFileName     = "ARCHIVE_CONFIG_LOG_FILE_NAME"
@@ -69,6 +72,9 @@ type ClientManager struct {
 	jobStore                  storage.JobStoreInterface
 	runStore                  storage.RunStoreInterface
 	taskStore                 storage.TaskStoreInterface
+	executionCacheDB          *cachestorage.DB
+	executionCacheStore       cachestorage.ExecutionCacheStoreInterface
+	notificationStore         storage.NotificationStoreInterface
 	resourceReferenceStore    storage.ResourceReferenceStoreInterface
 	dBStatusStore             storage.DBStatusStoreInterface
 	defaultExperimentStore    storage.DefaultExperimentStoreInterface
@@ -88,6 +94,14 @@ func (c *ClientManager) TaskStore() storage.TaskStoreInterface {
 	return c.taskStore
 }
 
+func (c *ClientManager) ExecutionCacheStore() cachestorage.ExecutionCacheStoreInterface {
+	return c.executionCacheStore
+}
+
+func (c *ClientManager) NotificationStore() storage.NotificationStoreInterface {
+	return c.notificationStore
+}
+
 func (c *ClientManager) ExperimentStore() storage.ExperimentStoreInterface {
 	return c.experimentStore
 }
@@ -172,6 +186,14 @@ func (c *ClientManager) init() {
 	c.pipelineStore = storage.NewPipelineStore(db, c.time, c.uuid)
 	c.jobStore = storage.NewJobStore(db, c.time)
 	c.taskStore = storage.NewTaskStore(db, c.time, c.uuid)
+	if common.IsCacheEnabled() == "true" {
+		// The execution caches of the v1 cache server are only invalidated.
+		c.executionCacheDB = initExecutionCacheDBClient()
+		if c.executionCacheDB != nil {
+			c.executionCacheStore = cachestorage.NewExecutionCacheStore(c.executionCacheDB, c.time)
+		}
+	}
+	c.notificationStore = storage.NewNotificationStore(db, c.time, c.uuid)
 	c.resourceReferenceStore = storage.NewResourceReferenceStore(db)
 	c.dBStatusStore = storage.NewDBStatusStore(db)
 	c.defaultExperimentStore = storage.NewDefaultExperimentStore(db)
@@ -184,7 +206,7 @@ func (c *ClientManager) init() {
 		Burst: common.GetIntConfigWithDefault(clientBurst, 10),
 	}
 
-	c.execClient = util.NewExecutionClientOrFatal(util.ArgoWorkflow, common.GetDurationConfig(initConnectionTimeout), clientParams)
+	c.execClient = util.NewExecutionClientOrFatal(common.GetExecutionType(), common.GetDurationConfig(initConnectionTimeout), clientParams)
 
 	c.swfClient = client.NewScheduledWorkflowClientOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
 
@@ -205,6 +227,9 @@ func (c *ClientManager) init() {
 }
 
 func (c *ClientManager) Close() {
+	if c.executionCacheDB != nil {
+		c.executionCacheDB.Close()
+	}
 	c.db.Close()
 }
 
@@ -214,7 +239,7 @@ func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
 
 	switch driverName {
 	case "mysql":
-		arg = initMysql(driverName, initConnectionTimeout)
+		arg = initMysql(driverName, common.GetStringConfig(mysqlDBName), initConnectionTimeout)
 	default:
 		glog.Fatalf("Driver %v is not supported", driverName)
 	}
@@ -226,13 +251,18 @@ func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
 
 	// If pipeline_versions table is introduced into DB for the first time,
 	// it needs initialization or data backfill.
+	// Similarly, the parameters of existing runs are backfilled when the
+	// run_parameters table is introduced.
 	var tableNames []string
 	var initializePipelineVersions = true
+	var initializeRunParameters = true
 	db.Raw(`show tables`).Pluck("Tables_in_mlpipeline", &tableNames)
 	for _, tableName := range tableNames {
-		if tableName == "pipeline_versions" {
+		switch tableName {
+		case "pipeline_versions":
 			initializePipelineVersions = false
-			break
+		case "run_parameters":
+			initializeRunParameters = false
 		}
 	}
 
@@ -245,7 +275,11 @@ func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
 		&model.ResourceReference{},
 		&model.RunDetail{},
 		&model.RunMetric{},
+		&model.RunParameter{},
 		&model.Task{},
+		&model.NotificationSubscription{},
+		&model.NotificationDelivery{},
+		&model.RunUpdate{},
 		&model.DBStatus{},
 		&model.DefaultExperiment{})
 
@@ -288,6 +322,11 @@ func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
 	if response.Error != nil {
 		glog.Fatalf("Failed to create a foreign key for RunID in run_metrics table. Error: %s", response.Error)
 	}
+	response = db.Model(&model.RunParameter{}).
+		AddForeignKey("RunUUID", "run_details(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
+	if response.Error != nil {
+		glog.Fatalf("Failed to create a foreign key for RunUUID in run_parameters table. Error: %s", response.Error)
+	}
 	response = db.Model(&model.PipelineVersion{}).
 		AddForeignKey("PipelineId", "pipelines(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
 	if response.Error != nil {
@@ -308,6 +347,12 @@ func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
 	if err != nil {
 		glog.Fatalf("Failed to backfill experiment UUID in run_details table: %s", err)
 	}
+	if initializeRunParameters {
+		err = backfillRunParameters(db)
+		if err != nil {
+			glog.Fatalf("Failed to backfill run_parameters table: %s", err)
+		}
+	}
 
 	response = db.Model(&model.Pipeline{}).ModifyColumn("Description", "longtext not null")
 	if response.Error != nil {
@@ -327,9 +372,42 @@ func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
 	return storage.NewDB(db.DB(), storage.NewMySQLDialect())
 }
 
+// initExecutionCacheDBClient connects to the database of the v1 cache server.
+// The database is owned by the cache server, so it is neither created nor
+// migrated. Returns nil if the database or its table isn't available, in which
+// case the v1 execution caches aren't invalidated.
+func initExecutionCacheDBClient() *cachestorage.DB {
+	driverName := common.GetStringConfig("DBConfig.DriverName")
+	if driverName != "mysql" {
+		glog.Warningf("The v1 execution caches can't be invalidated with driver %v", driverName)
+		return nil
+	}
+	mysqlConfig := client.CreateMySQLConfig(
+		common.GetStringConfigWithDefault(mysqlUser, "root"),
+		common.GetStringConfigWithDefault(mysqlPassword, ""),
+		common.GetStringConfigWithDefault(mysqlServiceHost, "mysql"),
+		common.GetStringConfigWithDefault(mysqlServicePort, "3306"),
+		common.GetStringConfigWithDefault(mysqlCacheDBName, "cachedb"),
+		common.GetStringConfigWithDefault(mysqlGroupConcatMaxLen, "1024"),
+		common.GetMapConfig(mysqlExtraParams),
+	)
+
+	db, err := gorm.Open(driverName, mysqlConfig.FormatDSN())
+	if err != nil {
+		glog.Warningf("The v1 execution caches won't be invalidated, the cache database is unavailable. Error: %v", err)
+		return nil
+	}
+	if !db.HasTable(&cachemodel.ExecutionCache{}) {
+		glog.Warningf("The v1 execution caches won't be invalidated, the cache server hasn't created its table.")
+		db.Close()
+		return nil
+	}
+	return cachestorage.NewDB(db)
+}
+
 // Initialize the connection string for connecting to Mysql database
 // Format would be something like root@tcp(ip:port)/dbname?charset=utf8&loc=Local&parseTime=True
-func initMysql(driverName string, initConnectionTimeout time.Duration) string {
+func initMysql(driverName string, dbName string, initConnectionTimeout time.Duration) string {
 	mysqlConfig := client.CreateMySQLConfig(
 		common.GetStringConfigWithDefault(mysqlUser, "root"),
 		common.GetStringConfigWithDefault(mysqlPassword, ""),
@@ -360,7 +438,6 @@ func initMysql(driverName string, initConnectionTimeout time.Duration) string {
 	util.TerminateIfError(err)
 
 	// Create database if not exist
-	dbName := common.GetStringConfig(mysqlDBName)
 	operation = func() error {
 		_, err = db.Exec(fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", dbName))
 		if err != nil {
@@ -499,3 +576,42 @@ func backfillExperimentIDToRunTable(db *gorm.DB) (retError error) {
 	`)
 	return err
 }
+
+func backfillRunParameters(db *gorm.DB) error {
+	rows, err := db.CommonDB().Query(`SELECT UUID, Parameters, RuntimeParameters FROM run_details`)
+	if err != nil {
+		return err
+	}
+	var params []*model.RunParameter
+	for rows.Next() {
+		var uuid string
+		var parameters, runtimeParameters sql.NullString
+		if err := rows.Scan(&uuid, &parameters, &runtimeParameters); err != nil {
+			rows.Close()
+			return err
+		}
+		spec := model.PipelineSpec{
+			Parameters:    parameters.String,
+			RuntimeConfig: model.RuntimeConfig{Parameters: runtimeParameters.String},
+		}
+		runParams, err := spec.RunParameters(uuid)
+		if err != nil {
+			glog.Warningf("Skipped backfilling the parameters of run %v: %v", uuid, err)
+			continue
+		}
+		params = append(params, runParams...)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	tx := db.Begin()
+	for _, param := range params {
+		if err := tx.Create(param).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit().Error
+}
@@ -16,9 +16,11 @@ package common
 
 import (
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/spf13/viper"
 )
 
@@ -32,6 +34,16 @@ const (
 	KubeflowUserIDPrefix                    string = "KUBEFLOW_USERID_PREFIX"
 	UpdatePipelineVersionByDefault          string = "AUTO_UPDATE_PIPELINE_DEFAULT_VERSION"
 	TokenReviewAudience                     string = "TOKEN_REVIEW_AUDIENCE"
+	ExecutionType                           string = "ExecutionType"
+	BatchRunsChunkSize                      string = "BATCH_RUNS_CHUNK_SIZE"
+	RunRetentionPolicies                    string = "RunRetentionPolicies"
+	RunRetentionInterval                    string = "RUN_RETENTION_INTERVAL"
+	RunRetentionDryRun                      string = "RUN_RETENTION_DRY_RUN"
+	NotificationDispatchInterval            string = "NOTIFICATION_DISPATCH_INTERVAL"
+	NotificationAllowedHosts                string = "NOTIFICATION_ALLOWED_HOSTS"
+	RunUpdatePollInterval                   string = "RUN_UPDATE_POLL_INTERVAL"
+	DefaultCacheStaleness                   string = "DEFAULT_CACHE_STALENESS"
+	MaximumCacheStaleness                   string = "MAXIMUM_CACHE_STALENESS"
 )
 
 func IsPipelineVersionUpdatedByDefault() bool {
@@ -92,6 +104,13 @@ func GetDurationConfig(configName string) time.Duration {
 	return viper.GetDuration(configName)
 }
 
+func GetDurationConfigWithDefault(configName string, value time.Duration) time.Duration {
+	if !viper.IsSet(configName) {
+		return value
+	}
+	return viper.GetDuration(configName)
+}
+
 func IsMultiUserMode() bool {
 	return GetBoolConfigWithDefault(MultiUserMode, false)
 }
@@ -127,3 +146,65 @@ func GetKubeflowUserIDPrefix() string {
 func GetTokenReviewAudience() string {
 	return GetStringConfigWithDefault(TokenReviewAudience, DefaultTokenReviewAudience)
 }
+
+// GetDefaultCacheStaleness returns the max cache staleness of the v2 tasks that
+// don't set one, as an ISO 8601 duration. It is empty when not configured.
+func GetDefaultCacheStaleness() string {
+	return GetStringConfigWithDefault(DefaultCacheStaleness, "")
+}
+
+// GetMaximumCacheStaleness returns the upper bound of the max cache staleness of
+// the v2 tasks, as an ISO 8601 duration. It is empty when not configured.
+func GetMaximumCacheStaleness() string {
+	return GetStringConfigWithDefault(MaximumCacheStaleness, "")
+}
+
+// GetBatchRunsChunkSize returns the number of runs a batch run request
+// processes at a time.
+func GetBatchRunsChunkSize() int {
+	return GetIntConfigWithDefault(BatchRunsChunkSize, DefaultBatchRunsChunkSize)
+}
+
+// GetRunRetentionInterval returns how often the run retention policies are
+// enforced.
+func GetRunRetentionInterval() time.Duration {
+	return GetDurationConfigWithDefault(RunRetentionInterval, DefaultRunRetentionInterval)
+}
+
+// IsRunRetentionDryRun returns whether the runs expired under the run
+// retention policies are only reported rather than archived and deleted.
+func IsRunRetentionDryRun() bool {
+	return GetBoolConfigWithDefault(RunRetentionDryRun, false)
+}
+
+// GetNotificationDispatchInterval returns how often the pending run
+// notifications are delivered.
+func GetNotificationDispatchInterval() time.Duration {
+	return GetDurationConfigWithDefault(NotificationDispatchInterval, DefaultNotificationDispatchInterval)
+}
+
+// GetRunUpdatePollInterval returns how often the run updates made through the
+// other API servers are polled for the run watchers.
+func GetRunUpdatePollInterval() time.Duration {
+	return GetDurationConfigWithDefault(RunUpdatePollInterval, DefaultRunUpdatePollInterval)
+}
+
+// GetNotificationAllowedHosts returns the comma separated hosts that the
+// notification webhooks may be posted to even though they resolve to
+// addresses internal to the cluster.
+func GetNotificationAllowedHosts() []string {
+	hosts := []string{}
+	for _, host := range strings.Split(GetStringConfigWithDefault(NotificationAllowedHosts, ""), ",") {
+		host = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+		if host != "" {
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
+
+// GetExecutionType returns the type of the executions that runs are driven by,
+// Argo Workflow by default.
+func GetExecutionType() util.ExecutionType {
+	return util.ExecutionType(GetStringConfigWithDefault(ExecutionType, string(util.ArgoWorkflow)))
+}
@@ -15,6 +15,8 @@
 package common
 
 import (
+	"time"
+
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
@@ -34,26 +36,30 @@ const (
 	RbacPipelinesGroup   = "pipelines.kubeflow.org"
 	RbacPipelinesVersion = "v1beta1"
 
-	RbacResourceTypePipelines      = "pipelines"
-	RbacResourceTypeExperiments    = "experiments"
-	RbacResourceTypeRuns           = "runs"
-	RbacResourceTypeJobs           = "jobs"
-	RbacResourceTypeViewers        = "viewers"
-	RbacResourceTypeVisualizations = "visualizations"
-
-	RbacResourceVerbArchive       = "archive"
-	RbacResourceVerbUpdate        = "update"
-	RbacResourceVerbCreate        = "create"
-	RbacResourceVerbDelete        = "delete"
-	RbacResourceVerbDisable       = "disable"
-	RbacResourceVerbEnable        = "enable"
-	RbacResourceVerbGet           = "get"
-	RbacResourceVerbList          = "list"
-	RbacResourceVerbRetry         = "retry"
-	RbacResourceVerbTerminate     = "terminate"
-	RbacResourceVerbUnarchive     = "unarchive"
-	RbacResourceVerbReportMetrics = "reportMetrics"
-	RbacResourceVerbReadArtifact  = "readArtifact"
+	RbacResourceTypePipelines                 = "pipelines"
+	RbacResourceTypeExperiments               = "experiments"
+	RbacResourceTypeRuns                      = "runs"
+	RbacResourceTypeJobs                      = "jobs"
+	RbacResourceTypeViewers                   = "viewers"
+	RbacResourceTypeVisualizations            = "visualizations"
+	RbacResourceTypeNotificationSubscriptions = "notificationsubscriptions"
+
+	RbacResourceVerbArchive         = "archive"
+	RbacResourceVerbUpdate          = "update"
+	RbacResourceVerbCreate          = "create"
+	RbacResourceVerbDelete          = "delete"
+	RbacResourceVerbDisable         = "disable"
+	RbacResourceVerbEnable          = "enable"
+	RbacResourceVerbGet             = "get"
+	RbacResourceVerbList            = "list"
+	RbacResourceVerbRetry           = "retry"
+	RbacResourceVerbTerminate       = "terminate"
+	RbacResourceVerbUnarchive       = "unarchive"
+	RbacResourceVerbReportMetrics   = "reportMetrics"
+	RbacResourceVerbReadArtifact    = "readArtifact"
+	RbacResourceVerbTrigger         = "trigger"
+	RbacResourceVerbBackfill        = "backfill"
+	RbacResourceVerbInvalidateCache = "invalidateCache"
 )
 
 const (
@@ -70,6 +76,18 @@ const (
 
 const DefaultTokenReviewAudience string = "pipelines.kubeflow.org"
 
+const DefaultBatchRunsChunkSize int = 50
+
+const DefaultRunRetentionInterval time.Duration = time.Hour
+
+const DefaultNotificationDispatchInterval time.Duration = 10 * time.Second
+
+const DefaultRunUpdatePollInterval time.Duration = time.Second
+
+// The run updates are polled at most this often, even while runs keep being
+// updated.
+const MinRunUpdatePollInterval time.Duration = 100 * time.Millisecond
+
 func ToModelResourceType(apiType api.ResourceType) (model.ResourceType, error) {
 	switch apiType {
 	case api.ResourceType_EXPERIMENT:
@@ -140,16 +140,43 @@ func NewWithKeyMap(filterProto *api.Filter, keyMap map[string]string, modelName
 		modelNamePrefix = modelName + "."
 	}
 
-	for _, pred := range filterProto.Predicates {
-		k, ok := keyMap[pred.Key]
+	return NewWithKeyMapper(filterProto, func(key string) (string, bool) {
+		k, ok := keyMap[key]
 		if !ok {
-			return nil, util.NewInvalidInputError("no support for filtering on unrecognized field %q", pred.Key)
+			return "", false
 		}
-		pred.Key = modelNamePrefix + k
+		return modelNamePrefix + k, true
+	})
+}
+
+// NewWithKeyMapper is like New, but maps the key names in the protocol buffer
+// with the supplied function, which returns the column name or SQL expression
+// to use when querying the model, or false if the key is not supported.
+func NewWithKeyMapper(filterProto *api.Filter, mapKey func(key string) (string, bool)) (*Filter, error) {
+	if err := mapPredicateKeys(filterProto.Predicates, filterProto.Groups, mapKey); err != nil {
+		return nil, err
 	}
 	return New(filterProto)
 }
 
+// mapPredicateKeys maps the keys of the predicates, including the predicates in
+// nested groups.
+func mapPredicateKeys(predicates []*api.Predicate, groups []*api.PredicateGroup, mapKey func(key string) (string, bool)) error {
+	for _, pred := range predicates {
+		k, ok := mapKey(pred.Key)
+		if !ok {
+			return util.NewInvalidInputError("no support for filtering on unrecognized field %q", pred.Key)
+		}
+		pred.Key = k
+	}
+	for _, group := range groups {
+		if err := mapPredicateKeys(group.Predicates, group.Groups, mapKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // AddToSelect builds a WHERE clause from the Filter f, adds it to the supplied
 // SelectBuilder object and returns it for use in SQL queries.
 func (f *Filter) AddToSelect(sb squirrel.SelectBuilder) squirrel.SelectBuilder {
@@ -213,9 +240,76 @@ func (f *Filter) AddToSelect(sb squirrel.SelectBuilder) squirrel.SelectBuilder {
 		}
 	}
 
+	// Groups are compiled from the filter proto, which is validated when the
+	// filter is created and is preserved when the filter is marshalled.
+	for _, group := range f.filterProto.GetGroups() {
+		sb = sb.Where(predicateGroupToSqlizer(group))
+	}
+
 	return sb
 }
 
+// not negates a condition, as squirrel has no expression for NOT.
+type not struct {
+	condition squirrel.Sqlizer
+}
+
+func (n not) ToSql() (string, []interface{}, error) {
+	sql, args, err := n.condition.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf("NOT %s", sql), args, nil
+}
+
+func predicateGroupToSqlizer(g *api.PredicateGroup) squirrel.Sqlizer {
+	var conditions []squirrel.Sqlizer
+	for _, pred := range g.Predicates {
+		conditions = append(conditions, predicateToSqlizer(pred))
+	}
+	for _, group := range g.Groups {
+		conditions = append(conditions, predicateGroupToSqlizer(group))
+	}
+
+	switch g.Op {
+	case api.PredicateGroup_OR:
+		return squirrel.Or(conditions)
+	case api.PredicateGroup_NOT:
+		return not{squirrel.And(conditions)}
+	default:
+		return squirrel.And(conditions)
+	}
+}
+
+func predicateToSqlizer(p *api.Predicate) squirrel.Sqlizer {
+	switch p.Op {
+	case api.Predicate_IS_NULL:
+		return squirrel.Eq{p.Key: nil}
+	case api.Predicate_IS_NOT_NULL:
+		return squirrel.NotEq{p.Key: nil}
+	}
+
+	// The value is checked when the filter is created.
+	v, _ := predicateValue(p)
+	switch p.Op {
+	case api.Predicate_NOT_EQUALS:
+		return squirrel.NotEq{p.Key: v}
+	case api.Predicate_GREATER_THAN:
+		return squirrel.Gt{p.Key: v}
+	case api.Predicate_GREATER_THAN_EQUALS:
+		return squirrel.GtOrEq{p.Key: v}
+	case api.Predicate_LESS_THAN:
+		return squirrel.Lt{p.Key: v}
+	case api.Predicate_LESS_THAN_EQUALS:
+		return squirrel.LtOrEq{p.Key: v}
+	case api.Predicate_IS_SUBSTRING:
+		return squirrel.Like{p.Key: fmt.Sprintf("%%%s%%", v)}
+	default:
+		// EQUALS and IN.
+		return squirrel.Eq{p.Key: v}
+	}
+}
+
 func checkPredicate(p *api.Predicate) error {
 	switch p.Op {
 	case api.Predicate_IN:
@@ -238,6 +332,11 @@ func checkPredicate(p *api.Predicate) error {
 			return util.NewInvalidInputError("cannot use non string value type %T with operator %v", p.Op, t)
 		}
 
+	case api.Predicate_IS_NULL, api.Predicate_IS_NOT_NULL:
+		if p.Value != nil {
+			return util.NewInvalidInputError("cannot use value type %T with operator %v", p.Value, p.Op)
+		}
+
 	default:
 		return util.NewInvalidInputError("invalid predicate operation: %v", p.Op)
 	}
@@ -245,12 +344,58 @@ func checkPredicate(p *api.Predicate) error {
 	return nil
 }
 
+func checkPredicateGroup(g *api.PredicateGroup) error {
+	switch g.Op {
+	case api.PredicateGroup_AND, api.PredicateGroup_OR, api.PredicateGroup_NOT:
+	default:
+		return util.NewInvalidInputError("invalid predicate group operation: %v", g.Op)
+	}
+	if len(g.Predicates) == 0 && len(g.Groups) == 0 {
+		return util.NewInvalidInputError("predicate group with operation %v is empty", g.Op)
+	}
+
+	for _, pred := range g.Predicates {
+		if err := checkPredicate(pred); err != nil {
+			return err
+		}
+		if pred.Op == api.Predicate_IS_NULL || pred.Op == api.Predicate_IS_NOT_NULL {
+			continue
+		}
+		if _, err := predicateValue(pred); err != nil {
+			return err
+		}
+	}
+	for _, group := range g.Groups {
+		if err := checkPredicateGroup(group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (f *Filter) parseFilterProto() error {
+	for _, group := range f.filterProto.Groups {
+		if err := checkPredicateGroup(group); err != nil {
+			return err
+		}
+	}
+
 	for _, pred := range f.filterProto.Predicates {
 		if err := checkPredicate(pred); err != nil {
 			return err
 		}
 
+		// Null checks are compiled by squirrel from nil values, so they are kept
+		// with the equality predicates.
+		switch pred.Op {
+		case api.Predicate_IS_NULL:
+			f.eq[pred.Key] = append(f.eq[pred.Key], nil)
+			continue
+		case api.Predicate_IS_NOT_NULL:
+			f.neq[pred.Key] = append(f.neq[pred.Key], nil)
+			continue
+		}
+
 		var m map[string][]interface{}
 		switch pred.Op {
 		case api.Predicate_EQUALS:
@@ -282,47 +427,54 @@ func (f *Filter) parseFilterProto() error {
 }
 
 func addPredicateValue(m map[string][]interface{}, p *api.Predicate) error {
+	v, err := predicateValue(p)
+	if err != nil {
+		return err
+	}
+	m[p.Key] = append(m[p.Key], v)
+	return nil
+}
+
+func predicateValue(p *api.Predicate) (interface{}, error) {
 	switch t := p.Value.(type) {
 	case *api.Predicate_IntValue:
-		m[p.Key] = append(m[p.Key], p.GetIntValue())
+		return p.GetIntValue(), nil
 	case *api.Predicate_LongValue:
-		m[p.Key] = append(m[p.Key], p.GetLongValue())
+		return p.GetLongValue(), nil
 	case *api.Predicate_StringValue:
-		m[p.Key] = append(m[p.Key], p.GetStringValue())
+		return p.GetStringValue(), nil
 	case *api.Predicate_TimestampValue:
 		ts, err := ptypes.Timestamp(p.GetTimestampValue())
 		if err != nil {
-			return util.NewInvalidInputError("invalid timestamp: %v", err)
+			return nil, util.NewInvalidInputError("invalid timestamp: %v", err)
 		}
-		m[p.Key] = append(m[p.Key], ts.Unix())
+		return ts.Unix(), nil
 
 	case *api.Predicate_IntValues:
 		var v []int32
 		for _, i := range p.GetIntValues().GetValues() {
 			v = append(v, i)
 		}
-		m[p.Key] = append(m[p.Key], v)
+		return v, nil
 
 	case *api.Predicate_LongValues:
 		var v []int64
 		for _, i := range p.GetLongValues().GetValues() {
 			v = append(v, i)
 		}
-		m[p.Key] = append(m[p.Key], v)
+		return v, nil
 
 	case *api.Predicate_StringValues:
 		var v []string
 		for _, i := range p.GetStringValues().GetValues() {
 			v = append(v, i)
 		}
-		m[p.Key] = append(m[p.Key], v)
+		return v, nil
 
 	case nil:
-		return util.NewInvalidInputError("no value set for predicate on key %q", p.Key)
+		return nil, util.NewInvalidInputError("no value set for predicate on key %q", p.Key)
 
 	default:
-		return util.NewInvalidInputError("unknown value type in Filter for predicate key %q: %T", p.Key, t)
+		return nil, util.NewInvalidInputError("unknown value type in Filter for predicate key %q: %T", p.Key, t)
 	}
-
-	return nil
 }
@@ -72,6 +72,20 @@ func TestValidNewFilters(t *testing.T) {
 				key: "label" op: IS_SUBSTRING string_value: "label_substring" }`,
 			&Filter{substring: map[string][]interface{}{"label": {"label_substring"}}},
 		},
+		{
+			`predicates { key: "finished_at" op: IS_NULL }`,
+			&Filter{eq: map[string][]interface{}{"finished_at": {nil}}},
+		},
+		{
+			`predicates { key: "finished_at" op: IS_NOT_NULL }`,
+			&Filter{neq: map[string][]interface{}{"finished_at": {nil}}},
+		},
+		{
+			`groups { op: OR
+				predicates { key: "status" op: EQUALS string_value: "Failed" }
+				predicates { key: "status" op: EQUALS string_value: "Error" } }`,
+			&Filter{},
+		},
 	}
 
 	for _, test := range tests {
@@ -205,6 +219,28 @@ func TestInvalidFilters(t *testing.T) {
 			`predicates { key: "total" op: LESS_THAN
 				timestamp_value { seconds: -100000000000 }}`,
 		},
+		// Value with null check
+		{
+			`predicates { key: "total" op: IS_NULL int_value: 10 }`,
+		},
+		// Invalid predicate group operation
+		{
+			`groups { predicates { key: "total" op: EQUALS int_value: 10 } }`,
+		},
+		// Empty predicate group
+		{
+			`groups { op: OR }`,
+		},
+		// Invalid predicate in a nested group
+		{
+			`groups { op: OR
+				predicates { key: "total" op: EQUALS int_value: 10 }
+				groups { op: NOT predicates { key: "total" op: IN int_value: 10 } } }`,
+		},
+		// No value in a group
+		{
+			`groups { op: AND predicates { key: "total" op: EQUALS } }`,
+		},
 	}
 
 	for _, test := range tests {
@@ -287,6 +323,40 @@ func TestAddToSelect(t *testing.T) {
 			"SELECT mycolumn WHERE label LIKE ? AND label LIKE ?",
 			[]interface{}{"%label_substring1%", "%label_substring2%"},
 		},
+		{
+			`predicates { key: "finished_at" op: IS_NULL }`,
+			"SELECT mycolumn WHERE finished_at IS NULL",
+			nil,
+		},
+		{
+			`predicates { key: "finished_at" op: IS_NOT_NULL }`,
+			"SELECT mycolumn WHERE finished_at IS NOT NULL",
+			nil,
+		},
+		{
+			`groups { op: OR
+				predicates { key: "status" op: EQUALS string_value: "Failed" }
+				predicates { key: "status" op: EQUALS string_value: "Error" } }`,
+			"SELECT mycolumn WHERE (status = ? OR status = ?)",
+			[]interface{}{"Failed", "Error"},
+		},
+		{
+			`groups { op: NOT
+				predicates { key: "status" op: EQUALS string_value: "Running" }
+				predicates { key: "label" op: IS_SUBSTRING string_value: "test" } }`,
+			"SELECT mycolumn WHERE NOT (status = ? AND label LIKE ?)",
+			[]interface{}{"Running", "%test%"},
+		},
+		{
+			`predicates { key: "total" op: GREATER_THAN int_value: 10 }
+			 groups { op: OR
+				predicates { key: "finished_at" op: IS_NULL }
+				groups { op: AND
+					predicates { key: "status" op: IN string_values { values: "Failed" values: "Error" } }
+					groups { op: NOT predicates { key: "finished_at" op: LESS_THAN timestamp_value { seconds: 10 } } } } }`,
+			"SELECT mycolumn WHERE total > ? AND (finished_at IS NULL OR (status IN (?,?) AND NOT (finished_at < ?)))",
+			[]interface{}{int32(10), "Failed", "Error", int64(10)},
+		},
 	}
 
 	for _, test := range tests {
@@ -352,3 +422,67 @@ func TestUnmarshalJSON(t *testing.T) {
 		t.Errorf("json.Unmarshal(%+v):\nGot: %v, Error: %v\nWant:\n%+v, Error: nil\nDiff:%s\n", in, got, err, want, cmp.Diff(want, got, cmp.AllowUnexported(Filter{})))
 	}
 }
+
+func TestNewWithKeyMap_Groups(t *testing.T) {
+	filterProto := &api.Filter{}
+	protoStr := `groups { op: OR
+		predicates { key: "name" op: EQUALS string_value: "pipeline" }
+		groups { op: NOT predicates { key: "description" op: IS_NULL } } }`
+	if err := proto.UnmarshalText(protoStr, filterProto); err != nil {
+		t.Fatalf("Failed to unmarshal Filter text proto\n%q\nError: %v", protoStr, err)
+	}
+
+	keyMap := map[string]string{"name": "Name", "description": "Description"}
+	filter, err := NewWithKeyMap(filterProto, keyMap, "pipelines")
+	if err != nil {
+		t.Fatalf("NewWithKeyMap(%+v) = %v\nWant nil error", filterProto, err)
+	}
+	gotSQL, gotArgs, err := filter.AddToSelect(squirrel.Select("mycolumn")).ToSql()
+	wantSQL := "SELECT mycolumn WHERE (pipelines.Name = ? OR NOT (pipelines.Description IS NULL))"
+	wantArgs := []interface{}{"pipeline"}
+	if gotSQL != wantSQL || !cmp.Equal(gotArgs, wantArgs) || err != nil {
+		t.Errorf("Filter.AddToSelect().ToSql() =\nGot: %+v, %v, %v\nWant: %+v, %+v, <nil>", gotSQL, gotArgs, err, wantSQL, wantArgs)
+	}
+
+	filterProto = &api.Filter{}
+	protoStr = `groups { op: OR groups { op: AND predicates { key: "unknown" op: IS_NULL } } }`
+	if err := proto.UnmarshalText(protoStr, filterProto); err != nil {
+		t.Fatalf("Failed to unmarshal Filter text proto\n%q\nError: %v", protoStr, err)
+	}
+	if _, err := NewWithKeyMap(filterProto, keyMap, "pipelines"); err == nil {
+		t.Errorf("NewWithKeyMap(%+v) = <nil>\nWant non-nil error for unrecognized nested key", filterProto)
+	}
+}
+
+func TestMarshalJSON_RoundTrip(t *testing.T) {
+	filterProto := &api.Filter{}
+	protoStr := `predicates { key: "finished_at" op: IS_NOT_NULL }
+		groups { op: OR
+			predicates { key: "status" op: EQUALS string_value: "Failed" }
+			groups { op: NOT predicates { key: "total" op: IN long_values { values: 1 values: 2 } } } }`
+	if err := proto.UnmarshalText(protoStr, filterProto); err != nil {
+		t.Fatalf("Failed to unmarshal Filter text proto\n%q\nError: %v", protoStr, err)
+	}
+	f, err := New(filterProto)
+	if err != nil {
+		t.Fatalf("New(%+v) = %v\nWant nil error", filterProto, err)
+	}
+
+	b, err := json.Marshal(f)
+	if err != nil {
+		t.Fatalf("json.Marshal(%+v) = %v\nWant nil error", f, err)
+	}
+	got := &Filter{}
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("json.Unmarshal(%s) = %v\nWant nil error", b, err)
+	}
+	if !cmp.Equal(got, f, cmpopts.EquateEmpty(), protocmp.Transform(), cmp.AllowUnexported(Filter{})) {
+		t.Errorf("json.Unmarshal(json.Marshal(%+v)) = %+v\nDiff:%s\n", f, got, cmp.Diff(f, got, protocmp.Transform(), cmp.AllowUnexported(Filter{})))
+	}
+
+	wantSQL, wantArgs, _ := f.AddToSelect(squirrel.Select("mycolumn")).ToSql()
+	gotSQL, gotArgs, err := got.AddToSelect(squirrel.Select("mycolumn")).ToSql()
+	if gotSQL != wantSQL || !cmp.Equal(gotArgs, wantArgs) || err != nil {
+		t.Errorf("Filter.AddToSelect().ToSql() after round trip =\nGot: %+v, %v, %v\nWant: %+v, %+v, <nil>", gotSQL, gotArgs, err, wantSQL, wantArgs)
+	}
+}
@@ -164,7 +164,12 @@ func NewOptions(listable Listable, pageSize int, sortBy string, filterProto *api
 
 	// Filtering.
 	if filterProto != nil {
-		f, err := filter.NewWithKeyMap(filterProto, listable.APIToModelFieldMap(), listable.GetModelName())
+		var f *filter.Filter
+		if l, ok := listable.(KeyExpressionFilterable); ok {
+			f, err = filter.NewWithKeyMapper(filterProto, l.GetFilterKeyExpression)
+		} else {
+			f, err = filter.NewWithKeyMap(filterProto, listable.APIToModelFieldMap(), listable.GetModelName())
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -321,6 +326,15 @@ type Listable interface {
 	GetFieldValue(name string) interface{}
 }
 
+// KeyExpressionFilterable is implemented by listables that can be filtered on
+// keys that are not mapped to columns of the model, such as the metrics of a
+// run.
+type KeyExpressionFilterable interface {
+	// GetFilterKeyExpression returns the column name or SQL expression to
+	// filter on for the given API key, or false if the key is not supported.
+	GetFilterKeyExpression(key string) (string, bool)
+}
+
 // NextPageToken returns a string that can be used to fetch the subsequent set
 // of results using the same listing options in o, starting with listable as the
 // first record.
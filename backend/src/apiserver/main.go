@@ -28,6 +28,9 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	// Embed the time zone database to validate the time zones of the schedules
+	// in images without one.
+	_ "time/tzdata"
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/golang/glog"
@@ -37,6 +40,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/server"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -59,6 +63,10 @@ func main() {
 	flag.Parse()
 
 	initConfig()
+	// Pipelines and scheduled workflows are only compiled to Argo Workflows.
+	if execType := common.GetExecutionType(); execType != util.ArgoWorkflow {
+		glog.Fatalf("Not supported type of Execution %s: only %s is supported", execType, util.ArgoWorkflow)
+	}
 	clientManager := newClientManager()
 	resourceManager := resource.NewResourceManager(&clientManager)
 	err := loadSamples(resourceManager)
@@ -71,6 +79,9 @@ func main() {
 		glog.Fatalf("Failed to create default experiment. Err: %v", err)
 	}
 
+	go startRunRetentionReconciler(resourceManager)
+	go startNotificationDispatcher(resourceManager)
+	go startRunUpdatePoller(resourceManager)
 	go startRpcServer(resourceManager)
 	startHttpProxy(resourceManager)
 
@@ -92,11 +103,12 @@ func startRpcServer(resourceManager *resource.ResourceManager) {
 	if err != nil {
 		glog.Fatalf("Failed to start RPC server: %v", err)
 	}
-	s := grpc.NewServer(grpc.UnaryInterceptor(apiServerInterceptor), grpc.MaxRecvMsgSize(math.MaxInt32))
+	s := grpc.NewServer(grpc.UnaryInterceptor(apiServerInterceptor), grpc.StreamInterceptor(apiServerStreamInterceptor), grpc.MaxRecvMsgSize(math.MaxInt32))
 	api.RegisterPipelineServiceServer(s, server.NewPipelineServer(resourceManager, &server.PipelineServerOptions{CollectMetrics: *collectMetricsFlag}))
 	api.RegisterExperimentServiceServer(s, server.NewExperimentServer(resourceManager, &server.ExperimentServerOptions{CollectMetrics: *collectMetricsFlag}))
 	api.RegisterRunServiceServer(s, server.NewRunServer(resourceManager, &server.RunServerOptions{CollectMetrics: *collectMetricsFlag}))
 	api.RegisterTaskServiceServer(s, server.NewTaskServer(resourceManager))
+	api.RegisterNotificationServiceServer(s, server.NewNotificationServer(resourceManager))
 	api.RegisterJobServiceServer(s, server.NewJobServer(resourceManager, &server.JobServerOptions{CollectMetrics: *collectMetricsFlag}))
 	api.RegisterReportServiceServer(s, server.NewReportServer(resourceManager))
 	api.RegisterVisualizationServiceServer(
@@ -130,6 +142,7 @@ func startHttpProxy(resourceManager *resource.ResourceManager) {
 	registerHttpHandlerFromEndpoint(api.RegisterJobServiceHandlerFromEndpoint, "JobService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(api.RegisterRunServiceHandlerFromEndpoint, "RunService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(api.RegisterTaskServiceHandlerFromEndpoint, "TaskService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(api.RegisterNotificationServiceHandlerFromEndpoint, "NotificationService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(api.RegisterReportServiceHandlerFromEndpoint, "ReportService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(api.RegisterVisualizationServiceHandlerFromEndpoint, "Visualization", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(api.RegisterAuthServiceHandlerFromEndpoint, "AuthService", ctx, runtimeMux)
@@ -152,6 +165,11 @@ func startHttpProxy(resourceManager *resource.ResourceManager) {
 	runLogServer := server.NewRunLogServer(resourceManager)
 	topMux.HandleFunc("/apis/v1alpha1/runs/{run_id}/nodes/{node_id}/log", runLogServer.ReadRunLog)
 
+	// run watches are streamed as server-sent events via HTTP.
+	runWatchServer := server.NewRunWatchServer(resourceManager)
+	topMux.HandleFunc("/apis/v1beta1/runs:watch", runWatchServer.WatchRuns).Methods(http.MethodGet)
+	topMux.HandleFunc("/apis/v1beta1/runs/{run_id}:watch", runWatchServer.WatchRun).Methods(http.MethodGet)
+
 	topMux.PathPrefix("/apis/").Handler(runtimeMux)
 
 	// Register a handler for Prometheus to poll.
@@ -161,6 +179,71 @@ func startHttpProxy(resourceManager *resource.ResourceManager) {
 	glog.Info("Http Proxy started")
 }
 
+// startRunRetentionReconciler periodically archives and deletes the runs that
+// expired under the run retention policies in the config.
+func startRunRetentionReconciler(resourceManager *resource.ResourceManager) {
+	policies := &resource.RunRetentionPolicies{}
+	if err := viper.UnmarshalKey(common.RunRetentionPolicies, policies); err != nil {
+		glog.Fatalf("Failed to parse run retention policies. Err: %v", err)
+	}
+	if !policies.IsEnabled() {
+		glog.Info("No run retention policy configured")
+		return
+	}
+	interval := common.GetRunRetentionInterval()
+	dryRun := common.IsRunRetentionDryRun()
+	glog.Infof("Starting run retention reconciler. Interval: %v, dry run: %v", interval, dryRun)
+	for {
+		report, err := resourceManager.EnforceRunRetentionPolicies(context.Background(), policies, dryRun)
+		if err != nil {
+			glog.Errorf("Failed to enforce run retention policies. Err: %v", err)
+		} else if dryRun {
+			glog.Infof("Run retention dry run. Runs to archive: %v. Runs to delete: %v",
+				report.ArchivedRunIDs, report.DeletedRunIDs)
+		} else {
+			glog.Infof("Run retention archived %v runs and deleted %v runs",
+				len(report.ArchivedRunIDs), len(report.DeletedRunIDs))
+		}
+		time.Sleep(interval)
+	}
+}
+
+// startNotificationDispatcher periodically delivers the pending notifications
+// of the runs that entered a subscribed state.
+func startNotificationDispatcher(resourceManager *resource.ResourceManager) {
+	interval := common.GetNotificationDispatchInterval()
+	glog.Infof("Starting notification dispatcher. Interval: %v", interval)
+	for {
+		count, err := resourceManager.DeliverPendingNotifications(context.Background())
+		if err != nil {
+			glog.Errorf("Failed to deliver notifications. Err: %v", err)
+		}
+		// Keep delivering while deliveries are due.
+		if err != nil || count == 0 {
+			time.Sleep(interval)
+		}
+	}
+}
+
+// startRunUpdatePoller periodically notifies the run watchers of this API
+// server of the runs updated through the other API servers.
+func startRunUpdatePoller(resourceManager *resource.ResourceManager) {
+	interval := common.GetRunUpdatePollInterval()
+	glog.Infof("Starting run update poller. Interval: %v", interval)
+	for {
+		count, err := resourceManager.PollRunUpdates()
+		if err != nil {
+			glog.Errorf("Failed to poll run updates. Err: %v", err)
+		}
+		// Keep polling while runs are updated.
+		if err != nil || count == 0 {
+			time.Sleep(interval)
+		} else {
+			time.Sleep(common.MinRunUpdatePollInterval)
+		}
+	}
+}
+
 func registerHttpHandlerFromEndpoint(handler RegisterHttpHandlerFromEndpoint, serviceName string, ctx context.Context, mux *runtime.ServeMux) {
 	endpoint := "localhost" + *rpcPortFlag
 	opts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(math.MaxInt32))}
@@ -32,6 +32,13 @@ type Job struct {
 	Trigger
 	PipelineSpec
 	Conditions string `gorm:"column:Conditions; not null"`
+
+	// How a run is triggered while MaxConcurrency runs are active: Allow,
+	// Forbid or Replace. Empty means Allow.
+	ConcurrencyPolicy string `gorm:"column:ConcurrencyPolicy; not null; default:''"`
+	// Deadline in seconds for starting a run after its scheduled time. 0 means
+	// no deadline.
+	StartingDeadlineSeconds int64 `gorm:"column:StartingDeadlineSeconds; not null; default:0"`
 }
 
 // Trigger specifies when to create a new workflow.
@@ -40,6 +47,8 @@ type Trigger struct {
 	CronSchedule
 	// Create workflows periodically.
 	PeriodicSchedule
+	// Create workflows when runs of an upstream resource complete.
+	RunCompletionTrigger
 }
 
 type CronSchedule struct {
@@ -54,6 +63,10 @@ type CronSchedule struct {
 	// Cron string describing when a workflow should be created within the
 	// time interval defined by StartTime and EndTime.
 	Cron *string `gorm:"column:Schedule;"`
+
+	// IANA time zone in which the cron string is interpreted. If no time zone
+	// is specified, the time zone of the controller is used.
+	CronScheduleTimeZone *string `gorm:"column:CronScheduleTimeZone;"`
 }
 
 type PeriodicSchedule struct {
@@ -70,6 +83,18 @@ type PeriodicSchedule struct {
 	IntervalSecond *int64 `gorm:"column:IntervalSecond;"`
 }
 
+type RunCompletionTrigger struct {
+	// Type of the upstream resource, either Job or PipelineVersion.
+	RunCompletionUpstreamType *string `gorm:"column:RunCompletionUpstreamType;"`
+
+	// ID of the upstream resource.
+	RunCompletionUpstreamId *string `gorm:"column:RunCompletionUpstreamId;"`
+
+	// Comma-separated terminal states of the upstream runs that trigger a
+	// workflow.
+	RunCompletionStates *string `gorm:"column:RunCompletionStates;"`
+}
+
 func (j Job) GetValueOfPrimaryKey() string {
 	return fmt.Sprint(j.UUID)
 }
@@ -0,0 +1,65 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "strings"
+
+const (
+	// RunTerminatedState is the state notified when a run that was terminated
+	// reaches its final condition.
+	RunTerminatedState string = "Terminated"
+
+	NotificationDeliveryPending   string = "PENDING"
+	NotificationDeliverySucceeded string = "SUCCEEDED"
+	NotificationDeliveryFailed    string = "FAILED"
+)
+
+// NotificationSubscription subscribes a webhook to the runs of an experiment,
+// a job or a namespace entering some states.
+type NotificationSubscription struct {
+	UUID           string       `gorm:"column:UUID; not null; primary_key"`
+	ResourceType   ResourceType `gorm:"column:ResourceType; not null; index:idx_notification_subscription_resource"`
+	ResourceUUID   string       `gorm:"column:ResourceUUID; not null; index:idx_notification_subscription_resource"`
+	States         string       `gorm:"column:States; not null"` /* Comma separated run states */
+	URL            string       `gorm:"column:URL; not null; size:2048"`
+	Secret         string       `gorm:"column:Secret; not null"`
+	CreatedAtInSec int64        `gorm:"column:CreatedAtInSec; not null"`
+}
+
+// HasState returns whether the subscription is notified of the run state.
+func (s *NotificationSubscription) HasState(state string) bool {
+	for _, subscribed := range strings.Split(s.States, ",") {
+		if subscribed == state {
+			return true
+		}
+	}
+	return false
+}
+
+// NotificationDelivery is the delivery of the summary of a run that entered a
+// state to the webhook of a subscription.
+type NotificationDelivery struct {
+	UUID               string `gorm:"column:UUID; not null; primary_key"`
+	SubscriptionUUID   string `gorm:"column:SubscriptionUUID; not null; unique_index:idx_notification_delivery_run_state"`
+	RunUUID            string `gorm:"column:RunUUID; not null; unique_index:idx_notification_delivery_run_state"`
+	State              string `gorm:"column:State; not null; unique_index:idx_notification_delivery_run_state"`
+	Status             string `gorm:"column:Status; not null; index"`
+	Attempts           int32  `gorm:"column:Attempts; not null"`
+	ResponseCode       int32  `gorm:"column:ResponseCode; not null"`
+	Error              string `gorm:"column:Error; not null; size:65535"`
+	NextAttemptAtInSec int64  `gorm:"column:NextAttemptAtInSec; not null"`
+	CreatedAtInSec     int64  `gorm:"column:CreatedAtInSec; not null"`
+	UpdatedAtInSec     int64  `gorm:"column:UpdatedAtInSec; not null"`
+}
@@ -14,6 +14,13 @@
 
 package model
 
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
 type PipelineSpec struct {
 	// Pipeline ID will be optional. It's available only if the resource is created through
 	// a pipeline ID.
@@ -38,3 +45,61 @@ type PipelineSpec struct {
 	// Runtime config of the pipeline, only used for v2 API.
 	RuntimeConfig
 }
+
+// ParameterValues returns the values of the v1 parameters and the v2 runtime
+// parameters by name. Runtime parameters that aren't strings are formatted as
+// JSON, with numbers in their shortest decimal representation.
+func (p PipelineSpec) ParameterValues() (map[string]string, error) {
+	values := make(map[string]string)
+	if p.Parameters != "" {
+		var params []struct {
+			Name  string  `json:"name"`
+			Value *string `json:"value"`
+		}
+		if err := json.Unmarshal([]byte(p.Parameters), &params); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal parameters '%s': %v", p.Parameters, err)
+		}
+		for _, param := range params {
+			if param.Value != nil {
+				values[param.Name] = *param.Value
+			}
+		}
+	}
+	if p.RuntimeConfig.Parameters != "" {
+		var params map[string]interface{}
+		if err := json.Unmarshal([]byte(p.RuntimeConfig.Parameters), &params); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal runtime parameters '%s': %v", p.RuntimeConfig.Parameters, err)
+		}
+		for name, param := range params {
+			switch v := param.(type) {
+			case nil:
+			case string:
+				values[name] = v
+			case float64:
+				values[name] = strconv.FormatFloat(v, 'f', -1, 64)
+			default:
+				b, err := json.Marshal(v)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal runtime parameter %q: %v", name, err)
+				}
+				values[name] = string(b)
+			}
+		}
+	}
+	return values, nil
+}
+
+// RunParameters returns the parameter values of the run with the given ID,
+// sorted by name.
+func (p PipelineSpec) RunParameters(runUUID string) ([]*RunParameter, error) {
+	values, err := p.ParameterValues()
+	if err != nil {
+		return nil, err
+	}
+	params := make([]*RunParameter, 0, len(values))
+	for name, value := range values {
+		params = append(params, &RunParameter{RunUUID: runUUID, Name: name, Value: value})
+	}
+	sort.Slice(params, func(i, j int) bool { return params[i].Name < params[j].Name })
+	return params, nil
+}
@@ -15,6 +15,8 @@
 package model
 
 import (
+	"fmt"
+	"regexp"
 	"strings"
 )
 
@@ -22,6 +24,15 @@ const (
 	RunTerminatingConditions string = "Terminating"
 )
 
+const (
+	runMetricKeyPrefix    = "metric:"
+	runParameterKeyPrefix = "parameter:"
+)
+
+// Metric and parameter names are embedded in queries, so only names that are
+// safe to quote are supported as filtering and sorting keys.
+var runKeyNameRegex = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
 type Run struct {
 	UUID               string `gorm:"column:UUID; not null; primary_key"`
 	ExperimentUUID     string `gorm:"column:ExperimentUUID; not null;"`
@@ -60,6 +71,14 @@ type RunMetric struct {
 	Payload     string  `gorm:"column:Payload; not null; size:65535"`
 }
 
+// RunParameter is the value of a parameter of a run, which is stored in its own
+// table so that runs can be filtered and sorted by it.
+type RunParameter struct {
+	RunUUID string `gorm:"column:RunUUID; not null; primary_key"`
+	Name    string `gorm:"column:Name; not null; primary_key"`
+	Value   string `gorm:"column:Value; not null; size:65535"`
+}
+
 func (r Run) GetValueOfPrimaryKey() string {
 	return r.UUID
 }
@@ -104,8 +123,44 @@ func (r *Run) GetField(name string) (string, bool) {
 	if field, ok := runAPIToModelFieldMap[name]; ok {
 		return field, true
 	}
-	if strings.HasPrefix(name, "metric:") {
-		return name[7:], true
+	if strings.HasPrefix(name, runMetricKeyPrefix) {
+		return name[len(runMetricKeyPrefix):], true
+	}
+	// Parameter names aren't necessarily valid SQL identifiers, so the sorting
+	// field is the quoted key.
+	if parameterName := strings.TrimPrefix(name, runParameterKeyPrefix); parameterName != name &&
+		runKeyNameRegex.MatchString(parameterName) {
+		return "`" + name + "`", true
+	}
+	return "", false
+}
+
+// GetRunParameterName returns the name of the parameter if the given sorting
+// field is a run parameter.
+func GetRunParameterName(field string) (string, bool) {
+	if !strings.HasPrefix(field, "`"+runParameterKeyPrefix) || !strings.HasSuffix(field, "`") {
+		return "", false
+	}
+	return field[len(runParameterKeyPrefix)+1 : len(field)-1], true
+}
+
+// GetFilterKeyExpression returns the SQL expression to filter runs on for the
+// given API key. Besides the columns of the run table, runs can be filtered on
+// "metric:<name>", the largest value of the metric reported by any node of the
+// run, and "parameter:<name>", the string value of the parameter of the run.
+func (r *Run) GetFilterKeyExpression(key string) (string, bool) {
+	if field, ok := runAPIToModelFieldMap[key]; ok {
+		return field, true
+	}
+	if name := strings.TrimPrefix(key, runMetricKeyPrefix); name != key && runKeyNameRegex.MatchString(name) {
+		// The largest value is selected by ordering rather than with MAX, so that
+		// the result keeps the numeric type of the column when compared to strings.
+		return fmt.Sprintf("(SELECT rm.NumberValue FROM run_metrics AS rm "+
+			"WHERE rm.RunUUID = run_details.UUID AND rm.Name = '%s' ORDER BY rm.NumberValue DESC LIMIT 1)", name), true
+	}
+	if name := strings.TrimPrefix(key, runParameterKeyPrefix); name != key && runKeyNameRegex.MatchString(name) {
+		return fmt.Sprintf("(SELECT rp.Value FROM run_parameters AS rp "+
+			"WHERE rp.RunUUID = run_details.UUID AND rp.Name = '%s')", name), true
 	}
 	return "", false
 }
@@ -130,7 +185,18 @@ func (r *Run) GetFieldValue(name string) interface{} {
 	case "Conditions":
 		return r.Conditions
 	}
-	// Second, try to find the match of "name" inside an array typed field
+	// Second, try to find the value of the parameter if "name" is a parameter
+	if parameterName, ok := GetRunParameterName(name); ok {
+		values, err := r.PipelineSpec.ParameterValues()
+		if err != nil {
+			return nil
+		}
+		if value, ok := values[parameterName]; ok {
+			return value
+		}
+		return nil
+	}
+	// Third, try to find the match of "name" inside an array typed field
 	for _, metric := range r.Metrics {
 		if metric.Name == name {
 			return metric.NumberValue
@@ -0,0 +1,30 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// RunUpdate records that a run was created or that its condition or metrics
+// changed, so that the run watchers of every API server replica are notified
+// of it.
+type RunUpdate struct {
+	ID      int64  `gorm:"column:ID; primary_key; AUTO_INCREMENT"`
+	RunUUID string `gorm:"column:RunUUID; not null"`
+	// The namespace and the experiment of the run, so that the watchers of
+	// other runs can skip the update without reading the run.
+	Namespace      string `gorm:"column:Namespace; not null"`
+	ExperimentUUID string `gorm:"column:ExperimentUUID; not null"`
+	// The run store of the API server that updated the run, whose watchers
+	// were already notified.
+	Source string `gorm:"column:Source; not null"`
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/auth"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	cachestorage "github.com/kubeflow/pipelines/backend/src/cache/storage"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 )
 
@@ -36,6 +37,9 @@ type FakeClientManager struct {
 	jobStore                      storage.JobStoreInterface
 	runStore                      storage.RunStoreInterface
 	taskStore                     storage.TaskStoreInterface
+	executionCacheDB              *cachestorage.DB
+	executionCacheStore           cachestorage.ExecutionCacheStoreInterface
+	notificationStore             storage.NotificationStoreInterface
 	resourceReferenceStore        storage.ResourceReferenceStoreInterface
 	dBStatusStore                 storage.DBStatusStoreInterface
 	defaultExperimentStore        storage.DefaultExperimentStoreInterface
@@ -68,14 +72,22 @@ func NewFakeClientManager(time util.TimeInterface, uuid util.UUIDGeneratorInterf
 		return nil, err
 	}
 
+	executionCacheDB, err := cachestorage.NewFakeDb()
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO(neuromage): Pass in metadata.Store instance for tests as well.
 	return &FakeClientManager{
 		db:                            db,
+		executionCacheDB:              executionCacheDB,
+		executionCacheStore:           cachestorage.NewExecutionCacheStore(executionCacheDB, time),
 		experimentStore:               storage.NewExperimentStore(db, time, uuid),
 		pipelineStore:                 storage.NewPipelineStore(db, time, uuid),
 		jobStore:                      storage.NewJobStore(db, time),
 		runStore:                      storage.NewRunStore(db, time),
 		taskStore:                     storage.NewTaskStore(db, time, uuid),
+		notificationStore:             storage.NewNotificationStore(db, time, uuid),
 		ExecClientFake:                client.NewFakeExecClient(),
 		resourceReferenceStore:        storage.NewResourceReferenceStore(db),
 		dBStatusStore:                 storage.NewDBStatusStore(db),
@@ -159,6 +171,14 @@ func (f *FakeClientManager) TaskStore() storage.TaskStoreInterface {
 	return f.taskStore
 }
 
+func (f *FakeClientManager) ExecutionCacheStore() cachestorage.ExecutionCacheStoreInterface {
+	return f.executionCacheStore
+}
+
+func (f *FakeClientManager) NotificationStore() storage.NotificationStoreInterface {
+	return f.notificationStore
+}
+
 func (f *FakeClientManager) ResourceReferenceStore() storage.ResourceReferenceStoreInterface {
 	return f.resourceReferenceStore
 }
@@ -192,6 +212,7 @@ func (f *FakeClientManager) Authenticators() []auth.Authenticator {
 }
 
 func (f *FakeClientManager) Close() error {
+	f.executionCacheDB.Close()
 	return f.db.Close()
 }
 
@@ -17,6 +17,7 @@ package resource
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
 
@@ -138,18 +139,20 @@ func (r *ResourceManager) ToModelJob(job *api.Job, swf *util.ScheduledWorkflow,
 		}
 	}
 	modelJob := &model.Job{
-		UUID:               string(swf.UID),
-		DisplayName:        job.Name,
-		Name:               swf.Name,
-		Namespace:          swf.Namespace,
-		ServiceAccount:     serviceAccount,
-		Description:        job.Description,
-		Conditions:         swf.ConditionSummary(),
-		Enabled:            job.Enabled,
-		Trigger:            toModelTrigger(job.Trigger),
-		MaxConcurrency:     job.MaxConcurrency,
-		NoCatchup:          job.NoCatchup,
-		ResourceReferences: resourceReferences,
+		UUID:                    string(swf.UID),
+		DisplayName:             job.Name,
+		Name:                    swf.Name,
+		Namespace:               swf.Namespace,
+		ServiceAccount:          serviceAccount,
+		Description:             job.Description,
+		Conditions:              swf.ConditionSummary(),
+		Enabled:                 job.Enabled,
+		Trigger:                 toModelTrigger(job.Trigger),
+		MaxConcurrency:          job.MaxConcurrency,
+		NoCatchup:               job.NoCatchup,
+		ResourceReferences:      resourceReferences,
+		ConcurrencyPolicy:       job.ConcurrencyPolicy,
+		StartingDeadlineSeconds: job.StartingDeadlineSeconds,
 		PipelineSpec: model.PipelineSpec{
 			PipelineId:   job.GetPipelineSpec().GetPipelineId(),
 			PipelineName: pipelineName,
@@ -216,6 +219,9 @@ func toModelTrigger(trigger *api.Trigger) model.Trigger {
 		if cronSchedule.EndTime != nil {
 			modelTrigger.CronScheduleEndTimeInSec = &cronSchedule.EndTime.Seconds
 		}
+		if cronSchedule.TimeZone != "" {
+			modelTrigger.CronScheduleTimeZone = &cronSchedule.TimeZone
+		}
 	}
 
 	if trigger.GetPeriodicSchedule() != nil {
@@ -229,6 +235,17 @@ func toModelTrigger(trigger *api.Trigger) model.Trigger {
 			modelTrigger.PeriodicScheduleEndTimeInSec = &periodicSchedule.EndTime.Seconds
 		}
 	}
+
+	if trigger.GetRunCompletionTrigger() != nil {
+		runCompletionTrigger := trigger.GetRunCompletionTrigger()
+		// The upstream type is validated by the API server.
+		upstreamType, _ := common.ToModelResourceType(runCompletionTrigger.GetUpstream().GetType())
+		modelTrigger.RunCompletionTrigger = model.RunCompletionTrigger{
+			RunCompletionUpstreamType: util.StringPointer(string(upstreamType)),
+			RunCompletionUpstreamId:   util.StringPointer(runCompletionTrigger.GetUpstream().GetId()),
+			RunCompletionStates:       util.StringPointer(strings.Join(runCompletionTrigger.States, ",")),
+		}
+	}
 	return modelTrigger
 }
 
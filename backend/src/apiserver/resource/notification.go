@@ -0,0 +1,323 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	exec "github.com/kubeflow/pipelines/backend/src/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	NotificationSignatureHeader = "X-KFP-Signature"
+	NotificationDeliveryHeader  = "X-KFP-Delivery"
+
+	// The number of pending deliveries attempted at a time.
+	notificationDeliveryChunkSize = 50
+	// Deliveries are marked as failed after this many attempts.
+	notificationMaxAttempts = 5
+	// The delay before the first retry, which doubles on every retry.
+	notificationRetryBackoff = 30 * time.Second
+	// How long a delivery is claimed by the API server attempting it. The
+	// delivery is attempted again if the API server stops before updating it.
+	notificationClaimTimeout = 5 * time.Minute
+)
+
+// NotificationRunStates are the run states that can be subscribed to.
+var NotificationRunStates = []string{
+	string(exec.ExecutionSucceeded),
+	string(exec.ExecutionFailed),
+	string(exec.ExecutionError),
+	model.RunTerminatedState,
+}
+
+// RunNotification is the summary of a run that is posted to the webhooks
+// subscribed to its state.
+type RunNotification struct {
+	RunID           string            `json:"run_id"`
+	RunName         string            `json:"run_name"`
+	State           string            `json:"state"`
+	PipelineID      string            `json:"pipeline_id,omitempty"`
+	PipelineName    string            `json:"pipeline_name,omitempty"`
+	ExperimentID    string            `json:"experiment_id,omitempty"`
+	JobID           string            `json:"job_id,omitempty"`
+	Namespace       string            `json:"namespace,omitempty"`
+	Parameters      map[string]string `json:"parameters"`
+	CreatedAt       time.Time         `json:"created_at"`
+	FinishedAt      *time.Time        `json:"finished_at,omitempty"`
+	DurationSeconds int64             `json:"duration_seconds"`
+	Metrics         []*RunMetricValue `json:"metrics"`
+}
+
+// RunMetricValue is a metric of the run in a RunNotification.
+type RunMetricValue struct {
+	NodeID      string  `json:"node_id"`
+	Name        string  `json:"name"`
+	NumberValue float64 `json:"number_value"`
+	Format      string  `json:"format,omitempty"`
+}
+
+func (r *ResourceManager) CreateNotificationSubscription(subscription *model.NotificationSubscription) (*model.NotificationSubscription, error) {
+	if err := checkNotificationURL(subscription.URL); err != nil {
+		return nil, err
+	}
+	switch subscription.ResourceType {
+	case common.Experiment:
+		if _, err := r.GetExperiment(subscription.ResourceUUID); err != nil {
+			return nil, util.Wrap(err, "Failed to get the experiment of the subscription")
+		}
+	case common.Job:
+		if _, err := r.GetJob(subscription.ResourceUUID); err != nil {
+			return nil, util.Wrap(err, "Failed to get the job of the subscription")
+		}
+	}
+	return r.notificationStore.CreateSubscription(subscription)
+}
+
+func (r *ResourceManager) GetNotificationSubscription(subscriptionId string) (*model.NotificationSubscription, error) {
+	return r.notificationStore.GetSubscription(subscriptionId)
+}
+
+func (r *ResourceManager) ListNotificationSubscriptions(resourceType model.ResourceType, resourceId string) ([]*model.NotificationSubscription, error) {
+	return r.notificationStore.ListSubscriptions(resourceType, resourceId)
+}
+
+func (r *ResourceManager) DeleteNotificationSubscription(subscriptionId string) error {
+	if _, err := r.notificationStore.GetSubscription(subscriptionId); err != nil {
+		return util.Wrap(err, "Delete subscription failed")
+	}
+	return r.notificationStore.DeleteSubscription(subscriptionId)
+}
+
+func (r *ResourceManager) ListNotificationDeliveries(subscriptionId string, limit int) ([]*model.NotificationDelivery, error) {
+	if _, err := r.notificationStore.GetSubscription(subscriptionId); err != nil {
+		return nil, util.Wrap(err, "List deliveries failed")
+	}
+	return r.notificationStore.ListDeliveries(subscriptionId, limit)
+}
+
+// notificationState returns the state a run is notified of when its execution
+// reaches a final condition, or an empty string if the state can't be
+// subscribed to. Runs that were terminated are notified as terminated.
+func notificationState(terminated bool, condition string) string {
+	if terminated {
+		return model.RunTerminatedState
+	}
+	for _, state := range NotificationRunStates {
+		if state == condition {
+			return condition
+		}
+	}
+	return ""
+}
+
+// enqueueRunNotifications creates a pending delivery for every subscription to
+// the experiment, the job or the namespace of the run in the given state.
+func (r *ResourceManager) enqueueRunNotifications(runId string, state string) error {
+	run, err := r.runStore.GetRun(runId)
+	if err != nil {
+		return util.Wrap(err, "Failed to get the run to notify")
+	}
+	resourceKeys := []*common.ReferenceKey{}
+	for _, reference := range run.ResourceReferences {
+		if reference.ReferenceType == common.Experiment || reference.ReferenceType == common.Job {
+			resourceKeys = append(resourceKeys, &common.ReferenceKey{Type: reference.ReferenceType, ID: reference.ReferenceUUID})
+		}
+	}
+	if run.Namespace != "" {
+		resourceKeys = append(resourceKeys, &common.ReferenceKey{Type: common.Namespace, ID: run.Namespace})
+	}
+	subscriptions, err := r.notificationStore.ListSubscriptionsOfResources(resourceKeys)
+	if err != nil {
+		return err
+	}
+	for _, subscription := range subscriptions {
+		if !subscription.HasState(state) {
+			continue
+		}
+		if err := r.notificationStore.CreateDelivery(subscription.UUID, runId, state); err != nil {
+			return util.Wrapf(err, "Failed to notify subscription %v", subscription.UUID)
+		}
+	}
+	return nil
+}
+
+// DeliverPendingNotifications posts the run summaries of the deliveries that
+// are due. Failed deliveries are retried with an exponential backoff until
+// they run out of attempts. Every API server delivers the pending
+// notifications, so a delivery is claimed before it is attempted. Returns the
+// number of deliveries attempted.
+func (r *ResourceManager) DeliverPendingNotifications(ctx context.Context) (int, error) {
+	deliveries, err := r.notificationStore.ListPendingDeliveries(r.time.Now().Unix(), notificationDeliveryChunkSize)
+	if err != nil {
+		return 0, util.Wrap(err, "Failed to list the pending notification deliveries")
+	}
+	attempted := 0
+	for _, delivery := range deliveries {
+		claimed, err := r.notificationStore.ClaimDelivery(delivery, r.time.Now().Add(notificationClaimTimeout).Unix())
+		if err != nil {
+			return attempted, util.Wrapf(err, "Failed to claim notification delivery %v", delivery.UUID)
+		}
+		if !claimed {
+			// Another API server is attempting the delivery.
+			continue
+		}
+		r.attemptDelivery(ctx, delivery)
+		if err := r.notificationStore.UpdateDelivery(delivery); err != nil {
+			return attempted, util.Wrapf(err, "Failed to update notification delivery %v", delivery.UUID)
+		}
+		attempted++
+	}
+	return attempted, nil
+}
+
+// attemptDelivery posts the run summary of the delivery and records the result.
+func (r *ResourceManager) attemptDelivery(ctx context.Context, delivery *model.NotificationDelivery) {
+	delivery.Attempts++
+	responseCode, err := r.postRunNotification(ctx, delivery)
+	delivery.ResponseCode = int32(responseCode)
+	if err == nil {
+		delivery.Status = model.NotificationDeliverySucceeded
+		delivery.Error = ""
+		return
+	}
+	glog.Warningf("Failed to deliver notification %v of run %v (attempt %v). Error: %+v",
+		delivery.UUID, delivery.RunUUID, delivery.Attempts, err)
+	// The delivery log is visible to the users, so it only records the
+	// external message of the error.
+	delivery.Error = "Failed to post the notification"
+	var userError *util.UserError
+	if errors.As(err, &userError) {
+		delivery.Error = userError.ExternalMessage()
+	}
+	if delivery.Attempts >= notificationMaxAttempts || util.IsUserErrorCodeMatch(err, codes.NotFound) {
+		delivery.Status = model.NotificationDeliveryFailed
+		return
+	}
+	backoff := notificationRetryBackoff * time.Duration(1<<uint(delivery.Attempts-1))
+	delivery.NextAttemptAtInSec = r.time.Now().Add(backoff).Unix()
+}
+
+// postRunNotification posts the run summary of the delivery to the webhook of
+// its subscription. Returns the HTTP status code of the response, if any.
+func (r *ResourceManager) postRunNotification(ctx context.Context, delivery *model.NotificationDelivery) (int, error) {
+	subscription, err := r.notificationStore.GetSubscription(delivery.SubscriptionUUID)
+	if err != nil {
+		return 0, err
+	}
+	notification, err := r.buildRunNotification(delivery.RunUUID, delivery.State)
+	if err != nil {
+		return 0, err
+	}
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to marshal the notification of run %v", delivery.RunUUID)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to create the notification request")
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(NotificationDeliveryHeader, delivery.UUID)
+	if subscription.Secret != "" {
+		request.Header.Set(NotificationSignatureHeader, "sha256="+SignNotification(subscription.Secret, body))
+	}
+	response, err := notificationHTTPClient.Do(request)
+	if err != nil {
+		// The webhook host is rejected by the dialer if it resolves to an
+		// internal address.
+		var userError *util.UserError
+		if errors.As(err, &userError) {
+			return 0, userError
+		}
+		return 0, util.NewUnavailableError(err, "Failed to post the notification to the webhook")
+	}
+	defer response.Body.Close()
+	// The response body is neither read nor kept, since the webhook may not
+	// be trusted.
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response.StatusCode, util.NewUnavailableError(
+			fmt.Errorf("webhook responded with status %v", response.StatusCode),
+			"Failed to post the notification: the webhook responded with status %v", response.StatusCode)
+	}
+	return response.StatusCode, nil
+}
+
+// buildRunNotification summarizes the run. It is built when the notification
+// is delivered, so that it includes the metrics reported after the run
+// finished.
+func (r *ResourceManager) buildRunNotification(runId string, state string) (*RunNotification, error) {
+	run, err := r.runStore.GetRun(runId)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to get the run to notify")
+	}
+	parameters, err := run.PipelineSpec.ParameterValues()
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to get the parameters of run %v", runId)
+	}
+	notification := &RunNotification{
+		RunID:        run.UUID,
+		RunName:      run.DisplayName,
+		State:        state,
+		PipelineID:   run.PipelineId,
+		PipelineName: run.PipelineName,
+		ExperimentID: run.ExperimentUUID,
+		Namespace:    run.Namespace,
+		Parameters:   parameters,
+		CreatedAt:    time.Unix(run.CreatedAtInSec, 0).UTC(),
+		Metrics:      []*RunMetricValue{},
+	}
+	for _, reference := range run.ResourceReferences {
+		if reference.ReferenceType == common.Job {
+			notification.JobID = reference.ReferenceUUID
+		}
+	}
+	if run.FinishedAtInSec > 0 {
+		finishedAt := time.Unix(run.FinishedAtInSec, 0).UTC()
+		notification.FinishedAt = &finishedAt
+		notification.DurationSeconds = run.FinishedAtInSec - run.CreatedAtInSec
+	}
+	for _, metric := range run.Metrics {
+		notification.Metrics = append(notification.Metrics, &RunMetricValue{
+			NodeID:      metric.NodeID,
+			Name:        metric.Name,
+			NumberValue: metric.NumberValue,
+			Format:      metric.Format,
+		})
+	}
+	return notification, nil
+}
+
+// SignNotification returns the hex encoded HMAC-SHA256 of the notification
+// body with the secret of the subscription.
+func SignNotification(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,371 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// resolveWebhookHostsTo makes the webhook hosts resolve to the given address
+// until the test ends.
+func resolveWebhookHostsTo(t *testing.T, address string) {
+	lookup := lookupWebhookHost
+	lookupWebhookHost = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+		return []net.IPAddr{{IP: net.ParseIP(address)}}, nil
+	}
+	t.Cleanup(func() { lookupWebhookHost = lookup })
+}
+
+// allowWebhookHosts allows the webhooks to be posted to the given hosts until
+// the test ends.
+func allowWebhookHosts(t *testing.T, hosts string) {
+	viper.Set(common.NotificationAllowedHosts, hosts)
+	t.Cleanup(func() { viper.Set(common.NotificationAllowedHosts, "") })
+}
+
+// initWithNotifiedRun creates a one-time run and a subscription to the runs of
+// its experiment entering the given states.
+func initWithNotifiedRun(t *testing.T, url string, states string) (*FakeClientManager, *ResourceManager, *model.RunDetail, *model.NotificationSubscription) {
+	resolveWebhookHostsTo(t, "203.0.113.10")
+	store, manager, run := initWithOneTimeRun(t)
+	// The fake UUID generator of the client manager generates a single UUID.
+	manager.notificationStore = storage.NewNotificationStore(store.DB(), util.NewFakeTimeForEpoch(), util.NewUUIDGenerator())
+	subscription, err := manager.CreateNotificationSubscription(&model.NotificationSubscription{
+		ResourceType: common.Experiment,
+		ResourceUUID: run.ExperimentUUID,
+		States:       states,
+		URL:          url,
+		Secret:       "secret",
+	})
+	assert.Nil(t, err)
+	return store, manager, run, subscription
+}
+
+func newRunWorkflow(run *model.RunDetail, phase v1alpha1.WorkflowPhase) *v1alpha1.Workflow {
+	return &v1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      run.Name,
+			Namespace: "ns1",
+			UID:       types.UID(run.UUID),
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: run.UUID},
+		},
+		Status: v1alpha1.WorkflowStatus{Phase: phase, FinishedAt: v1.NewTime(time.Unix(10, 0))},
+	}
+}
+
+func reportRunWorkflow(t *testing.T, manager *ResourceManager, run *model.RunDetail, phase v1alpha1.WorkflowPhase) {
+	err := manager.ReportWorkflowResource(context.Background(), util.NewWorkflow(newRunWorkflow(run, phase)))
+	assert.Nil(t, err)
+}
+
+func TestReportWorkflowResource_NotifiesSubscriptions(t *testing.T) {
+	store, manager, run, subscription := initWithNotifiedRun(t, "http://hook", "Succeeded,Failed")
+	defer store.Close()
+	otherSubscription, err := manager.CreateNotificationSubscription(&model.NotificationSubscription{
+		ResourceType: common.Experiment,
+		ResourceUUID: run.ExperimentUUID,
+		States:       "Succeeded",
+		URL:          "http://other-hook",
+	})
+	assert.Nil(t, err)
+
+	reportRunWorkflow(t, manager, run, v1alpha1.WorkflowRunning)
+	reportRunWorkflow(t, manager, run, v1alpha1.WorkflowFailed)
+	// The condition doesn't change when the workflow is reported again.
+	reportRunWorkflow(t, manager, run, v1alpha1.WorkflowFailed)
+
+	deliveries, err := manager.ListNotificationDeliveries(subscription.UUID, 10)
+	assert.Nil(t, err)
+	assert.Len(t, deliveries, 1)
+	assert.Equal(t, run.UUID, deliveries[0].RunUUID)
+	assert.Equal(t, "Failed", deliveries[0].State)
+	assert.Equal(t, model.NotificationDeliveryPending, deliveries[0].Status)
+
+	deliveries, err = manager.ListNotificationDeliveries(otherSubscription.UUID, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, deliveries)
+}
+
+func TestReportWorkflowResource_NotifiesTerminatedRun(t *testing.T) {
+	store, manager, run, subscription := initWithNotifiedRun(t, "http://hook", "Terminated")
+	defer store.Close()
+
+	err := manager.TerminateRun(context.Background(), run.UUID)
+	assert.Nil(t, err)
+	// The active deadline of the terminated workflow stays 0 after it finishes.
+	workflow := newRunWorkflow(run, v1alpha1.WorkflowFailed)
+	workflow.Spec.ActiveDeadlineSeconds = util.Int64Pointer(0)
+	err = manager.ReportWorkflowResource(context.Background(), util.NewWorkflow(workflow))
+	assert.Nil(t, err)
+	// The run is still notified as terminated when the report is retried.
+	err = manager.ReportWorkflowResource(context.Background(), util.NewWorkflow(workflow))
+	assert.Nil(t, err)
+
+	deliveries, err := manager.ListNotificationDeliveries(subscription.UUID, 10)
+	assert.Nil(t, err)
+	assert.Len(t, deliveries, 1)
+	assert.Equal(t, model.RunTerminatedState, deliveries[0].State)
+}
+
+func TestReportWorkflowResource_NotifiesWhenReportIsRetried(t *testing.T) {
+	store, manager, run, subscription := initWithNotifiedRun(t, "http://hook", "Failed")
+	defer store.Close()
+
+	// The final condition of the run was stored by a report that failed before
+	// its notifications were enqueued.
+	err := store.RunStore().UpdateRun(run.UUID, "Failed", 10, "")
+	assert.Nil(t, err)
+	reportRunWorkflow(t, manager, run, v1alpha1.WorkflowFailed)
+
+	deliveries, err := manager.ListNotificationDeliveries(subscription.UUID, 10)
+	assert.Nil(t, err)
+	assert.Len(t, deliveries, 1)
+	assert.Equal(t, "Failed", deliveries[0].State)
+}
+
+func TestDeliverPendingNotifications_ClaimedByAnotherServer(t *testing.T) {
+	var requests int
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer webhook.Close()
+	allowWebhookHosts(t, "127.0.0.1")
+
+	store, manager, run, subscription := initWithNotifiedRun(t, webhook.URL, "Failed")
+	defer store.Close()
+	reportRunWorkflow(t, manager, run, v1alpha1.WorkflowFailed)
+	pending, err := manager.notificationStore.ListPendingDeliveries(manager.time.Now().Unix(), 10)
+	assert.Nil(t, err)
+	assert.Len(t, pending, 1)
+	claimed, err := manager.notificationStore.ClaimDelivery(pending[0], pending[0].NextAttemptAtInSec+60)
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+
+	count, err := manager.DeliverPendingNotifications(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+	assert.Equal(t, 0, requests)
+
+	// The delivery is attempted again once the claim expires.
+	manager.time = util.NewFakeTime(time.Unix(3600, 0))
+	count, err = manager.DeliverPendingNotifications(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, 1, requests)
+	deliveries, err := manager.ListNotificationDeliveries(subscription.UUID, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, model.NotificationDeliverySucceeded, deliveries[0].Status)
+}
+
+func TestDeliverPendingNotifications(t *testing.T) {
+	var requests []*http.Request
+	var bodies [][]byte
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, r)
+		bodies = append(bodies, body)
+		if len(requests) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("try again"))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer webhook.Close()
+	allowWebhookHosts(t, "127.0.0.1")
+
+	store, manager, run, subscription := initWithNotifiedRun(t, webhook.URL, "Failed")
+	defer store.Close()
+	reportRunWorkflow(t, manager, run, v1alpha1.WorkflowFailed)
+	err := store.RunStore().ReportMetric(&model.RunMetric{
+		RunUUID: run.UUID, NodeID: "node1", Name: "accuracy", NumberValue: 0.9, Format: "RAW",
+	})
+	assert.Nil(t, err)
+
+	count, err := manager.DeliverPendingNotifications(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	deliveries, err := manager.ListNotificationDeliveries(subscription.UUID, 10)
+	assert.Nil(t, err)
+	assert.Len(t, deliveries, 1)
+	assert.Equal(t, model.NotificationDeliveryPending, deliveries[0].Status)
+	assert.Equal(t, int32(1), deliveries[0].Attempts)
+	assert.Equal(t, int32(http.StatusInternalServerError), deliveries[0].ResponseCode)
+	assert.Equal(t, "Failed to post the notification: the webhook responded with status 500", deliveries[0].Error)
+	// The response of the webhook isn't visible to the users.
+	assert.NotContains(t, deliveries[0].Error, "try again")
+
+	// The delivery is retried after a backoff.
+	count, err = manager.DeliverPendingNotifications(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+	manager.time = util.NewFakeTime(time.Unix(3600, 0))
+	count, err = manager.DeliverPendingNotifications(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	deliveries, err = manager.ListNotificationDeliveries(subscription.UUID, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, model.NotificationDeliverySucceeded, deliveries[0].Status)
+	assert.Equal(t, int32(2), deliveries[0].Attempts)
+	assert.Equal(t, int32(http.StatusOK), deliveries[0].ResponseCode)
+	assert.Empty(t, deliveries[0].Error)
+
+	assert.Len(t, requests, 2)
+	request := requests[1]
+	assert.Equal(t, deliveries[0].UUID, request.Header.Get(NotificationDeliveryHeader))
+	assert.Equal(t, "sha256="+SignNotification("secret", bodies[1]), request.Header.Get(NotificationSignatureHeader))
+	notification := &RunNotification{}
+	assert.Nil(t, json.Unmarshal(bodies[1], notification))
+	assert.Equal(t, run.UUID, notification.RunID)
+	assert.Equal(t, "Failed", notification.State)
+	assert.Equal(t, run.ExperimentUUID, notification.ExperimentID)
+	assert.Equal(t, map[string]string{"param1": "world"}, notification.Parameters)
+	assert.Equal(t, int64(10)-run.CreatedAtInSec, notification.DurationSeconds)
+	assert.Equal(t, []*RunMetricValue{{NodeID: "node1", Name: "accuracy", NumberValue: 0.9, Format: "RAW"}},
+		notification.Metrics)
+}
+
+func TestDeliverPendingNotifications_FailsAfterMaxAttempts(t *testing.T) {
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer webhook.Close()
+	allowWebhookHosts(t, "127.0.0.1")
+
+	store, manager, run, subscription := initWithNotifiedRun(t, webhook.URL, "Failed")
+	defer store.Close()
+	reportRunWorkflow(t, manager, run, v1alpha1.WorkflowFailed)
+
+	for attempt := 1; attempt <= notificationMaxAttempts; attempt++ {
+		manager.time = util.NewFakeTime(time.Unix(int64(attempt)*secondsPerDay, 0))
+		count, err := manager.DeliverPendingNotifications(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, count)
+	}
+	deliveries, err := manager.ListNotificationDeliveries(subscription.UUID, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, model.NotificationDeliveryFailed, deliveries[0].Status)
+	assert.Equal(t, int32(notificationMaxAttempts), deliveries[0].Attempts)
+	assert.Equal(t, int32(http.StatusServiceUnavailable), deliveries[0].ResponseCode)
+
+	manager.time = util.NewFakeTime(time.Unix(100*secondsPerDay, 0))
+	count, err := manager.DeliverPendingNotifications(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestCreateNotificationSubscription_InternalAddress(t *testing.T) {
+	store, manager, run := initWithOneTimeRun(t)
+	defer store.Close()
+
+	for _, url := range []string{"http://169.254.169.254/latest", "http://127.0.0.1:8888", "http://[::1]/", "http://10.0.0.1"} {
+		_, err := manager.CreateNotificationSubscription(&model.NotificationSubscription{
+			ResourceType: common.Experiment,
+			ResourceUUID: run.ExperimentUUID,
+			States:       "Failed",
+			URL:          url,
+		})
+		assert.True(t, util.IsUserErrorCodeMatch(err, codes.InvalidArgument), url)
+		assert.Contains(t, err.Error(), "resolves to an internal address", url)
+	}
+
+	resolveWebhookHostsTo(t, "192.168.0.1")
+	_, err := manager.CreateNotificationSubscription(&model.NotificationSubscription{
+		ResourceType: common.Experiment,
+		ResourceUUID: run.ExperimentUUID,
+		States:       "Failed",
+		URL:          "https://ml-pipeline.kubeflow.svc.cluster.local",
+	})
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.InvalidArgument))
+
+	// The administrator can allow internal hosts.
+	allowWebhookHosts(t, "ml-pipeline.kubeflow.svc.cluster.local")
+	_, err = manager.CreateNotificationSubscription(&model.NotificationSubscription{
+		ResourceType: common.Experiment,
+		ResourceUUID: run.ExperimentUUID,
+		States:       "Failed",
+		URL:          "https://ml-pipeline.kubeflow.svc.cluster.local",
+	})
+	assert.Nil(t, err)
+}
+
+func TestDeliverPendingNotifications_InternalAddress(t *testing.T) {
+	requested := false
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer internal.Close()
+	store, manager, run, subscription := initWithNotifiedRun(t, "http://hook"+internal.URL[len("http://127.0.0.1"):], "Failed")
+	defer store.Close()
+	reportRunWorkflow(t, manager, run, v1alpha1.WorkflowFailed)
+
+	// The host resolves to an internal address after the subscription is
+	// created.
+	resolveWebhookHostsTo(t, "127.0.0.1")
+	count, err := manager.DeliverPendingNotifications(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	assert.False(t, requested)
+	deliveries, err := manager.ListNotificationDeliveries(subscription.UUID, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, model.NotificationDeliveryPending, deliveries[0].Status)
+	assert.Contains(t, deliveries[0].Error, "resolves to an internal address")
+}
+
+func TestDeliverPendingNotifications_RedirectToInternalAddress(t *testing.T) {
+	requested := false
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer internal.Close()
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://internal"+internal.URL[len("http://127.0.0.1"):], http.StatusTemporaryRedirect)
+	}))
+	defer webhook.Close()
+	// Only the host of the webhook is allowed, not the host it redirects to.
+	allowWebhookHosts(t, "127.0.0.1")
+
+	store, manager, run, subscription := initWithNotifiedRun(t, webhook.URL, "Failed")
+	defer store.Close()
+	reportRunWorkflow(t, manager, run, v1alpha1.WorkflowFailed)
+	resolveWebhookHostsTo(t, "127.0.0.1")
+
+	_, err := manager.DeliverPendingNotifications(context.Background())
+	assert.Nil(t, err)
+	assert.False(t, requested)
+	deliveries, err := manager.ListNotificationDeliveries(subscription.UUID, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, model.NotificationDeliveryPending, deliveries[0].Status)
+	assert.Contains(t, deliveries[0].Error, "resolves to an internal address")
+}
@@ -0,0 +1,167 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// The webhooks are posted to from inside the cluster, so they must not reach
+// the addresses that are only routable from it, unless their hosts are
+// allowed by the administrator.
+var blockedWebhookNetworks = mustParseCIDRs(
+	"0.0.0.0/8",      // "this" network
+	"10.0.0.0/8",     // private
+	"100.64.0.0/10",  // carrier-grade NAT
+	"127.0.0.0/8",    // loopback
+	"169.254.0.0/16", // link-local, including the cloud metadata servers
+	"172.16.0.0/12",  // private
+	"192.0.0.0/24",   // IETF protocol assignments
+	"192.168.0.0/16", // private
+	"198.18.0.0/15",  // benchmarking
+	"224.0.0.0/4",    // multicast
+	"240.0.0.0/4",    // reserved, including broadcast
+	"::/128",         // unspecified
+	"::1/128",        // loopback
+	"64:ff9b::/96",   // IPv4/IPv6 translation
+	"fc00::/7",       // unique local
+	"fe80::/10",      // link-local
+	"ff00::/8",       // multicast
+)
+
+// lookupWebhookHost resolves the host of a webhook. It is replaced in tests.
+var lookupWebhookHost = net.DefaultResolver.LookupIPAddr
+
+var notificationHTTPClient = &http.Client{
+	Timeout: 30 * time.Second,
+	// Redirects are followed through the same dialer, so their targets are
+	// checked as well.
+	Transport: &http.Transport{
+		Proxy:                 nil,
+		DialContext:           dialWebhook,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: 30 * time.Second,
+		MaxIdleConns:          10,
+		IdleConnTimeout:       90 * time.Second,
+	},
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(fmt.Sprintf("invalid CIDR %q: %v", cidr, err))
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+func isBlockedWebhookIP(ip net.IP) bool {
+	if ipv4 := ip.To4(); ipv4 != nil {
+		ip = ipv4
+	}
+	for _, network := range blockedWebhookNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func isAllowedWebhookHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	for _, allowed := range common.GetNotificationAllowedHosts() {
+		if host == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveWebhookHost returns the addresses of the host of a webhook, and fails
+// if any of them can't be posted to.
+func resolveWebhookHost(ctx context.Context, host string) ([]net.IP, error) {
+	var ips []net.IP
+	if ip := net.ParseIP(host); ip != nil {
+		ips = []net.IP{ip}
+	} else {
+		addrs, err := lookupWebhookHost(ctx, host)
+		if err != nil {
+			return nil, util.NewInvalidInputError("Failed to resolve the webhook host %q", host)
+		}
+		for _, addr := range addrs {
+			ips = append(ips, addr.IP)
+		}
+	}
+	if len(ips) == 0 {
+		return nil, util.NewInvalidInputError("Failed to resolve the webhook host %q", host)
+	}
+	for _, ip := range ips {
+		if isBlockedWebhookIP(ip) {
+			return nil, util.NewInvalidInputError(
+				"The webhook host %q resolves to an internal address, which is not allowed", host)
+		}
+	}
+	return ips, nil
+}
+
+// dialWebhook dials the resolved address of a webhook after checking it, so
+// that the host can't resolve to another address between the check and the
+// connection.
+func dialWebhook(ctx context.Context, network string, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	if isAllowedWebhookHost(host) {
+		return dialer.DialContext(ctx, network, address)
+	}
+	ips, err := resolveWebhookHost(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	return dialer.DialContext(ctx, network, net.JoinHostPort(ips[0].String(), port))
+}
+
+// checkNotificationURL checks that the webhook URL of a subscription doesn't
+// resolve to an internal address. The address is checked again whenever the
+// webhook is posted to, since it may resolve differently by then.
+func checkNotificationURL(webhookURL string) error {
+	parsed, err := url.Parse(webhookURL)
+	if err != nil {
+		return util.NewInvalidInputError("Invalid subscription: the URL must be an HTTP or HTTPS URL. Got %q", webhookURL)
+	}
+	if isAllowedWebhookHost(parsed.Hostname()) {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := resolveWebhookHost(ctx, parsed.Hostname()); err != nil {
+		return util.Wrap(err, "Invalid subscription")
+	}
+	return nil
+}
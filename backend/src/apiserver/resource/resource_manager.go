@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cenkalti/backoff"
 	"github.com/golang/glog"
@@ -32,8 +34,10 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
+	cachestorage "github.com/kubeflow/pipelines/backend/src/cache/storage"
 	exec "github.com/kubeflow/pipelines/backend/src/common"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	scheduledworkflow "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	scheduledworkflowclient "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned/typed/scheduledworkflow/v1beta1"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -45,6 +49,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/util/retry"
 )
 
 // Metric variables. Please prefix the metric names with resource_manager_.
@@ -62,6 +67,8 @@ type ClientManagerInterface interface {
 	JobStore() storage.JobStoreInterface
 	RunStore() storage.RunStoreInterface
 	TaskStore() storage.TaskStoreInterface
+	ExecutionCacheStore() cachestorage.ExecutionCacheStoreInterface
+	NotificationStore() storage.NotificationStoreInterface
 	ResourceReferenceStore() storage.ResourceReferenceStoreInterface
 	DBStatusStore() storage.DBStatusStoreInterface
 	DefaultExperimentStore() storage.DefaultExperimentStoreInterface
@@ -83,6 +90,8 @@ type ResourceManager struct {
 	jobStore                  storage.JobStoreInterface
 	runStore                  storage.RunStoreInterface
 	taskStore                 storage.TaskStoreInterface
+	executionCacheStore       cachestorage.ExecutionCacheStoreInterface
+	notificationStore         storage.NotificationStoreInterface
 	resourceReferenceStore    storage.ResourceReferenceStoreInterface
 	dBStatusStore             storage.DBStatusStoreInterface
 	defaultExperimentStore    storage.DefaultExperimentStoreInterface
@@ -105,6 +114,8 @@ func NewResourceManager(clientManager ClientManagerInterface) *ResourceManager {
 		jobStore:                  clientManager.JobStore(),
 		runStore:                  clientManager.RunStore(),
 		taskStore:                 clientManager.TaskStore(),
+		executionCacheStore:       clientManager.ExecutionCacheStore(),
+		notificationStore:         clientManager.NotificationStore(),
 		resourceReferenceStore:    clientManager.ResourceReferenceStore(),
 		dBStatusStore:             clientManager.DBStatusStore(),
 		defaultExperimentStore:    clientManager.DefaultExperimentStore(),
@@ -427,6 +438,19 @@ func (r *ResourceManager) ListRuns(filterContext *common.FilterContext,
 	return r.runStore.ListRuns(filterContext, opts)
 }
 
+// WatchRunUpdates returns a channel receiving the updates of the runs that are
+// created, or whose condition or metrics change, and a function that stops the
+// watch.
+func (r *ResourceManager) WatchRunUpdates() (<-chan model.RunUpdate, func()) {
+	return r.runStore.WatchRunUpdates()
+}
+
+// PollRunUpdates notifies the run watchers of the runs updated by the other
+// API servers. Returns the number of updates polled.
+func (r *ResourceManager) PollRunUpdates() (int, error) {
+	return r.runStore.PollRunUpdates()
+}
+
 func (r *ResourceManager) ArchiveRun(runId string) error {
 	return r.runStore.ArchiveRun(runId)
 }
@@ -495,31 +519,34 @@ func (r *ResourceManager) ListTasks(filterContext *common.FilterContext,
 	return r.taskStore.ListTasks(filterContext, opts)
 }
 
-func (r *ResourceManager) ListJobs(filterContext *common.FilterContext,
-	opts *list.Options) (jobs []*model.Job, total_size int, nextPageToken string, err error) {
-	return r.jobStore.ListJobs(filterContext, opts)
+func (r *ResourceManager) GetTask(taskId string) (*model.Task, error) {
+	return r.taskStore.GetTask(taskId)
 }
 
-// TerminateWorkflow terminates a workflow by setting its activeDeadlineSeconds to 0
-func TerminateWorkflow(ctx context.Context, wfClient util.ExecutionInterface, name string) error {
-	patchObj := map[string]interface{}{
-		"spec": map[string]interface{}{
-			"activeDeadlineSeconds": 0,
-		},
+// InvalidateCache deletes the tasks matching all the non-empty conditions, so
+// that they are no longer reused as cache entries. The v1 execution caches are
+// deleted as well if the cache database is available, unless the conditions
+// include a pipeline or a namespace, which the v1 execution caches don't
+// record. Returns the number of deleted cache entries of both kinds.
+func (r *ResourceManager) InvalidateCache(fingerprint, pipelineName, namespace string, createdBeforeInSec int64) (int64, error) {
+	count, err := r.taskStore.DeleteTasks(fingerprint, pipelineName, namespace, createdBeforeInSec)
+	if err != nil {
+		return 0, err
 	}
-
-	patch, err := json.Marshal(patchObj)
+	if r.executionCacheStore == nil || pipelineName != "" || namespace != "" {
+		return count, nil
+	}
+	// The fingerprints of the v1 execution caches are their cache keys.
+	executionCacheCount, err := r.executionCacheStore.DeleteExecutionCaches(fingerprint, createdBeforeInSec)
 	if err != nil {
-		return util.NewInternalServerError(err, "Unexpected error while marshalling a patch object.")
+		return count, util.NewInternalServerError(err, "Failed to delete the v1 execution caches")
 	}
+	return count + executionCacheCount, nil
+}
 
-	var operation = func() error {
-		_, err = wfClient.Patch(ctx, name, types.MergePatchType, patch, v1.PatchOptions{})
-		return err
-	}
-	var backoffPolicy = backoff.WithMaxRetries(backoff.NewConstantBackOff(100), 10)
-	err = backoff.Retry(operation, backoffPolicy)
-	return err
+func (r *ResourceManager) ListJobs(filterContext *common.FilterContext,
+	opts *list.Options) (jobs []*model.Job, total_size int, nextPageToken string, err error) {
+	return r.jobStore.ListJobs(filterContext, opts)
 }
 
 func (r *ResourceManager) TerminateRun(ctx context.Context, runId string) error {
@@ -538,7 +565,7 @@ func (r *ResourceManager) TerminateRun(ctx context.Context, runId string) error
 		return util.Wrap(err, "Terminate run failed")
 	}
 
-	err = TerminateWorkflow(ctx, r.getWorkflowClient(namespace), runDetail.Run.Name)
+	err = util.TerminateWorkflow(ctx, r.getWorkflowClient(namespace), runDetail.Run.Name)
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to terminate the run")
 	}
@@ -555,13 +582,13 @@ func (r *ResourceManager) RetryRun(ctx context.Context, runId string) error {
 		return util.Wrap(err, "Retry run failed")
 	}
 
-	if runDetail.WorkflowSpecManifest != "" && runDetail.WorkflowRuntimeManifest == "" {
+	// Runs of both v1 and v2 pipelines are retried by retrying their Argo
+	// workflows. In v2 mode, drivers reuse MLMD executions of the previous
+	// attempt, so succeeded tasks are kept and failed tasks are re-driven.
+	if runDetail.WorkflowRuntimeManifest == "" {
 		return util.NewBadRequestError(errors.New("workflow cannot be retried"), "Workflow must be Failed/Error to retry")
 	}
-	if runDetail.PipelineSpecManifest != "" {
-		return util.NewBadRequestError(errors.New("workflow cannot be retried"), "Workflow must be with v1 mode to retry")
-	}
-	execSpec, err := util.NewExecutionSpecJSON(util.ArgoWorkflow, []byte(runDetail.WorkflowRuntimeManifest))
+	execSpec, err := util.NewExecutionSpecJSON(common.GetExecutionType(), []byte(runDetail.WorkflowRuntimeManifest))
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to retrieve the runtime pipeline spec from the run")
 	}
@@ -573,6 +600,10 @@ func (r *ResourceManager) RetryRun(ctx context.Context, runId string) error {
 	if err := execSpec.CanRetry(); err != nil {
 		return err
 	}
+	if namespace == "" {
+		// Fall back to the namespace of the workflow.
+		namespace = execSpec.ExecutionNamespace()
+	}
 
 	newExecSpec, podsToDelete, err := execSpec.GenerateRetryExecution()
 	if err != nil {
@@ -647,7 +678,7 @@ func (r *ResourceManager) readRunLogFromArchive(run *model.RunDetail, nodeId str
 		return util.NewBadRequestError(errors.New("archived log cannot be read"), "Failed to retrieve the runtime workflow from the run")
 	}
 
-	execSpec, err := util.NewExecutionSpecJSON(util.ArgoWorkflow, []byte(run.WorkflowRuntimeManifest))
+	execSpec, err := util.NewExecutionSpecJSON(common.GetExecutionType(), []byte(run.WorkflowRuntimeManifest))
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to retrieve the runtime pipeline spec from the run")
 	}
@@ -720,6 +751,9 @@ func (r *ResourceManager) CreateJob(ctx context.Context, apiJob *api.Job) (*mode
 	if err != nil {
 		return nil, err
 	}
+	if err = r.checkAPIJobRunCompletionTriggerCycle("", namespace, apiJob); err != nil {
+		return nil, err
+	}
 
 	newScheduledWorkflow, err := r.getScheduledWorkflowClient(namespace).Create(ctx, scheduledWorkflow)
 	if err != nil {
@@ -750,6 +784,19 @@ func (r *ResourceManager) EnableJob(ctx context.Context, jobID string, enabled b
 	if err != nil {
 		return util.Wrap(err, "Enable/Disable job failed")
 	}
+	// Disabled jobs are not triggered, so they don't close trigger cycles until
+	// they are enabled.
+	if enabled && job.RunCompletionUpstreamId != nil {
+		upstreamType := model.ResourceType("")
+		if job.RunCompletionUpstreamType != nil {
+			upstreamType = model.ResourceType(*job.RunCompletionUpstreamType)
+		}
+		err = r.checkRunCompletionTriggerCycle(jobID, job.Namespace, getPipelineVersionIdFromModelJob(job),
+			upstreamType, *job.RunCompletionUpstreamId)
+		if err != nil {
+			return util.Wrap(err, "Enable/Disable job failed")
+		}
+	}
 
 	_, err = r.getScheduledWorkflowClient(job.Namespace).Patch(
 		ctx,
@@ -771,6 +818,175 @@ func (r *ResourceManager) EnableJob(ctx context.Context, jobID string, enabled b
 	return nil
 }
 
+// UpdateJob replaces the definition of an existing job. The ScheduledWorkflow
+// custom resource is patched in place, so the job keeps its UUID, its
+// experiment and the trigger history used to index the runs it triggers.
+func (r *ResourceManager) UpdateJob(ctx context.Context, jobID string, apiJob *api.Job) (*model.Job, error) {
+	job, err := r.checkJobExist(ctx, jobID)
+	if err != nil {
+		return nil, util.Wrap(err, "Update job failed")
+	}
+
+	experimentID := ""
+	for _, ref := range job.ResourceReferences {
+		if ref.ReferenceType == common.Experiment {
+			experimentID = ref.ReferenceUUID
+		}
+	}
+	newExperimentID := common.GetExperimentIDFromAPIResourceReferences(apiJob.GetResourceReferences())
+	if newExperimentID == "" && experimentID != "" {
+		apiJob.ResourceReferences = append(apiJob.GetResourceReferences(), &api.ResourceReference{
+			Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experimentID},
+			Relationship: api.Relationship_OWNER,
+		})
+	} else if newExperimentID != experimentID {
+		return nil, util.NewInvalidInputError("The experiment of job %v cannot be changed from %v to %v.", jobID, experimentID, newExperimentID)
+	}
+
+	manifestBytes, err := getManifestBytes(apiJob.PipelineSpec, &apiJob.ResourceReferences, r)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := template.New(manifestBytes)
+	if err != nil {
+		return nil, err
+	}
+	scheduledWorkflow, err := tmpl.ScheduledWorkflow(apiJob)
+	if err != nil {
+		return nil, util.Wrap(err, "failed to generate the scheduledWorkflow.")
+	}
+	if err = r.checkAPIJobRunCompletionTriggerCycle(jobID, job.Namespace, apiJob); err != nil {
+		return nil, err
+	}
+
+	// Only the spec is replaced. The status keeps the last triggered time, so
+	// the next run is scheduled from where the job left off.
+	oldSpec, newScheduledWorkflow, err := r.replaceScheduledWorkflowSpec(ctx, job, scheduledWorkflow.Spec)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to update the scheduled workflow of job %v", jobID)
+	}
+
+	newJob, err := r.ToModelJob(apiJob, util.NewScheduledWorkflow(newScheduledWorkflow), string(manifestBytes), tmpl.GetTemplateType())
+	if err != nil {
+		r.restoreScheduledWorkflowSpec(ctx, job, oldSpec)
+		return nil, util.Wrap(err, "Update job failed")
+	}
+	newJob.CreatedAtInSec = job.CreatedAtInSec
+	newJob.UpdatedAtInSec = r.time.Now().Unix()
+	if err = r.jobStore.ReplaceJob(newJob); err != nil {
+		r.restoreScheduledWorkflowSpec(ctx, job, oldSpec)
+		return nil, util.Wrap(err, "Update job failed")
+	}
+	return r.jobStore.GetJob(jobID)
+}
+
+// TriggerJob requests a run of the job outside of its schedule. The run is
+// created by the ScheduledWorkflow controller.
+func (r *ResourceManager) TriggerJob(ctx context.Context, jobID string) error {
+	return r.addManualTrigger(ctx, jobID, nil, nil)
+}
+
+// BackfillJob requests runs of the job for every time its schedule fires
+// between startTime and endTime, inclusive. The runs are created by the
+// ScheduledWorkflow controller.
+func (r *ResourceManager) BackfillJob(ctx context.Context, jobID string, startTime int64, endTime int64) error {
+	if endTime > r.time.Now().Unix() {
+		return util.NewInvalidInputError("Cannot backfill job %v up to %v, which is in the future.",
+			jobID, time.Unix(endTime, 0).UTC().Format(time.RFC3339))
+	}
+	backfillStartTime := v1.NewTime(time.Unix(startTime, 0).UTC())
+	backfillEndTime := v1.NewTime(time.Unix(endTime, 0).UTC())
+	return r.addManualTrigger(ctx, jobID, &backfillStartTime, &backfillEndTime)
+}
+
+func (r *ResourceManager) addManualTrigger(ctx context.Context, jobID string, backfillStartTime *v1.Time, backfillEndTime *v1.Time) error {
+	job, err := r.checkJobExist(ctx, jobID)
+	if err != nil {
+		return util.Wrap(err, "Failed to trigger job")
+	}
+	name, err := r.uuid.NewRandom()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to generate the manual trigger name.")
+	}
+	return r.appendManualTrigger(ctx, job, scheduledworkflow.ManualTrigger{
+		Name:              name.String(),
+		BackfillStartTime: backfillStartTime,
+		BackfillEndTime:   backfillEndTime,
+	})
+}
+
+// appendManualTrigger appends the trigger to the ScheduledWorkflow of the job,
+// unless the ScheduledWorkflow already has or had a trigger with the same name.
+func (r *ResourceManager) appendManualTrigger(ctx context.Context, job *model.Job, trigger scheduledworkflow.ManualTrigger) error {
+	// The controller updates the ScheduledWorkflow concurrently, so the trigger
+	// is appended with optimistic concurrency.
+	swfClient := r.getScheduledWorkflowClient(job.Namespace)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		swf, err := swfClient.Get(ctx, job.Name, v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if trigger.BackfillStartTime != nil && swf.Spec.Trigger.CronSchedule == nil && swf.Spec.Trigger.PeriodicSchedule == nil {
+			return util.NewInvalidInputError("Job %v has no schedule to backfill.", job.UUID)
+		}
+		for _, t := range swf.Spec.ManualTriggers {
+			if t.Name == trigger.Name {
+				return nil
+			}
+		}
+		// The controller removes the triggers it is done with, but keeps their names.
+		for _, name := range swf.Status.CompletedManualTriggers {
+			if name == trigger.Name {
+				return nil
+			}
+		}
+		swf = swf.DeepCopy()
+		swf.Spec.ManualTriggers = append(swf.Spec.ManualTriggers, trigger)
+		_, err = swfClient.Update(ctx, swf)
+		return err
+	})
+	if err != nil {
+		if _, ok := err.(*util.UserError); ok {
+			return err
+		}
+		return util.NewInternalServerError(err, "Failed to trigger job %v", job.UUID)
+	}
+	return nil
+}
+
+// replaceScheduledWorkflowSpec replaces the spec of the job's
+// ScheduledWorkflow and returns the replaced spec. The pending manual triggers
+// are kept, since they aren't part of the job definition.
+func (r *ResourceManager) replaceScheduledWorkflowSpec(ctx context.Context, job *model.Job,
+	spec scheduledworkflow.ScheduledWorkflowSpec) (scheduledworkflow.ScheduledWorkflowSpec, *scheduledworkflow.ScheduledWorkflow, error) {
+	// Manual triggers are appended concurrently, so the spec is replaced with
+	// optimistic concurrency.
+	swfClient := r.getScheduledWorkflowClient(job.Namespace)
+	var oldSpec scheduledworkflow.ScheduledWorkflowSpec
+	var newScheduledWorkflow *scheduledworkflow.ScheduledWorkflow
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		swf, err := swfClient.Get(ctx, job.Name, v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		oldSpec = swf.Spec
+		swf = swf.DeepCopy()
+		swf.Spec = *spec.DeepCopy()
+		swf.Spec.ManualTriggers = oldSpec.ManualTriggers
+		newScheduledWorkflow, err = swfClient.Update(ctx, swf)
+		return err
+	})
+	return oldSpec, newScheduledWorkflow, err
+}
+
+// restoreScheduledWorkflowSpec rolls back the spec of the job's
+// ScheduledWorkflow after the job failed to be stored.
+func (r *ResourceManager) restoreScheduledWorkflowSpec(ctx context.Context, job *model.Job, spec scheduledworkflow.ScheduledWorkflowSpec) {
+	if _, _, err := r.replaceScheduledWorkflowSpec(ctx, job, spec); err != nil {
+		glog.Errorf("Failed to restore the scheduled workflow of job %v: %v", job.UUID, err)
+	}
+}
+
 func (r *ResourceManager) DeleteJob(ctx context.Context, jobID string) error {
 	job, err := r.jobStore.GetJob(jobID)
 	if err != nil {
@@ -863,10 +1079,11 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, execSpec u
 		if err != nil {
 			return util.Wrap(err, "Failed to retrieve the experiment ID for the job that created the run.")
 		}
-		jobName, err := r.getResourceName(common.Job, jobId)
+		job, err := r.GetJob(jobId)
 		if err != nil {
 			return util.Wrap(err, "Failed to retrieve the job name for the job that created the run.")
 		}
+		jobName := job.DisplayName
 		// Scheduled time equals created time if it is not specified
 		var scheduledTimeInSec int64
 		if execSpec.ScheduledAtInSecOr0() == 0 {
@@ -888,6 +1105,9 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, execSpec u
 				Conditions:       string(condition),
 				PipelineSpec: model.PipelineSpec{
 					WorkflowSpecManifest: execSpec.GetExecutionSpec().ToStringForStore(),
+					// The parameters are stored so that the runs of the job can be filtered by them.
+					Parameters:    specParametersOrEmpty(execSpec),
+					RuntimeConfig: job.RuntimeConfig,
 				},
 				ResourceReferences: []*model.ResourceReference{
 					{
@@ -919,6 +1139,22 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, execSpec u
 	}
 
 	if execStatus.IsInFinalState() {
+		// The jobs are triggered before the final state is marked as persisted, so that
+		// the report is retried if they fail to be triggered.
+		if !execSpec.PersistedFinalState() {
+			err := r.triggerRunCompletionJobs(ctx, runId, jobId, execSpec.ExecutionNamespace(), string(condition))
+			if err != nil {
+				return util.Wrapf(err, "Failed to trigger the jobs that depend on run %s", runId)
+			}
+		}
+		// The notifications are enqueued on every report until the final state is
+		// marked as persisted. A subscription is only notified once of the run,
+		// since its delivery isn't created again.
+		if state := notificationState(execSpec.IsTerminated(), string(condition)); state != "" && !execSpec.PersistedFinalState() {
+			if err := r.enqueueRunNotifications(runId, state); err != nil {
+				return util.Wrapf(err, "Failed to notify the subscriptions to run %s", runId)
+			}
+		}
 		err := AddWorkflowLabel(ctx, r.getWorkflowClient(execSpec.ExecutionNamespace()), execSpec.ExecutionName(), util.LabelKeyWorkflowPersistedFinalState, "true")
 		if err != nil {
 			message := fmt.Sprintf("Failed to add PersistedFinalState label to workflow %s", execSpec.ExecutionName())
@@ -936,6 +1172,144 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, execSpec u
 	return nil
 }
 
+// specParametersOrEmpty returns the marshalled parameters of the execution
+// spec, or an empty string if it has no parameters or they can't be marshalled.
+func specParametersOrEmpty(execSpec util.ExecutionSpec) string {
+	params := execSpec.SpecParameters()
+	if len(params) == 0 {
+		return ""
+	}
+	paramsString, err := util.MarshalParameters(execSpec.ExecutionType(), params)
+	if err != nil {
+		glog.Warningf("Failed to marshal the parameters of execution %v: %v", execSpec.ExecutionName(), err)
+		return ""
+	}
+	return paramsString
+}
+
+// triggerRunCompletionJobs requests a run of every enabled job in the namespace of
+// the completed run that is triggered by the completion of the run in the given state.
+// The upstream of a job is either the job that created the run or the pipeline version
+// of the run. Requests are named after the run, so reporting the run again does not
+// request more runs.
+func (r *ResourceManager) triggerRunCompletionJobs(ctx context.Context, runId string, jobId string,
+	namespace string, state string) error {
+	var upstreams []*model.ResourceReference
+	versionUserId, versionUserType := runId, common.Run
+	if jobId != "" {
+		upstreams = append(upstreams, &model.ResourceReference{ReferenceUUID: jobId, ReferenceType: common.Job})
+		// The pipeline version of a run created by a job is referenced by the job.
+		versionUserId, versionUserType = jobId, common.Job
+	}
+	versionRef, err := r.resourceReferenceStore.GetResourceReference(versionUserId, versionUserType, common.PipelineVersion)
+	if err == nil {
+		upstreams = append(upstreams, versionRef)
+	} else if !util.IsUserErrorCodeMatch(err, codes.NotFound) {
+		return util.Wrap(err, "Failed to retrieve the pipeline version of the run")
+	}
+
+	for _, upstream := range upstreams {
+		jobs, err := r.jobStore.ListJobsTriggeredByRunCompletion(upstream.ReferenceType, upstream.ReferenceUUID)
+		if err != nil {
+			return err
+		}
+		for _, job := range jobs {
+			if job.Namespace != namespace || job.UUID == jobId || !isRunCompletionState(job.RunCompletionStates, state) {
+				continue
+			}
+			err = r.appendManualTrigger(ctx, job, scheduledworkflow.ManualTrigger{
+				Name:          "run-completion-" + runId,
+				UpstreamRunID: runId,
+			})
+			if err != nil {
+				return util.Wrapf(err, "Failed to trigger job %v", job.UUID)
+			}
+		}
+	}
+	return nil
+}
+
+// checkRunCompletionTriggerCycle fails if the job would be triggered again,
+// through the jobs it triggers, by the completion of its own runs. The job
+// runs the given pipeline version, if any, and is triggered by the completion
+// of the runs of the given upstream. The job ID is empty for a new job.
+func (r *ResourceManager) checkRunCompletionTriggerCycle(jobID string, namespace string, pipelineVersionID string,
+	upstreamType model.ResourceType, upstreamID string) error {
+	if upstreamID == "" {
+		return nil
+	}
+	type node struct {
+		jobID             string
+		pipelineVersionID string
+	}
+	// The stored definition of the job itself is ignored, since it is replaced.
+	visited := map[string]bool{jobID: true}
+	queue := []node{{jobID: jobID, pipelineVersionID: pipelineVersionID}}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		var downstreams []*model.Job
+		if n.jobID != "" {
+			jobs, err := r.jobStore.ListJobsTriggeredByRunCompletion(common.Job, n.jobID)
+			if err != nil {
+				return err
+			}
+			downstreams = append(downstreams, jobs...)
+		}
+		if n.pipelineVersionID != "" {
+			jobs, err := r.jobStore.ListJobsTriggeredByRunCompletion(common.PipelineVersion, n.pipelineVersionID)
+			if err != nil {
+				return err
+			}
+			downstreams = append(downstreams, jobs...)
+		}
+		for _, downstream := range downstreams {
+			if downstream.Namespace != namespace || visited[downstream.UUID] {
+				continue
+			}
+			visited[downstream.UUID] = true
+			versionID := getPipelineVersionIdFromModelJob(downstream)
+			if (upstreamType == common.Job && upstreamID == downstream.UUID) ||
+				(upstreamType == common.PipelineVersion && upstreamID == versionID) {
+				return util.NewInvalidInputError(
+					"The run completion trigger creates a cycle: the job triggers job %v, whose runs trigger the job.",
+					downstream.UUID)
+			}
+			queue = append(queue, node{jobID: downstream.UUID, pipelineVersionID: versionID})
+		}
+	}
+	return nil
+}
+
+// checkAPIJobRunCompletionTriggerCycle fails if the run completion trigger of
+// the job, if any, creates a cycle. The job ID is empty for a new job.
+func (r *ResourceManager) checkAPIJobRunCompletionTriggerCycle(jobID string, namespace string, apiJob *api.Job) error {
+	upstream := apiJob.GetTrigger().GetRunCompletionTrigger().GetUpstream()
+	if upstream == nil {
+		return nil
+	}
+	upstreamType, err := common.ToModelResourceType(upstream.GetType())
+	if err != nil {
+		return err
+	}
+	return r.checkRunCompletionTriggerCycle(jobID, namespace,
+		getPipelineVersionIdFromAPIResourceReferences(apiJob.GetResourceReferences()), upstreamType, upstream.GetId())
+}
+
+// isRunCompletionState returns whether the state is one of the comma-separated
+// states of a run completion trigger, which default to Succeeded.
+func isRunCompletionState(states *string, state string) bool {
+	if states == nil || *states == "" {
+		return state == string(exec.ExecutionSucceeded)
+	}
+	for _, s := range strings.Split(*states, ",") {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
 // AddWorkflowLabel add label for a workflow
 func AddWorkflowLabel(ctx context.Context, wfClient util.ExecutionInterface, name string, labelKey string, labelValue string) error {
 	patchObj := map[string]interface{}{
@@ -1124,7 +1498,7 @@ func (r *ResourceManager) ReadArtifact(runID string, nodeID string, artifactName
 	if run.WorkflowRuntimeManifest == "" {
 		return nil, util.NewInvalidInputError("read artifact from run with v2 IR spec is not supported")
 	}
-	execSpec, err := util.NewExecutionSpecJSON(util.ArgoWorkflow, []byte(run.WorkflowRuntimeManifest))
+	execSpec, err := util.NewExecutionSpecJSON(common.GetExecutionType(), []byte(run.WorkflowRuntimeManifest))
 	if err != nil {
 		// This should never happen.
 		return nil, util.NewInternalServerError(
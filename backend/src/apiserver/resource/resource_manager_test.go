@@ -30,6 +30,7 @@ import (
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
@@ -1357,6 +1358,36 @@ func TestRetryRun(t *testing.T) {
 	assert.Contains(t, actualRunDetail.WorkflowRuntimeManifest, "Running")
 }
 
+func TestRetryRun_V2(t *testing.T) {
+	store, manager, runDetail := initWithOneTimeRunV2(t)
+	defer store.Close()
+
+	err := manager.RetryRun(context.Background(), runDetail.UUID)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Workflow must be Failed/Error to retry")
+
+	failedWorkflow := util.NewWorkflow(testWorkflow.DeepCopy())
+	failedWorkflow.SetExecutionName(runDetail.Name)
+	failedWorkflow.SetLabels(util.LabelKeyWorkflowRunId, runDetail.UUID)
+	failedWorkflow.Status.Phase = v1alpha1.WorkflowFailed
+	failedWorkflow.Status.Nodes = map[string]v1alpha1.NodeStatus{
+		"node1": {Name: "driver", Type: v1alpha1.NodeTypePod, Phase: v1alpha1.NodeSucceeded},
+		"node2": {Name: "executor", Type: v1alpha1.NodeTypePod, Phase: v1alpha1.NodeFailed},
+	}
+	err = manager.ReportWorkflowResource(context.Background(), failedWorkflow)
+	assert.Nil(t, err)
+
+	err = manager.RetryRun(context.Background(), runDetail.UUID)
+	assert.Nil(t, err)
+
+	actualRunDetail, err := manager.GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, v2SpecHelloWorld, actualRunDetail.PipelineSpecManifest)
+	assert.Contains(t, actualRunDetail.WorkflowRuntimeManifest, "Running")
+	assert.Contains(t, actualRunDetail.WorkflowRuntimeManifest, "driver")
+	assert.NotContains(t, actualRunDetail.WorkflowRuntimeManifest, "executor")
+}
+
 func TestRetryRun_RunNotExist(t *testing.T) {
 	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
 	defer store.Close()
@@ -1918,6 +1949,292 @@ func TestEnableJob_DbFailure(t *testing.T) {
 	assert.Contains(t, err.Error(), "database is closed")
 }
 
+func TestUpdateJob(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	swfClient := store.SwfClient().ScheduledWorkflow("ns1")
+	swf, err := swfClient.Get(context.Background(), job.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	lastTriggeredTime := v1.NewTime(time.Unix(100, 0).UTC())
+	swf.Status.Trigger.LastTriggeredTime = &lastTriggeredTime
+	swf.Status.Trigger.LastIndex = util.Int64Pointer(5)
+
+	apiJob := &api.Job{
+		Name:                    "j1",
+		Description:             "updated",
+		Enabled:                 true,
+		MaxConcurrency:          3,
+		ConcurrencyPolicy:       "Replace",
+		StartingDeadlineSeconds: 300,
+		Trigger: &api.Trigger{
+			Trigger: &api.Trigger_CronSchedule{CronSchedule: &api.CronSchedule{Cron: "0 0 * * * *"}},
+		},
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
+		},
+	}
+	updatedJob, err := manager.UpdateJob(context.Background(), job.UUID, apiJob)
+	assert.Nil(t, err)
+	assert.Equal(t, job.UUID, updatedJob.UUID)
+	assert.Equal(t, job.Name, updatedJob.Name)
+	assert.Equal(t, "updated", updatedJob.Description)
+	assert.Equal(t, int64(3), updatedJob.MaxConcurrency)
+	assert.Equal(t, "Replace", updatedJob.ConcurrencyPolicy)
+	assert.Equal(t, int64(300), updatedJob.StartingDeadlineSeconds)
+	assert.Equal(t, util.StringPointer("0 0 * * * *"), updatedJob.Cron)
+	assert.Equal(t, `[{"name":"param1","value":"world"}]`, updatedJob.Parameters)
+	assert.Equal(t, job.CreatedAtInSec, updatedJob.CreatedAtInSec)
+	assert.True(t, updatedJob.UpdatedAtInSec > job.UpdatedAtInSec)
+	// The job stays in its experiment.
+	assert.Equal(t, job.ResourceReferences, updatedJob.ResourceReferences)
+
+	swf, err = swfClient.Get(context.Background(), job.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, job.UUID, string(swf.UID))
+	assert.Equal(t, "0 0 * * * *", swf.Spec.Trigger.CronSchedule.Cron)
+	assert.Equal(t, int64(3), *swf.Spec.MaxConcurrency)
+	assert.Equal(t, swfapi.ReplaceConcurrent, swf.Spec.ConcurrencyPolicy)
+	assert.Equal(t, util.Int64Pointer(300), swf.Spec.StartingDeadlineSeconds)
+	assert.Equal(t, &lastTriggeredTime, swf.Status.Trigger.LastTriggeredTime)
+	assert.Equal(t, util.Int64Pointer(5), swf.Status.Trigger.LastIndex)
+}
+
+func TestUpdateJob_ChangeExperiment(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	apiJob := &api.Job{
+		Name:         "j1",
+		Enabled:      true,
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: "another-experiment"},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+	_, err := manager.UpdateJob(context.Background(), job.UUID, apiJob)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "cannot be changed")
+}
+
+func TestUpdateJob_JobNotExist(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	_, err := manager.UpdateJob(context.Background(), "1", &api.Job{Name: "j1"})
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "Job 1 not found")
+}
+
+func TestUpdateJob_InvalidWorkflowSpec(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	apiJob := &api.Job{
+		Name:         "j1",
+		Enabled:      true,
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: "I am invalid"},
+	}
+	_, err := manager.UpdateJob(context.Background(), job.UUID, apiJob)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+
+	// The job is left untouched.
+	unchangedJob, err := manager.GetJob(job.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, job, unchangedJob)
+}
+
+// createRunCompletionJob stores an enabled job that is triggered by the
+// completion of the runs of the upstream job.
+func createRunCompletionJob(t *testing.T, store *FakeClientManager, jobID string, namespace string,
+	upstreamJobID string) *model.Job {
+	jobType, states := string(common.Job), ""
+	job, err := store.JobStore().CreateJob(&model.Job{
+		UUID:      jobID,
+		Name:      jobID,
+		Namespace: namespace,
+		Enabled:   true,
+		Trigger: model.Trigger{
+			RunCompletionTrigger: model.RunCompletionTrigger{
+				RunCompletionUpstreamType: &jobType,
+				RunCompletionUpstreamId:   &upstreamJobID,
+				RunCompletionStates:       &states,
+			},
+		},
+	})
+	require.Nil(t, err)
+	return job
+}
+
+func TestUpdateJob_RunCompletionTriggerCycle(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	createRunCompletionJob(t, store, "DOWNSTREAM_JOB_1", "ns1", job.UUID)
+	createRunCompletionJob(t, store, "DOWNSTREAM_JOB_2", "ns1", "DOWNSTREAM_JOB_1")
+
+	apiJob := &api.Job{
+		Name:         "j1",
+		Enabled:      true,
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		Trigger: &api.Trigger{
+			Trigger: &api.Trigger_RunCompletionTrigger{RunCompletionTrigger: &api.RunCompletionTrigger{
+				Upstream: &api.ResourceKey{Type: api.ResourceType_JOB, Id: "DOWNSTREAM_JOB_2"},
+			}},
+		},
+	}
+	_, err := manager.UpdateJob(context.Background(), job.UUID, apiJob)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "cycle")
+
+	// The job is left untouched.
+	unchangedJob, err := manager.GetJob(job.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, job, unchangedJob)
+
+	// Jobs in other namespaces are not triggered, so they don't create cycles.
+	createRunCompletionJob(t, store, "DOWNSTREAM_JOB_3", "ns2", job.UUID)
+	createRunCompletionJob(t, store, "DOWNSTREAM_JOB_4", "ns1", "DOWNSTREAM_JOB_3")
+	apiJob.Trigger.GetRunCompletionTrigger().Upstream.Id = "DOWNSTREAM_JOB_4"
+	_, err = manager.UpdateJob(context.Background(), job.UUID, apiJob)
+	assert.Nil(t, err)
+}
+
+func TestEnableJob_RunCompletionTriggerCycle(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	createRunCompletionJob(t, store, "DOWNSTREAM_JOB", "ns1", job.UUID)
+	// Disabled jobs are not triggered, so the cycle is only closed once the job
+	// is enabled.
+	jobType, upstreamID := string(common.Job), "DOWNSTREAM_JOB"
+	job.Enabled = false
+	job.RunCompletionUpstreamType = &jobType
+	job.RunCompletionUpstreamId = &upstreamID
+	require.Nil(t, store.JobStore().ReplaceJob(job))
+
+	err := manager.EnableJob(context.Background(), job.UUID, true)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "cycle")
+	disabledJob, err := manager.GetJob(job.UUID)
+	assert.Nil(t, err)
+	assert.False(t, disabledJob.Enabled)
+}
+
+func TestTriggerJob(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	err := manager.TriggerJob(context.Background(), job.UUID)
+	assert.Nil(t, err)
+
+	swf, err := store.SwfClient().ScheduledWorkflow("ns1").Get(context.Background(), job.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Len(t, swf.Spec.ManualTriggers, 1)
+	assert.NotEmpty(t, swf.Spec.ManualTriggers[0].Name)
+	assert.Nil(t, swf.Spec.ManualTriggers[0].BackfillStartTime)
+	assert.Nil(t, swf.Spec.ManualTriggers[0].BackfillEndTime)
+}
+
+func TestTriggerJob_JobNotExist(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	err := manager.TriggerJob(context.Background(), "1")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "Job 1 not found")
+}
+
+func TestBackfillJob(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	job, err := manager.CreateJob(context.Background(), &api.Job{
+		Name:         "j1",
+		Enabled:      true,
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		Trigger: &api.Trigger{
+			Trigger: &api.Trigger_CronSchedule{CronSchedule: &api.CronSchedule{Cron: "0 0 * * * *"}},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	// The fake time is past the end time of the backfill.
+	err = manager.BackfillJob(context.Background(), job.UUID, 0, 1)
+	assert.Nil(t, err)
+	// Manual triggers are named after a new UUID.
+	store.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	manager = NewResourceManager(store)
+	err = manager.TriggerJob(context.Background(), job.UUID)
+	assert.Nil(t, err)
+
+	swf, err := store.SwfClient().ScheduledWorkflow("ns1").Get(context.Background(), job.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Len(t, swf.Spec.ManualTriggers, 2)
+	assert.Equal(t, int64(0), swf.Spec.ManualTriggers[0].BackfillStartTime.Unix())
+	assert.Equal(t, int64(1), swf.Spec.ManualTriggers[0].BackfillEndTime.Unix())
+	assert.Nil(t, swf.Spec.ManualTriggers[1].BackfillStartTime)
+}
+
+func TestUpdateJob_KeepsManualTriggers(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	apiJob := &api.Job{
+		Name:         "j1",
+		Enabled:      true,
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		Trigger: &api.Trigger{
+			Trigger: &api.Trigger_CronSchedule{CronSchedule: &api.CronSchedule{Cron: "0 0 * * * *"}},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+	job, err := manager.CreateJob(context.Background(), apiJob)
+	assert.Nil(t, err)
+	err = manager.BackfillJob(context.Background(), job.UUID, 0, 1)
+	assert.Nil(t, err)
+
+	apiJob.Description = "updated"
+	apiJob.Trigger = &api.Trigger{
+		Trigger: &api.Trigger_CronSchedule{CronSchedule: &api.CronSchedule{Cron: "0 30 * * * *"}},
+	}
+	_, err = manager.UpdateJob(context.Background(), job.UUID, apiJob)
+	assert.Nil(t, err)
+
+	// The pending backfill survives the update of the job.
+	swf, err := store.SwfClient().ScheduledWorkflow("ns1").Get(context.Background(), job.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "0 30 * * * *", swf.Spec.Trigger.CronSchedule.Cron)
+	assert.Equal(t, []swfapi.ManualTrigger{{
+		Name:              DefaultFakeUUID,
+		BackfillStartTime: &v1.Time{Time: time.Unix(0, 0).UTC()},
+		BackfillEndTime:   &v1.Time{Time: time.Unix(1, 0).UTC()},
+	}}, swf.Spec.ManualTriggers)
+}
+
+func TestBackfillJob_Future(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	err := manager.BackfillJob(context.Background(), job.UUID, 0, time.Now().Unix()+3600)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "in the future")
+}
+
+func TestBackfillJob_NoSchedule(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	err := manager.BackfillJob(context.Background(), job.UUID, 0, 1)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "has no schedule to backfill")
+}
+
 func TestDeleteJob(t *testing.T) {
 	store, manager, job := initWithJob(t)
 	defer store.Close()
@@ -2087,6 +2404,45 @@ func TestReportWorkflowResource_ScheduledWorkflowIDNotEmpty_Success(t *testing.T
 	assert.Equal(t, expectedRunDetail, runDetail)
 }
 
+func TestReportWorkflowResource_ScheduledWorkflowIDNotEmpty_StoresParameters(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+
+	workflow := util.NewWorkflow(&v1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "MY_NAME",
+			Namespace: "MY_NAMESPACE",
+			UID:       "WORKFLOW_1",
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: "WORKFLOW_1"},
+			OwnerReferences: []v1.OwnerReference{{
+				APIVersion: "kubeflow.org/v1beta1",
+				Kind:       "ScheduledWorkflow",
+				Name:       "SCHEDULE_NAME",
+				UID:        types.UID(job.UUID),
+			}},
+			CreationTimestamp: v1.NewTime(time.Unix(11, 0).UTC()),
+		},
+		Spec: v1alpha1.WorkflowSpec{
+			Arguments: v1alpha1.Arguments{Parameters: []v1alpha1.Parameter{{Name: "param1", Value: v1alpha1.AnyStringPtr("world")}}},
+		},
+	})
+	err := manager.ReportWorkflowResource(context.Background(), workflow)
+	assert.Nil(t, err)
+
+	runDetail, err := manager.GetRun("WORKFLOW_1")
+	assert.Nil(t, err)
+	assert.Equal(t, `[{"name":"param1","value":"world"}]`, runDetail.Parameters)
+
+	opts, err := list.NewOptions(&model.Run{}, 10, "", &api.Filter{Predicates: []*api.Predicate{
+		{Key: "parameter:param1", Op: api.Predicate_EQUALS, Value: &api.Predicate_StringValue{StringValue: "world"}},
+	}})
+	assert.Nil(t, err)
+	runs, _, _, err := manager.ListRuns(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Len(t, runs, 1)
+	assert.Equal(t, "WORKFLOW_1", runs[0].UUID)
+}
+
 func TestReportWorkflowResource_ScheduledWorkflowIDNotEmpty_NoExperiment_Success(t *testing.T) {
 	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
 	defer store.Close()
@@ -2215,6 +2571,92 @@ func TestReportWorkflowResource_WorkflowCompleted(t *testing.T) {
 	assert.Equal(t, wf.ExecutionObjectMeta().Labels[util.LabelKeyWorkflowPersistedFinalState], "true")
 }
 
+func TestReportWorkflowResource_WorkflowCompleted_TriggersRunCompletionJobs(t *testing.T) {
+	store, manager, upstream := initWithJob(t)
+	defer store.Close()
+	// The fake scheduled workflow client assigns the same UID to every job,
+	// so the downstream job is stored directly.
+	_, err := store.SwfClient().ScheduledWorkflow("ns1").Create(context.Background(), &swfapi.ScheduledWorkflow{
+		ObjectMeta: v1.ObjectMeta{GenerateName: "j2"},
+	})
+	assert.Nil(t, err)
+	jobType, states := string(common.Job), ""
+	downstream, err := store.JobStore().CreateJob(&model.Job{
+		UUID:      "DOWNSTREAM_JOB",
+		Name:      "j2",
+		Namespace: "ns1",
+		Enabled:   true,
+		Trigger: model.Trigger{
+			RunCompletionTrigger: model.RunCompletionTrigger{
+				RunCompletionUpstreamType: &jobType,
+				RunCompletionUpstreamId:   &upstream.UUID,
+				RunCompletionStates:       &states,
+			},
+		},
+		ResourceReferences: []*model.ResourceReference{
+			{
+				ResourceUUID:  "DOWNSTREAM_JOB",
+				ResourceType:  common.Job,
+				ReferenceUUID: DefaultFakeUUID,
+				ReferenceName: "e1",
+				ReferenceType: common.Experiment,
+				Relationship:  common.Owner,
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	reportWorkflow := func(runId string, phase v1alpha1.WorkflowPhase) {
+		workflow := util.NewWorkflow(&v1alpha1.Workflow{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      runId,
+				Namespace: downstream.Namespace,
+				UID:       types.UID(runId),
+				Labels:    map[string]string{util.LabelKeyWorkflowRunId: runId},
+				OwnerReferences: []v1.OwnerReference{{
+					APIVersion: "kubeflow.org/v1beta1",
+					Kind:       "ScheduledWorkflow",
+					Name:       upstream.Name,
+					UID:        types.UID(upstream.UUID),
+				}},
+				CreationTimestamp: v1.NewTime(time.Unix(11, 0).UTC()),
+			},
+			Status: v1alpha1.WorkflowStatus{Phase: phase},
+		})
+		_, err := store.ExecClientFake.Execution(downstream.Namespace).Create(context.Background(), workflow, v1.CreateOptions{})
+		assert.Nil(t, err)
+		err = manager.ReportWorkflowResource(context.Background(), workflow)
+		assert.Nil(t, err)
+	}
+
+	// Failed runs do not trigger the downstream job by default.
+	reportWorkflow("WORKFLOW_1", v1alpha1.WorkflowFailed)
+	swf, err := store.SwfClient().ScheduledWorkflow(downstream.Namespace).Get(context.Background(), downstream.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Empty(t, swf.Spec.ManualTriggers)
+
+	reportWorkflow("WORKFLOW_2", v1alpha1.WorkflowSucceeded)
+	swf, err = store.SwfClient().ScheduledWorkflow(downstream.Namespace).Get(context.Background(), downstream.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Len(t, swf.Spec.ManualTriggers, 1)
+	assert.Equal(t, "run-completion-WORKFLOW_2", swf.Spec.ManualTriggers[0].Name)
+	assert.Equal(t, "WORKFLOW_2", swf.Spec.ManualTriggers[0].UpstreamRunID)
+
+	// The controller removes the trigger once the downstream run is created.
+	swf.Spec.ManualTriggers = nil
+	swf.Status.CompletedManualTriggers = []string{"run-completion-WORKFLOW_2"}
+	_, err = store.SwfClient().ScheduledWorkflow(downstream.Namespace).Update(context.Background(), swf)
+	assert.Nil(t, err)
+	// Reporting the run again, before its final state is persisted, doesn't
+	// trigger the downstream job again.
+	err = manager.triggerRunCompletionJobs(context.Background(), "WORKFLOW_2", upstream.UUID,
+		downstream.Namespace, string(v1alpha1.WorkflowSucceeded))
+	assert.Nil(t, err)
+	swf, err = store.SwfClient().ScheduledWorkflow(downstream.Namespace).Get(context.Background(), downstream.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Empty(t, swf.Spec.ManualTriggers)
+}
+
 func TestReportWorkflowResource_WorkflowCompleted_WorkflowNotFound(t *testing.T) {
 	store, manager, run := initWithOneTimeRun(t)
 	defer store.Close()
@@ -19,6 +19,7 @@ import (
 
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
@@ -35,6 +36,28 @@ func deletePods(ctx context.Context, k8sCoreClient client.KubernetesCoreInterfac
 	return nil
 }
 
+// getPipelineVersionIdFromAPIResourceReferences returns the pipeline version a
+// run or job is created from, if any.
+func getPipelineVersionIdFromAPIResourceReferences(references []*api.ResourceReference) string {
+	for _, reference := range references {
+		if reference.Key.Type == api.ResourceType_PIPELINE_VERSION && reference.Relationship == api.Relationship_CREATOR {
+			return reference.Key.Id
+		}
+	}
+	return ""
+}
+
+// getPipelineVersionIdFromModelJob returns the pipeline version the job is
+// created from, if any.
+func getPipelineVersionIdFromModelJob(job *model.Job) string {
+	for _, reference := range job.ResourceReferences {
+		if reference.ReferenceType == common.PipelineVersion && reference.Relationship == common.Creator {
+			return reference.ReferenceUUID
+		}
+	}
+	return ""
+}
+
 // Convert PipelineId in PipelineSpec to the pipeline's default pipeline version.
 // This is for legacy usage of pipeline id to create run. The standard way to
 // create run is by specifying the pipeline version.
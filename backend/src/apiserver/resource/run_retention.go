@@ -0,0 +1,259 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"sort"
+
+	"github.com/golang/glog"
+	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+const (
+	secondsPerDay = 24 * 60 * 60
+	// The number of runs listed and processed at a time.
+	runRetentionChunkSize = 50
+)
+
+// RunRetentionPolicy limits how long finished runs are kept. Rules with a zero
+// value are disabled. Runs are aged by their creation time.
+type RunRetentionPolicy struct {
+	// Finished runs older than this many days are archived.
+	ArchiveAfterDays int64
+	// Archived runs older than this many days are deleted.
+	DeleteArchivedAfterDays int64
+	// Only this many of the latest runs of each job are kept, the older
+	// finished runs are deleted.
+	KeepLastRunsPerJob int
+}
+
+func (p RunRetentionPolicy) isEnabled() bool {
+	return p.ArchiveAfterDays > 0 || p.DeleteArchivedAfterDays > 0 || p.KeepLastRunsPerJob > 0
+}
+
+// RunRetentionPolicies are the retention policies of the server. The policy of
+// an experiment takes precedence over the policy of its namespace, which takes
+// precedence over the default policy.
+type RunRetentionPolicies struct {
+	Default RunRetentionPolicy
+	// Policies by namespace.
+	Namespaces map[string]RunRetentionPolicy
+	// Policies by experiment ID.
+	Experiments map[string]RunRetentionPolicy
+}
+
+// IsEnabled returns whether any of the policies has an enabled rule.
+func (p *RunRetentionPolicies) IsEnabled() bool {
+	for _, scope := range p.scopes() {
+		if scope.policy.isEnabled() {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RunRetentionPolicies) policyFor(namespace string, experimentId string) RunRetentionPolicy {
+	if policy, ok := p.Experiments[experimentId]; ok {
+		return policy
+	}
+	if policy, ok := p.Namespaces[namespace]; ok {
+		return policy
+	}
+	return p.Default
+}
+
+type runRetentionScope struct {
+	policy RunRetentionPolicy
+	filter storage.ExpiredRunsFilter
+}
+
+// scopes returns each policy with the filter selecting the runs it applies to,
+// which skips the runs that a more specific policy applies to.
+func (p *RunRetentionPolicies) scopes() []runRetentionScope {
+	experimentIds := make([]string, 0, len(p.Experiments))
+	for experimentId := range p.Experiments {
+		experimentIds = append(experimentIds, experimentId)
+	}
+	sort.Strings(experimentIds)
+	namespaces := make([]string, 0, len(p.Namespaces))
+	for namespace := range p.Namespaces {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	scopes := []runRetentionScope{{
+		policy: p.Default,
+		filter: storage.ExpiredRunsFilter{ExcludedExperimentUUIDs: experimentIds, ExcludedNamespaces: namespaces},
+	}}
+	for _, namespace := range namespaces {
+		scopes = append(scopes, runRetentionScope{
+			policy: p.Namespaces[namespace],
+			filter: storage.ExpiredRunsFilter{Namespace: namespace, ExcludedExperimentUUIDs: experimentIds},
+		})
+	}
+	for _, experimentId := range experimentIds {
+		scopes = append(scopes, runRetentionScope{
+			policy: p.Experiments[experimentId],
+			filter: storage.ExpiredRunsFilter{ExperimentUUID: experimentId},
+		})
+	}
+	return scopes
+}
+
+// RunRetentionReport lists the runs that were archived and deleted when the
+// retention policies were enforced, or that would be in a dry run.
+type RunRetentionReport struct {
+	ArchivedRunIDs []string
+	DeletedRunIDs  []string
+}
+
+// EnforceRunRetentionPolicies archives and deletes the finished runs that
+// expired under the retention policies. In a dry run, the runs are only
+// reported. Runs that fail to be archived or deleted are logged and skipped.
+func (r *ResourceManager) EnforceRunRetentionPolicies(ctx context.Context, policies *RunRetentionPolicies, dryRun bool) (*RunRetentionReport, error) {
+	report := &RunRetentionReport{ArchivedRunIDs: []string{}, DeletedRunIDs: []string{}}
+	deleted := map[string]bool{}
+	deleteRun := func(runId string) error {
+		if deleted[runId] {
+			return nil
+		}
+		if !dryRun {
+			if err := r.DeleteRun(ctx, runId); err != nil {
+				return err
+			}
+		}
+		deleted[runId] = true
+		report.DeletedRunIDs = append(report.DeletedRunIDs, runId)
+		return nil
+	}
+	archiveRun := func(runId string) error {
+		if deleted[runId] {
+			return nil
+		}
+		if !dryRun {
+			if err := r.ArchiveRun(runId); err != nil {
+				return err
+			}
+		}
+		report.ArchivedRunIDs = append(report.ArchivedRunIDs, runId)
+		return nil
+	}
+
+	now := r.time.Now().Unix()
+	scopes := policies.scopes()
+	// Archived runs are deleted before available runs are archived, so that a
+	// dry run reports the same runs as the pass that would follow it.
+	for _, scope := range scopes {
+		if scope.policy.DeleteArchivedAfterDays <= 0 {
+			continue
+		}
+		filter := scope.filter
+		filter.StorageState = api.Run_STORAGESTATE_ARCHIVED.String()
+		filter.CreatedBeforeInSec = now - scope.policy.DeleteArchivedAfterDays*secondsPerDay
+		if err := r.enforceOnExpiredRuns(&filter, deleteRun); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.enforceKeepLastRunsPerJob(policies, deleteRun); err != nil {
+		return nil, err
+	}
+	for _, scope := range scopes {
+		if scope.policy.ArchiveAfterDays <= 0 {
+			continue
+		}
+		filter := scope.filter
+		filter.StorageState = api.Run_STORAGESTATE_AVAILABLE.String()
+		filter.CreatedBeforeInSec = now - scope.policy.ArchiveAfterDays*secondsPerDay
+		if err := r.enforceOnExpiredRuns(&filter, archiveRun); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// enforceKeepLastRunsPerJob deletes the finished runs of each job that are
+// older than the latest runs the policy of the job keeps.
+func (r *ResourceManager) enforceKeepLastRunsPerJob(policies *RunRetentionPolicies, deleteRun func(runId string) error) error {
+	opts, err := list.NewOptions(&model.Job{}, runRetentionChunkSize, "", nil)
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to create list jobs options when enforcing run retention policies.")
+	}
+	for {
+		jobs, _, newToken, err := r.jobStore.ListJobs(&common.FilterContext{}, opts)
+		if err != nil {
+			return util.Wrap(err, "Failed to list jobs when enforcing run retention policies.")
+		}
+		for _, job := range jobs {
+			experimentId := ""
+			for _, reference := range job.ResourceReferences {
+				if reference.ReferenceType == common.Experiment {
+					experimentId = reference.ReferenceUUID
+				}
+			}
+			keep := policies.policyFor(job.Namespace, experimentId).KeepLastRunsPerJob
+			if keep <= 0 {
+				continue
+			}
+			// Runs created in the same second as the oldest kept run are kept too.
+			oldestKeptAtInSec, err := r.runStore.GetJobRunCreatedAtInSec(job.UUID, keep-1)
+			if err != nil {
+				return err
+			}
+			if oldestKeptAtInSec == 0 {
+				continue
+			}
+			filter := &storage.ExpiredRunsFilter{JobUUID: job.UUID, CreatedBeforeInSec: oldestKeptAtInSec}
+			if err := r.enforceOnExpiredRuns(filter, deleteRun); err != nil {
+				return err
+			}
+		}
+		if newToken == "" {
+			return nil
+		}
+		opts, err = list.NewOptionsFromToken(newToken, runRetentionChunkSize)
+		if err != nil {
+			return util.NewInternalServerError(err,
+				"Failed to create list jobs options from page token when enforcing run retention policies.")
+		}
+	}
+}
+
+// enforceOnExpiredRuns applies enforce to the runs selected by the filter, a
+// chunk of runs at a time.
+func (r *ResourceManager) enforceOnExpiredRuns(filter *storage.ExpiredRunsFilter, enforce func(runId string) error) error {
+	afterRunId := ""
+	for {
+		runIds, err := r.runStore.ListExpiredRunIDs(filter, afterRunId, runRetentionChunkSize)
+		if err != nil {
+			return util.Wrap(err, "Failed to list the runs expired under the run retention policies.")
+		}
+		for _, runId := range runIds {
+			if err := enforce(runId); err != nil {
+				glog.Errorf("Failed to enforce the run retention policies on run %v. Error: %v", runId, err)
+			}
+		}
+		if len(runIds) < runRetentionChunkSize {
+			return nil
+		}
+		afterRunId = runIds[len(runIds)-1]
+	}
+}
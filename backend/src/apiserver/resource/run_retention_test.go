@@ -0,0 +1,176 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+// initWithRunsForRetention stores runs created on the given days, where the
+// server time is day 100. Runs of job1 belong to the first experiment.
+func initWithRunsForRetention(t *testing.T) (*FakeClientManager, *ResourceManager, string, string) {
+	store, err := NewFakeClientManager(util.NewFakeTime(time.Unix(100*secondsPerDay, 0)), util.NewUUIDGenerator())
+	assert.Nil(t, err)
+	experiment1, err := store.ExperimentStore().CreateExperiment(&model.Experiment{Name: "e1", Namespace: "ns1"})
+	assert.Nil(t, err)
+	experiment2, err := store.ExperimentStore().CreateExperiment(&model.Experiment{Name: "e2", Namespace: "ns1"})
+	assert.Nil(t, err)
+	_, err = store.JobStore().CreateJob(&model.Job{
+		UUID:       "job1",
+		Name:       "j1",
+		Namespace:  "ns1",
+		Conditions: "ready",
+		ResourceReferences: []*model.ResourceReference{
+			{
+				ResourceUUID: "job1", ResourceType: common.Job,
+				ReferenceUUID: experiment1.UUID, ReferenceName: "e1", ReferenceType: common.Experiment,
+				Relationship: common.Owner,
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	runs := []struct {
+		uuid         string
+		experimentId string
+		jobId        string
+		storageState api.Run_StorageState
+		createdAtDay int64
+		finished     bool
+	}{
+		{"run1", experiment1.UUID, "", api.Run_STORAGESTATE_ARCHIVED, 10, true},
+		{"run2", experiment1.UUID, "", api.Run_STORAGESTATE_ARCHIVED, 50, true},
+		{"run3", experiment1.UUID, "", api.Run_STORAGESTATE_AVAILABLE, 20, true},
+		{"run4", experiment1.UUID, "", api.Run_STORAGESTATE_AVAILABLE, 20, false},
+		{"run5", experiment2.UUID, "", api.Run_STORAGESTATE_AVAILABLE, 20, true},
+		{"run6", experiment1.UUID, "job1", api.Run_STORAGESTATE_AVAILABLE, 95, true},
+		{"run7", experiment1.UUID, "job1", api.Run_STORAGESTATE_AVAILABLE, 96, true},
+		{"run8", experiment1.UUID, "job1", api.Run_STORAGESTATE_AVAILABLE, 97, true},
+	}
+	for _, run := range runs {
+		references := []*model.ResourceReference{
+			{
+				ResourceUUID: run.uuid, ResourceType: common.Run,
+				ReferenceUUID: run.experimentId, ReferenceName: "e", ReferenceType: common.Experiment,
+				Relationship: common.Owner,
+			},
+		}
+		if run.jobId != "" {
+			references = append(references, &model.ResourceReference{
+				ResourceUUID: run.uuid, ResourceType: common.Run,
+				ReferenceUUID: run.jobId, ReferenceName: "j1", ReferenceType: common.Job,
+				Relationship: common.Creator,
+			})
+		}
+		finishedAtInSec := int64(0)
+		if run.finished {
+			finishedAtInSec = run.createdAtDay*secondsPerDay + 1
+		}
+		_, err := store.RunStore().CreateRun(&model.RunDetail{
+			Run: model.Run{
+				UUID:               run.uuid,
+				ExperimentUUID:     run.experimentId,
+				Name:               run.uuid,
+				DisplayName:        run.uuid,
+				StorageState:       run.storageState.String(),
+				Namespace:          "ns1",
+				CreatedAtInSec:     run.createdAtDay * secondsPerDay,
+				FinishedAtInSec:    finishedAtInSec,
+				Conditions:         "Succeeded",
+				ResourceReferences: references,
+			},
+		})
+		assert.Nil(t, err)
+	}
+	return store, NewResourceManager(store), experiment1.UUID, experiment2.UUID
+}
+
+func TestEnforceRunRetentionPolicies(t *testing.T) {
+	store, manager, _, experiment2 := initWithRunsForRetention(t)
+	defer store.Close()
+
+	policies := &RunRetentionPolicies{
+		Default: RunRetentionPolicy{ArchiveAfterDays: 30, DeleteArchivedAfterDays: 60, KeepLastRunsPerJob: 2},
+		Experiments: map[string]RunRetentionPolicy{
+			experiment2: {DeleteArchivedAfterDays: 60},
+		},
+	}
+	expectedReport := &RunRetentionReport{
+		ArchivedRunIDs: []string{"run3"},
+		DeletedRunIDs:  []string{"run1", "run6"},
+	}
+
+	report, err := manager.EnforceRunRetentionPolicies(context.Background(), policies, true)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedReport, report)
+	for _, runId := range []string{"run1", "run3", "run6"} {
+		run, err := manager.GetRun(runId)
+		assert.Nil(t, err)
+		assert.NotNil(t, run)
+	}
+
+	report, err = manager.EnforceRunRetentionPolicies(context.Background(), policies, false)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedReport, report)
+	for _, runId := range []string{"run1", "run6"} {
+		_, err := manager.GetRun(runId)
+		assert.True(t, util.IsUserErrorCodeMatch(err, codes.NotFound))
+	}
+	expectedStorageStates := map[string]api.Run_StorageState{
+		"run2": api.Run_STORAGESTATE_ARCHIVED,
+		"run3": api.Run_STORAGESTATE_ARCHIVED,
+		"run4": api.Run_STORAGESTATE_AVAILABLE,
+		"run5": api.Run_STORAGESTATE_AVAILABLE,
+		"run7": api.Run_STORAGESTATE_AVAILABLE,
+		"run8": api.Run_STORAGESTATE_AVAILABLE,
+	}
+	for runId, expectedStorageState := range expectedStorageStates {
+		run, err := manager.GetRun(runId)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedStorageState.String(), run.StorageState, runId)
+	}
+
+	// Runs archived by a pass are deleted by the next one once they are old enough.
+	report, err = manager.EnforceRunRetentionPolicies(context.Background(), policies, false)
+	assert.Nil(t, err)
+	assert.Equal(t, &RunRetentionReport{ArchivedRunIDs: []string{}, DeletedRunIDs: []string{"run3"}}, report)
+}
+
+func TestRunRetentionPolicies_PolicyFor(t *testing.T) {
+	namespacePolicy := RunRetentionPolicy{ArchiveAfterDays: 10}
+	experimentPolicy := RunRetentionPolicy{KeepLastRunsPerJob: 5}
+	policies := &RunRetentionPolicies{
+		Default:     RunRetentionPolicy{DeleteArchivedAfterDays: 90},
+		Namespaces:  map[string]RunRetentionPolicy{"ns1": namespacePolicy},
+		Experiments: map[string]RunRetentionPolicy{"exp1": experimentPolicy},
+	}
+	assert.Equal(t, experimentPolicy, policies.policyFor("ns1", "exp1"))
+	assert.Equal(t, experimentPolicy, policies.policyFor("ns2", "exp1"))
+	assert.Equal(t, namespacePolicy, policies.policyFor("ns1", "exp2"))
+	assert.Equal(t, policies.Default, policies.policyFor("ns2", "exp2"))
+	assert.True(t, policies.IsEnabled())
+	assert.False(t, (&RunRetentionPolicies{
+		Namespaces: map[string]RunRetentionPolicy{"ns1": {}},
+	}).IsEnabled())
+}
@@ -17,6 +17,7 @@ package server
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/golang/protobuf/ptypes/timestamp"
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
@@ -268,6 +269,56 @@ func ToApiTasks(tasks []*model.Task) []*api.Task {
 	}
 	return apiTasks
 }
+
+// ToApiNotificationSubscription converts a subscription, leaving out its secret.
+func ToApiNotificationSubscription(subscription *model.NotificationSubscription) *api.NotificationSubscription {
+	states := []string{}
+	if subscription.States != "" {
+		states = strings.Split(subscription.States, ",")
+	}
+	return &api.NotificationSubscription{
+		Id: subscription.UUID,
+		ResourceKey: &api.ResourceKey{
+			Type: toApiResourceType(subscription.ResourceType),
+			Id:   subscription.ResourceUUID,
+		},
+		States:    states,
+		Url:       subscription.URL,
+		CreatedAt: &timestamp.Timestamp{Seconds: subscription.CreatedAtInSec},
+	}
+}
+
+func ToApiNotificationSubscriptions(subscriptions []*model.NotificationSubscription) []*api.NotificationSubscription {
+	apiSubscriptions := make([]*api.NotificationSubscription, 0)
+	for _, subscription := range subscriptions {
+		apiSubscriptions = append(apiSubscriptions, ToApiNotificationSubscription(subscription))
+	}
+	return apiSubscriptions
+}
+
+func ToApiNotificationDelivery(delivery *model.NotificationDelivery) *api.NotificationDelivery {
+	return &api.NotificationDelivery{
+		Id:             delivery.UUID,
+		SubscriptionId: delivery.SubscriptionUUID,
+		RunId:          delivery.RunUUID,
+		State:          delivery.State,
+		Status:         api.NotificationDelivery_Status(api.NotificationDelivery_Status_value[delivery.Status]),
+		Attempts:       delivery.Attempts,
+		ResponseCode:   delivery.ResponseCode,
+		Error:          delivery.Error,
+		CreatedAt:      &timestamp.Timestamp{Seconds: delivery.CreatedAtInSec},
+		UpdatedAt:      &timestamp.Timestamp{Seconds: delivery.UpdatedAtInSec},
+	}
+}
+
+func ToApiNotificationDeliveries(deliveries []*model.NotificationDelivery) []*api.NotificationDelivery {
+	apiDeliveries := make([]*api.NotificationDelivery, 0)
+	for _, delivery := range deliveries {
+		apiDeliveries = append(apiDeliveries, ToApiNotificationDelivery(delivery))
+	}
+	return apiDeliveries
+}
+
 func ToApiJob(job *model.Job) *api.Job {
 	// v1 parameters
 	params, err := toApiParameters(job.Parameters)
@@ -286,17 +337,19 @@ func ToApiJob(job *model.Job) *api.Job {
 		}
 	}
 	return &api.Job{
-		Id:             job.UUID,
-		Name:           job.DisplayName,
-		ServiceAccount: job.ServiceAccount,
-		Description:    job.Description,
-		Enabled:        job.Enabled,
-		CreatedAt:      &timestamp.Timestamp{Seconds: job.CreatedAtInSec},
-		UpdatedAt:      &timestamp.Timestamp{Seconds: job.UpdatedAtInSec},
-		Status:         job.Conditions,
-		MaxConcurrency: job.MaxConcurrency,
-		NoCatchup:      job.NoCatchup,
-		Trigger:        toApiTrigger(job.Trigger),
+		Id:                      job.UUID,
+		Name:                    job.DisplayName,
+		ServiceAccount:          job.ServiceAccount,
+		Description:             job.Description,
+		Enabled:                 job.Enabled,
+		CreatedAt:               &timestamp.Timestamp{Seconds: job.CreatedAtInSec},
+		UpdatedAt:               &timestamp.Timestamp{Seconds: job.UpdatedAtInSec},
+		Status:                  job.Conditions,
+		MaxConcurrency:          job.MaxConcurrency,
+		NoCatchup:               job.NoCatchup,
+		Trigger:                 toApiTrigger(job.Trigger),
+		ConcurrencyPolicy:       job.ConcurrencyPolicy,
+		StartingDeadlineSeconds: job.StartingDeadlineSeconds,
 		PipelineSpec: &api.PipelineSpec{
 			PipelineId:       job.PipelineId,
 			PipelineName:     job.PipelineName,
@@ -381,6 +434,9 @@ func toApiTrigger(trigger model.Trigger) *api.Trigger {
 			cronSchedule.EndTime = &timestamp.Timestamp{
 				Seconds: *trigger.CronScheduleEndTimeInSec}
 		}
+		if trigger.CronScheduleTimeZone != nil {
+			cronSchedule.TimeZone = *trigger.CronScheduleTimeZone
+		}
 		return &api.Trigger{Trigger: &api.Trigger_CronSchedule{CronSchedule: &cronSchedule}}
 	}
 
@@ -397,5 +453,17 @@ func toApiTrigger(trigger model.Trigger) *api.Trigger {
 		}
 		return &api.Trigger{Trigger: &api.Trigger_PeriodicSchedule{PeriodicSchedule: &periodicSchedule}}
 	}
+
+	if trigger.RunCompletionUpstreamId != nil && *trigger.RunCompletionUpstreamId != "" {
+		var runCompletionTrigger api.RunCompletionTrigger
+		runCompletionTrigger.Upstream = &api.ResourceKey{Id: *trigger.RunCompletionUpstreamId}
+		if trigger.RunCompletionUpstreamType != nil {
+			runCompletionTrigger.Upstream.Type = toApiResourceType(model.ResourceType(*trigger.RunCompletionUpstreamType))
+		}
+		if trigger.RunCompletionStates != nil && *trigger.RunCompletionStates != "" {
+			runCompletionTrigger.States = strings.Split(*trigger.RunCompletionStates, ",")
+		}
+		return &api.Trigger{Trigger: &api.Trigger_RunCompletionTrigger{RunCompletionTrigger: &runCompletionTrigger}}
+	}
 	return &api.Trigger{}
 }
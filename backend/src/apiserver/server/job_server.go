@@ -16,13 +16,16 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	exec "github.com/kubeflow/pipelines/backend/src/common"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	scheduledworkflow "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -63,6 +66,21 @@ var (
 		Help: "The total number of EnableJob requests",
 	})
 
+	updateJobRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_server_update_requests",
+		Help: "The total number of UpdateJob requests",
+	})
+
+	triggerJobRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_server_trigger_requests",
+		Help: "The total number of TriggerJob requests",
+	})
+
+	backfillJobRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_server_backfill_requests",
+		Help: "The total number of BackfillJob requests",
+	})
+
 	// TODO(jingzhang36): error count and success count.
 
 	jobCount = promauto.NewGauge(prometheus.GaugeOpts{
@@ -251,20 +269,135 @@ func (s *JobServer) DeleteJob(ctx context.Context, request *api.DeleteJobRequest
 	return &empty.Empty{}, nil
 }
 
+func (s *JobServer) UpdateJob(ctx context.Context, request *api.UpdateJobRequest) (*api.Job, error) {
+	if s.options.CollectMetrics {
+		updateJobRequests.Inc()
+	}
+
+	err := s.validateUpdateJobRequest(request)
+	if err != nil {
+		return nil, util.Wrap(err, "Validate update job request failed.")
+	}
+
+	err = s.canAccessJob(ctx, request.Id, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbUpdate})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	job, err := s.resourceManager.UpdateJob(ctx, request.Id, request.Job)
+	if err != nil {
+		return nil, err
+	}
+	return ToApiJob(job), nil
+}
+
+func (s *JobServer) TriggerJob(ctx context.Context, request *api.TriggerJobRequest) (*empty.Empty, error) {
+	if s.options.CollectMetrics {
+		triggerJobRequests.Inc()
+	}
+
+	if request.Id == "" {
+		return nil, util.NewInvalidInputError("Job ID is empty.")
+	}
+	err := s.canAccessJob(ctx, request.Id, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbTrigger})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	err = s.resourceManager.TriggerJob(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *JobServer) BackfillJob(ctx context.Context, request *api.BackfillJobRequest) (*empty.Empty, error) {
+	if s.options.CollectMetrics {
+		backfillJobRequests.Inc()
+	}
+
+	err := s.validateBackfillJobRequest(request)
+	if err != nil {
+		return nil, util.Wrap(err, "Validate backfill job request failed.")
+	}
+	err = s.canAccessJob(ctx, request.Id, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbBackfill})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	err = s.resourceManager.BackfillJob(ctx, request.Id, request.StartTime.GetSeconds(), request.EndTime.GetSeconds())
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *JobServer) validateBackfillJobRequest(request *api.BackfillJobRequest) error {
+	if request.Id == "" {
+		return util.NewInvalidInputError("Job ID is empty.")
+	}
+	if request.StartTime == nil || request.EndTime == nil {
+		return util.NewInvalidInputError("Both the start time and the end time of the backfill must be specified.")
+	}
+	if err := request.StartTime.CheckValid(); err != nil {
+		return util.NewInvalidInputError("Invalid start time: %v", err)
+	}
+	if err := request.EndTime.CheckValid(); err != nil {
+		return util.NewInvalidInputError("Invalid end time: %v", err)
+	}
+	if request.StartTime.GetSeconds() > request.EndTime.GetSeconds() {
+		return util.NewInvalidInputError("The start time of the backfill must not be after its end time.")
+	}
+	return nil
+}
+
 func (s *JobServer) validateCreateJobRequest(request *api.CreateJobRequest) error {
-	job := request.Job
+	return s.validateJob(request.Job)
+}
+
+func (s *JobServer) validateUpdateJobRequest(request *api.UpdateJobRequest) error {
+	if request.Id == "" {
+		return util.NewInvalidInputError("Job ID is empty.")
+	}
+	if request.Job == nil {
+		return util.NewInvalidInputError("Job is empty.")
+	}
+	if request.Job.Id != "" && request.Job.Id != request.Id {
+		return util.NewInvalidInputError("The job ID %v doesn't match the job ID %v in the request path.", request.Job.Id, request.Id)
+	}
+	if upstream := request.Job.GetTrigger().GetRunCompletionTrigger().GetUpstream(); upstream != nil &&
+		upstream.Type == api.ResourceType_JOB && upstream.Id == request.Id {
+		return util.NewInvalidInputError("Job %v cannot be triggered by the completion of its own runs.", request.Id)
+	}
+	return s.validateJob(request.Job)
+}
 
+func (s *JobServer) validateJob(job *api.Job) error {
 	if err := ValidatePipelineSpecAndResourceReferences(s.resourceManager, job.PipelineSpec, job.ResourceReferences); err != nil {
 		return err
 	}
 	if job.MaxConcurrency > 10 || job.MaxConcurrency < 1 {
 		return util.NewInvalidInputError("The max concurrency of the job is out of range. Support 1-10. Received %v.", job.MaxConcurrency)
 	}
+	switch scheduledworkflow.ConcurrencyPolicy(job.ConcurrencyPolicy) {
+	case "", scheduledworkflow.AllowConcurrent, scheduledworkflow.ForbidConcurrent, scheduledworkflow.ReplaceConcurrent:
+	default:
+		return util.NewInvalidInputError("The concurrency policy of the job is not supported. Support Allow, Forbid or Replace. Received %v.", job.ConcurrencyPolicy)
+	}
+	if job.StartingDeadlineSeconds < 0 {
+		return util.NewInvalidInputError("The starting deadline of the job can't be negative. Received %v.", job.StartingDeadlineSeconds)
+	}
 	if job.Trigger != nil && job.Trigger.GetCronSchedule() != nil {
 		if _, err := cron.Parse(job.Trigger.GetCronSchedule().Cron); err != nil {
 			return util.NewInvalidInputError(
 				"Schedule cron is not a supported format(https://godoc.org/github.com/robfig/cron). Error: %v", err)
 		}
+		if timeZone := job.Trigger.GetCronSchedule().TimeZone; timeZone != "" {
+			if _, err := time.LoadLocation(timeZone); err != nil {
+				return util.NewInvalidInputError(
+					"Schedule time zone %q is not a valid IANA time zone. Error: %v", timeZone, err)
+			}
+		}
 	}
 	if job.Trigger != nil && job.Trigger.GetPeriodicSchedule() != nil {
 		periodicScheduleInterval := job.Trigger.GetPeriodicSchedule().IntervalSecond
@@ -273,6 +406,40 @@ func (s *JobServer) validateCreateJobRequest(request *api.CreateJobRequest) erro
 				"Found invalid period schedule interval %v. Set at interval to least 1 second.", periodicScheduleInterval)
 		}
 	}
+	if job.Trigger != nil && job.Trigger.GetRunCompletionTrigger() != nil {
+		return s.validateRunCompletionTrigger(job.Trigger.GetRunCompletionTrigger())
+	}
+	return nil
+}
+
+func (s *JobServer) validateRunCompletionTrigger(trigger *api.RunCompletionTrigger) error {
+	upstream := trigger.GetUpstream()
+	if upstream == nil || upstream.Id == "" {
+		return util.NewInvalidInputError("The upstream of the run completion trigger is missing.")
+	}
+	switch upstream.Type {
+	case api.ResourceType_JOB:
+		if _, err := s.resourceManager.GetJob(upstream.Id); err != nil {
+			return util.Wrapf(err, "Failed to get the upstream job %v", upstream.Id)
+		}
+	case api.ResourceType_PIPELINE_VERSION:
+		if _, err := s.resourceManager.GetPipelineVersion(upstream.Id); err != nil {
+			return util.Wrapf(err, "Failed to get the upstream pipeline version %v", upstream.Id)
+		}
+	default:
+		return util.NewInvalidInputError(
+			"Unsupported upstream type %v of the run completion trigger. Supported types are JOB and PIPELINE_VERSION.",
+			upstream.Type)
+	}
+	for _, state := range trigger.States {
+		switch exec.ExecutionPhase(state) {
+		case exec.ExecutionSucceeded, exec.ExecutionFailed, exec.ExecutionError:
+		default:
+			return util.NewInvalidInputError(
+				"Unsupported state %q of the run completion trigger. Supported states are %v, %v and %v.",
+				state, exec.ExecutionSucceeded, exec.ExecutionFailed, exec.ExecutionError)
+		}
+	}
 	return nil
 }
 
@@ -242,6 +242,118 @@ func TestValidateApiJob_InvalidCron(t *testing.T) {
 	assert.Contains(t, err.Error(), "Schedule cron is not a supported format")
 }
 
+func TestValidateApiJob_InvalidTimeZone(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	apiJob := &api.Job{
+		Name:           "job1",
+		Enabled:        true,
+		MaxConcurrency: 1,
+		Trigger: &api.Trigger{
+			Trigger: &api.Trigger_CronSchedule{CronSchedule: &api.CronSchedule{
+				StartTime: &timestamp.Timestamp{Seconds: 1},
+				Cron:      "1 * * * *",
+				TimeZone:  "Not/AZone",
+			}}},
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{Key: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID}, Relationship: api.Relationship_OWNER},
+		},
+	}
+	err := server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "is not a valid IANA time zone")
+}
+
+func TestValidateApiJob_RunCompletionTrigger(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+
+	tests := []struct {
+		name         string
+		trigger      *api.RunCompletionTrigger
+		errorCode    codes.Code
+		errorMessage string
+	}{
+		{
+			name:         "no upstream",
+			trigger:      &api.RunCompletionTrigger{},
+			errorCode:    codes.InvalidArgument,
+			errorMessage: "upstream of the run completion trigger is missing",
+		},
+		{
+			name: "unsupported upstream type",
+			trigger: &api.RunCompletionTrigger{
+				Upstream: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+			},
+			errorCode:    codes.InvalidArgument,
+			errorMessage: "Unsupported upstream type EXPERIMENT",
+		},
+		{
+			name: "upstream job not found",
+			trigger: &api.RunCompletionTrigger{
+				Upstream: &api.ResourceKey{Type: api.ResourceType_JOB, Id: "not-a-job"},
+			},
+			errorCode:    codes.NotFound,
+			errorMessage: "Failed to get the upstream job not-a-job",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			apiJob := &api.Job{
+				Name:           "job1",
+				Enabled:        true,
+				MaxConcurrency: 1,
+				Trigger:        &api.Trigger{Trigger: &api.Trigger_RunCompletionTrigger{RunCompletionTrigger: tc.trigger}},
+				PipelineSpec:   &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+				ResourceReferences: []*api.ResourceReference{
+					{Key: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID}, Relationship: api.Relationship_OWNER},
+				},
+			}
+			err := server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+			assert.NotNil(t, err)
+			assert.Equal(t, tc.errorCode, err.(*util.UserError).ExternalStatusCode())
+			assert.Contains(t, err.Error(), tc.errorMessage)
+		})
+	}
+}
+
+func TestValidateApiJob_RunCompletionTriggerUnsupportedState(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	upstream, err := server.CreateJob(nil, &api.CreateJobRequest{Job: commonApiJob})
+	assert.Nil(t, err)
+
+	apiJob := &api.Job{
+		Name:           "job2",
+		Enabled:        true,
+		MaxConcurrency: 1,
+		Trigger: &api.Trigger{Trigger: &api.Trigger_RunCompletionTrigger{RunCompletionTrigger: &api.RunCompletionTrigger{
+			Upstream: &api.ResourceKey{Type: api.ResourceType_JOB, Id: upstream.Id},
+			States:   []string{"Succeeded", "Running"},
+		}}},
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{Key: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID}, Relationship: api.Relationship_OWNER},
+		},
+	}
+	err = server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), `Unsupported state "Running"`)
+
+	// The job can't be triggered by its own runs.
+	apiJob.Trigger.GetRunCompletionTrigger().States = nil
+	_, err = server.UpdateJob(nil, &api.UpdateJobRequest{Id: upstream.Id, Job: apiJob})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "cannot be triggered by the completion of its own runs")
+}
+
 func TestValidateApiJob_MaxConcurrencyOutOfRange(t *testing.T) {
 	clients, manager, experiment := initWithExperiment(t)
 	defer clients.Close()
@@ -720,6 +832,243 @@ func TestDisableJob_Multiuser(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestUpdateJob(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	job, err := server.CreateJob(nil, &api.CreateJobRequest{Job: commonApiJob})
+	assert.Nil(t, err)
+
+	apiJob := &api.Job{
+		Name:           "job1",
+		Description:    "updated",
+		Enabled:        true,
+		MaxConcurrency: 2,
+		Trigger: &api.Trigger{
+			Trigger: &api.Trigger_PeriodicSchedule{PeriodicSchedule: &api.PeriodicSchedule{
+				IntervalSecond: 60,
+			}}},
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "param1", Value: "mars"}},
+		},
+	}
+	updatedJob, err := server.UpdateJob(nil, &api.UpdateJobRequest{Id: job.Id, Job: apiJob})
+	assert.Nil(t, err)
+
+	expectedJob := &api.Job{
+		Id:             job.Id,
+		Name:           "job1",
+		Description:    "updated",
+		ServiceAccount: "pipeline-runner",
+		Enabled:        true,
+		MaxConcurrency: 2,
+		Trigger: &api.Trigger{
+			Trigger: &api.Trigger_PeriodicSchedule{PeriodicSchedule: &api.PeriodicSchedule{
+				IntervalSecond: 60,
+			}}},
+		CreatedAt: job.CreatedAt,
+		UpdatedAt: &timestamp.Timestamp{Seconds: 3},
+		Status:    "NO_STATUS",
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "param1", Value: "mars"}},
+		},
+		ResourceReferences: job.ResourceReferences,
+	}
+	assert.Equal(t, expectedJob, updatedJob)
+}
+
+func TestUpdateJob_InvalidRequest(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	job, err := server.CreateJob(nil, &api.CreateJobRequest{Job: commonApiJob})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name         string
+		request      *api.UpdateJobRequest
+		errorMessage string
+	}{
+		{
+			name:         "no id",
+			request:      &api.UpdateJobRequest{Job: commonApiJob},
+			errorMessage: "Job ID is empty",
+		},
+		{
+			name:         "no job",
+			request:      &api.UpdateJobRequest{Id: job.Id},
+			errorMessage: "Job is empty",
+		},
+		{
+			name:         "mismatched id",
+			request:      &api.UpdateJobRequest{Id: job.Id, Job: &api.Job{Id: "another-job"}},
+			errorMessage: "doesn't match",
+		},
+		{
+			name: "invalid max concurrency",
+			request: &api.UpdateJobRequest{Id: job.Id, Job: &api.Job{
+				Name:           "job1",
+				MaxConcurrency: 0,
+				PipelineSpec:   &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+			}},
+			errorMessage: "max concurrency of the job is out of range",
+		},
+		{
+			name: "invalid concurrency policy",
+			request: &api.UpdateJobRequest{Id: job.Id, Job: &api.Job{
+				Name:              "job1",
+				MaxConcurrency:    1,
+				ConcurrencyPolicy: "Queue",
+				PipelineSpec:      &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+			}},
+			errorMessage: "concurrency policy of the job is not supported",
+		},
+		{
+			name: "negative starting deadline",
+			request: &api.UpdateJobRequest{Id: job.Id, Job: &api.Job{
+				Name:                    "job1",
+				MaxConcurrency:          1,
+				StartingDeadlineSeconds: -1,
+				PipelineSpec:            &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+			}},
+			errorMessage: "starting deadline of the job can't be negative",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := server.UpdateJob(nil, tc.request)
+			assert.NotNil(t, err)
+			assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+			assert.Contains(t, err.Error(), tc.errorMessage)
+		})
+	}
+}
+
+func TestUpdateJob_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+
+	userIdentity := "user@google.com"
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + userIdentity})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	job, err := server.CreateJob(ctx, &api.CreateJobRequest{Job: commonApiJob})
+	assert.Nil(t, err)
+
+	clients.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	manager = resource.NewResourceManager(clients)
+	server = NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+
+	_, err = server.UpdateJob(ctx, &api.UpdateJobRequest{Id: job.Id, Job: &api.Job{
+		Name:           "job1",
+		MaxConcurrency: 1,
+		PipelineSpec:   &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+	}})
+	assert.NotNil(t, err)
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: "ns1",
+		Verb:      common.RbacResourceVerbUpdate,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeJobs,
+		Name:      commonApiJob.Name,
+	}
+	assert.EqualError(
+		t,
+		err,
+		wrapFailedAuthzRequestError(wrapFailedAuthzApiResourcesError(getPermissionDeniedError(userIdentity, resourceAttributes))).Error(),
+	)
+}
+
+func TestTriggerJob(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	job, err := server.CreateJob(nil, &api.CreateJobRequest{Job: commonApiJob})
+	assert.Nil(t, err)
+
+	_, err = server.TriggerJob(nil, &api.TriggerJobRequest{Id: job.Id})
+	assert.Nil(t, err)
+
+	_, err = server.TriggerJob(nil, &api.TriggerJobRequest{})
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestBackfillJob(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	job, err := server.CreateJob(nil, &api.CreateJobRequest{Job: commonApiJob})
+	assert.Nil(t, err)
+
+	_, err = server.BackfillJob(nil, &api.BackfillJobRequest{
+		Id:        job.Id,
+		StartTime: &timestamp.Timestamp{Seconds: 0},
+		EndTime:   &timestamp.Timestamp{Seconds: 1},
+	})
+	assert.Nil(t, err)
+}
+
+func TestBackfillJob_InvalidRequest(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	job, err := server.CreateJob(nil, &api.CreateJobRequest{Job: commonApiJob})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name         string
+		request      *api.BackfillJobRequest
+		errorMessage string
+	}{
+		{
+			name: "no id",
+			request: &api.BackfillJobRequest{
+				StartTime: &timestamp.Timestamp{Seconds: 0},
+				EndTime:   &timestamp.Timestamp{Seconds: 1},
+			},
+			errorMessage: "Job ID is empty",
+		},
+		{
+			name:         "no end time",
+			request:      &api.BackfillJobRequest{Id: job.Id, StartTime: &timestamp.Timestamp{Seconds: 0}},
+			errorMessage: "must be specified",
+		},
+		{
+			name: "start after end",
+			request: &api.BackfillJobRequest{
+				Id:        job.Id,
+				StartTime: &timestamp.Timestamp{Seconds: 2},
+				EndTime:   &timestamp.Timestamp{Seconds: 1},
+			},
+			errorMessage: "must not be after its end time",
+		},
+		{
+			name: "end in the future",
+			request: &api.BackfillJobRequest{
+				Id:        job.Id,
+				StartTime: &timestamp.Timestamp{Seconds: 0},
+				EndTime:   &timestamp.Timestamp{Seconds: 1 << 32},
+			},
+			errorMessage: "in the future",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := server.BackfillJob(nil, tc.request)
+			assert.NotNil(t, err)
+			assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+			assert.Contains(t, err.Error(), tc.errorMessage)
+		})
+	}
+}
+
 func TestListJobs_Unauthenticated(t *testing.T) {
 	viper.Set(common.MultiUserMode, "true")
 	defer viper.Set(common.MultiUserMode, "false")
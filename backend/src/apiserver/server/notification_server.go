@@ -0,0 +1,256 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+const maxNotificationDeliveriesPageSize = 200
+
+type NotificationServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+func (s *NotificationServer) CreateNotificationSubscription(ctx context.Context,
+	request *api.CreateNotificationSubscriptionRequest) (*api.NotificationSubscription, error) {
+	subscription, err := validateCreateNotificationSubscriptionRequest(request)
+	if err != nil {
+		return nil, util.Wrap(err, "Validate create subscription request failed.")
+	}
+
+	err = s.canAccessNotificationSubscription(ctx, subscription.ResourceType, subscription.ResourceUUID,
+		common.RbacResourceVerbCreate)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	newSubscription, err := s.resourceManager.CreateNotificationSubscription(subscription)
+	if err != nil {
+		return nil, util.Wrap(err, "Create subscription failed.")
+	}
+	return ToApiNotificationSubscription(newSubscription), nil
+}
+
+func (s *NotificationServer) GetNotificationSubscription(ctx context.Context,
+	request *api.GetNotificationSubscriptionRequest) (*api.NotificationSubscription, error) {
+	subscription, err := s.getAuthorizedSubscription(ctx, request.GetId(), common.RbacResourceVerbGet)
+	if err != nil {
+		return nil, util.Wrap(err, "Get subscription failed.")
+	}
+	return ToApiNotificationSubscription(subscription), nil
+}
+
+func (s *NotificationServer) ListNotificationSubscriptions(ctx context.Context,
+	request *api.ListNotificationSubscriptionsRequest) (*api.ListNotificationSubscriptionsResponse, error) {
+	resourceType, err := validateNotificationResourceKey(request.GetResourceReferenceKey())
+	if err != nil {
+		return nil, util.Wrap(err, "Validating filter failed.")
+	}
+	resourceId := request.GetResourceReferenceKey().GetId()
+
+	err = s.canAccessNotificationSubscription(ctx, resourceType, resourceId, common.RbacResourceVerbList)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	subscriptions, err := s.resourceManager.ListNotificationSubscriptions(resourceType, resourceId)
+	if err != nil {
+		return nil, util.Wrap(err, "List subscriptions failed.")
+	}
+	return &api.ListNotificationSubscriptionsResponse{
+		Subscriptions: ToApiNotificationSubscriptions(subscriptions),
+	}, nil
+}
+
+func (s *NotificationServer) DeleteNotificationSubscription(ctx context.Context,
+	request *api.DeleteNotificationSubscriptionRequest) (*empty.Empty, error) {
+	_, err := s.getAuthorizedSubscription(ctx, request.GetId(), common.RbacResourceVerbDelete)
+	if err != nil {
+		return nil, util.Wrap(err, "Delete subscription failed.")
+	}
+
+	err = s.resourceManager.DeleteNotificationSubscription(request.GetId())
+	if err != nil {
+		return nil, util.Wrap(err, "Delete subscription failed.")
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *NotificationServer) ListNotificationDeliveries(ctx context.Context,
+	request *api.ListNotificationDeliveriesRequest) (*api.ListNotificationDeliveriesResponse, error) {
+	pageSize := int(request.GetPageSize())
+	if pageSize < 0 {
+		return nil, util.NewInvalidInputError("The page size should be greater than 0. Got %v", pageSize)
+	}
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxNotificationDeliveriesPageSize {
+		pageSize = maxNotificationDeliveriesPageSize
+	}
+
+	_, err := s.getAuthorizedSubscription(ctx, request.GetSubscriptionId(), common.RbacResourceVerbGet)
+	if err != nil {
+		return nil, util.Wrap(err, "List deliveries failed.")
+	}
+
+	deliveries, err := s.resourceManager.ListNotificationDeliveries(request.GetSubscriptionId(), pageSize)
+	if err != nil {
+		return nil, util.Wrap(err, "List deliveries failed.")
+	}
+	return &api.ListNotificationDeliveriesResponse{Deliveries: ToApiNotificationDeliveries(deliveries)}, nil
+}
+
+// getAuthorizedSubscription gets the subscription if the verb is authorized on
+// the subscriptions to its resource.
+func (s *NotificationServer) getAuthorizedSubscription(ctx context.Context, subscriptionId string,
+	verb string) (*model.NotificationSubscription, error) {
+	if subscriptionId == "" {
+		return nil, util.NewInvalidInputError("Subscription ID is empty. Please specify a valid ID")
+	}
+	subscription, err := s.resourceManager.GetNotificationSubscription(subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	err = s.canAccessNotificationSubscription(ctx, subscription.ResourceType, subscription.ResourceUUID, verb)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	return subscription, nil
+}
+
+// canAccessNotificationSubscription authorizes the verb on the subscriptions in
+// the namespace of the subscribed resource.
+func (s *NotificationServer) canAccessNotificationSubscription(ctx context.Context, resourceType model.ResourceType,
+	resourceId string, verb string) error {
+	if !common.IsMultiUserMode() {
+		// Skip authorization if not multi-user mode.
+		return nil
+	}
+
+	var namespace string
+	var err error
+	switch resourceType {
+	case common.Experiment:
+		namespace, err = s.resourceManager.GetNamespaceFromExperimentID(resourceId)
+	case common.Job:
+		namespace, err = s.resourceManager.GetNamespaceFromJobID(resourceId)
+	default:
+		namespace = resourceId
+	}
+	if err != nil {
+		return util.Wrap(err, "Failed to authorize with the subscribed resource.")
+	}
+	if namespace == "" {
+		return util.NewInvalidInputError("The subscribed resource doesn't have a valid namespace.")
+	}
+
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      verb,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeNotificationSubscriptions,
+	}
+	err = isAuthorized(s.resourceManager, ctx, resourceAttributes)
+	if err != nil {
+		return util.Wrap(err, "Failed to authorize with API resource references")
+	}
+	return nil
+}
+
+// validateNotificationResourceKey returns the type of the resource whose runs
+// are subscribed to, which is an experiment, a job or a namespace.
+func validateNotificationResourceKey(key *api.ResourceKey) (model.ResourceType, error) {
+	if key == nil || key.GetId() == "" {
+		return "", util.NewInvalidInputError("Invalid subscription: must specify an experiment, a job or a namespace")
+	}
+	switch key.GetType() {
+	case api.ResourceType_EXPERIMENT:
+		return common.Experiment, nil
+	case api.ResourceType_JOB:
+		return common.Job, nil
+	case api.ResourceType_NAMESPACE:
+		return common.Namespace, nil
+	default:
+		return "", util.NewInvalidInputError(
+			"Invalid subscription: the subscribed resource must be an experiment, a job or a namespace. Got %v", key.GetType())
+	}
+}
+
+func validateCreateNotificationSubscriptionRequest(request *api.CreateNotificationSubscriptionRequest) (*model.NotificationSubscription, error) {
+	subscription := request.GetSubscription()
+	if subscription == nil {
+		return nil, util.NewInvalidInputError("Subscription is empty. Please specify a valid subscription")
+	}
+	if subscription.GetId() != "" {
+		return nil, util.NewInvalidInputError("Invalid subscription: Id should not be set")
+	}
+	resourceType, err := validateNotificationResourceKey(subscription.GetResourceKey())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(subscription.GetStates()) == 0 {
+		return nil, util.NewInvalidInputError("Invalid subscription: must specify the run states to be notified of")
+	}
+	for _, state := range subscription.GetStates() {
+		if !isNotificationRunState(state) {
+			return nil, util.NewInvalidInputError("Invalid subscription: unsupported run state %q. Supported states are %v",
+				state, strings.Join(resource.NotificationRunStates, ", "))
+		}
+	}
+
+	webhookURL, err := url.Parse(subscription.GetUrl())
+	if err != nil || (webhookURL.Scheme != "http" && webhookURL.Scheme != "https") || webhookURL.Hostname() == "" {
+		return nil, util.NewInvalidInputError("Invalid subscription: the URL must be an HTTP or HTTPS URL. Got %q",
+			subscription.GetUrl())
+	}
+	if webhookURL.User != nil {
+		return nil, util.NewInvalidInputError("Invalid subscription: the URL must not contain credentials")
+	}
+
+	return &model.NotificationSubscription{
+		ResourceType: resourceType,
+		ResourceUUID: subscription.GetResourceKey().GetId(),
+		States:       strings.Join(subscription.GetStates(), ","),
+		URL:          subscription.GetUrl(),
+		Secret:       subscription.GetSecret(),
+	}, nil
+}
+
+func isNotificationRunState(state string) bool {
+	for _, s := range resource.NotificationRunStates {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+func NewNotificationServer(resourceManager *resource.ResourceManager) *NotificationServer {
+	return &NotificationServer{resourceManager: resourceManager}
+}
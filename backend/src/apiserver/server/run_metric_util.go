@@ -84,3 +84,34 @@ func NewReportRunMetricResult(
 	}
 	return result
 }
+
+// NewBatchRunResult turns error into a BatchRunResult.
+func NewBatchRunResult(runId string, err error) *api.BatchRunsResponse_BatchRunResult {
+	result := &api.BatchRunsResponse_BatchRunResult{
+		RunId: runId,
+	}
+	if err == nil {
+		result.Status = api.BatchRunsResponse_BatchRunResult_OK
+		return result
+	}
+	userError, ok := err.(*util.UserError)
+	if !ok {
+		result.Status = api.BatchRunsResponse_BatchRunResult_INTERNAL_ERROR
+		return result
+	}
+	switch userError.ExternalStatusCode() {
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		result.Status = api.BatchRunsResponse_BatchRunResult_INVALID_ARGUMENT
+	case codes.NotFound:
+		result.Status = api.BatchRunsResponse_BatchRunResult_NOT_FOUND
+	case codes.PermissionDenied, codes.Unauthenticated:
+		result.Status = api.BatchRunsResponse_BatchRunResult_PERMISSION_DENIED
+	default:
+		result.Status = api.BatchRunsResponse_BatchRunResult_INTERNAL_ERROR
+	}
+	result.Message = userError.ExternalMessage()
+	if result.Status == api.BatchRunsResponse_BatchRunResult_INTERNAL_ERROR {
+		glog.Errorf("Internal error '%v' when operating on run '%s'", err, runId)
+	}
+	return result
+}
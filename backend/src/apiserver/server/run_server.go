@@ -17,9 +17,11 @@ package server
 import (
 	"context"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/empty"
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
@@ -82,6 +84,36 @@ var (
 		Help: "The total number of RetryRun requests",
 	})
 
+	batchArchiveRunsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_batch_archive_requests",
+		Help: "The total number of BatchArchiveRuns requests",
+	})
+
+	batchUnarchiveRunsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_batch_unarchive_requests",
+		Help: "The total number of BatchUnarchiveRuns requests",
+	})
+
+	batchDeleteRunsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_batch_delete_requests",
+		Help: "The total number of BatchDeleteRuns requests",
+	})
+
+	batchTerminateRunsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_batch_terminate_requests",
+		Help: "The total number of BatchTerminateRuns requests",
+	})
+
+	watchRunRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_watch_requests",
+		Help: "The total number of WatchRun requests",
+	})
+
+	watchRunsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_watch_list_requests",
+		Help: "The total number of WatchRuns requests",
+	})
+
 	// TODO(jingzhang36): error count and success count.
 
 	runCount = promauto.NewGauge(prometheus.GaugeOpts{
@@ -175,45 +207,9 @@ func (s *RunServer) ListRuns(ctx context.Context, request *api.ListRunsRequest)
 		return nil, util.Wrap(err, "Validating filter failed.")
 	}
 
-	if common.IsMultiUserMode() {
-		refKey := filterContext.ReferenceKey
-		if refKey == nil {
-			return nil, util.NewInvalidInputError("ListRuns must filter by resource reference in multi-user mode.")
-		}
-		if refKey.Type == common.Namespace {
-			namespace := refKey.ID
-			if len(namespace) == 0 {
-				return nil, util.NewInvalidInputError("Invalid resource references for ListRuns. Namespace is empty.")
-			}
-			resourceAttributes := &authorizationv1.ResourceAttributes{
-				Namespace: namespace,
-				Verb:      common.RbacResourceVerbList,
-			}
-			err = s.canAccessRun(ctx, "", resourceAttributes)
-			if err != nil {
-				return nil, util.Wrap(err, "Failed to authorize with namespace resource reference.")
-			}
-		} else if refKey.Type == common.Experiment || refKey.Type == "ExperimentUUID" {
-			// "ExperimentUUID" was introduced for perf optimization. We accept both refKey.Type for backward-compatible reason.
-			experimentID := refKey.ID
-			if len(experimentID) == 0 {
-				return nil, util.NewInvalidInputError("Invalid resource references for run. Experiment ID is empty.")
-			}
-			namespace, err := s.resourceManager.GetNamespaceFromExperimentID(experimentID)
-			if err != nil {
-				return nil, util.Wrap(err, "Run's experiment has no namespace.")
-			}
-			resourceAttributes := &authorizationv1.ResourceAttributes{
-				Namespace: namespace,
-				Verb:      common.RbacResourceVerbList,
-			}
-			err = s.canAccessRun(ctx, "", resourceAttributes)
-			if err != nil {
-				return nil, util.Wrap(err, "Failed to authorize with namespace in experiment resource reference.")
-			}
-		} else {
-			return nil, util.NewInvalidInputError("Invalid resource references for ListRuns. Got %+v", request.ResourceReferenceKey)
-		}
+	err = s.canListRuns(ctx, filterContext.ReferenceKey)
+	if err != nil {
+		return nil, err
 	}
 
 	runs, total_size, nextPageToken, err := s.resourceManager.ListRuns(filterContext, opts)
@@ -366,6 +362,322 @@ func (s *RunServer) RetryRun(ctx context.Context, request *api.RetryRunRequest)
 
 }
 
+func (s *RunServer) BatchArchiveRuns(ctx context.Context, request *api.BatchRunsRequest) (*api.BatchRunsResponse, error) {
+	if s.options.CollectMetrics {
+		batchArchiveRunsRequests.Inc()
+	}
+
+	return s.batchRuns(ctx, request, common.RbacResourceVerbArchive, func(runId string) error {
+		return s.resourceManager.ArchiveRun(runId)
+	})
+}
+
+func (s *RunServer) BatchUnarchiveRuns(ctx context.Context, request *api.BatchRunsRequest) (*api.BatchRunsResponse, error) {
+	if s.options.CollectMetrics {
+		batchUnarchiveRunsRequests.Inc()
+	}
+
+	return s.batchRuns(ctx, request, common.RbacResourceVerbUnarchive, func(runId string) error {
+		return s.resourceManager.UnarchiveRun(runId)
+	})
+}
+
+func (s *RunServer) BatchDeleteRuns(ctx context.Context, request *api.BatchRunsRequest) (*api.BatchRunsResponse, error) {
+	if s.options.CollectMetrics {
+		batchDeleteRunsRequests.Inc()
+	}
+
+	return s.batchRuns(ctx, request, common.RbacResourceVerbDelete, func(runId string) error {
+		err := s.resourceManager.DeleteRun(ctx, runId)
+		if err == nil && s.options.CollectMetrics {
+			runCount.Dec()
+		}
+		return err
+	})
+}
+
+func (s *RunServer) BatchTerminateRuns(ctx context.Context, request *api.BatchRunsRequest) (*api.BatchRunsResponse, error) {
+	if s.options.CollectMetrics {
+		batchTerminateRunsRequests.Inc()
+	}
+
+	return s.batchRuns(ctx, request, common.RbacResourceVerbTerminate, func(runId string) error {
+		return s.resourceManager.TerminateRun(ctx, runId)
+	})
+}
+
+// batchRuns authorizes and applies the operation to each run selected by the
+// request, a chunk of runs at a time. Runs selected by filter are listed one
+// chunk after another, so a large batch is never loaded at once.
+func (s *RunServer) batchRuns(ctx context.Context, request *api.BatchRunsRequest, verb string, operate func(runId string) error) (*api.BatchRunsResponse, error) {
+	response := &api.BatchRunsResponse{
+		Results: []*api.BatchRunsResponse_BatchRunResult{},
+	}
+	processChunk := func(runIds []string) {
+		for _, runId := range runIds {
+			// Makes sure run exists, as archiving an unknown run is a no-op.
+			_, err := s.resourceManager.GetRun(runId)
+			if err == nil {
+				err = s.canAccessRun(ctx, runId, &authorizationv1.ResourceAttributes{Verb: verb})
+				if err != nil {
+					err = util.Wrap(err, "Failed to authorize the request")
+				}
+			}
+			if err == nil {
+				err = operate(runId)
+			}
+			response.Results = append(response.Results, NewBatchRunResult(runId, err))
+		}
+	}
+	chunkSize := common.GetBatchRunsChunkSize()
+	if chunkSize <= 0 {
+		chunkSize = common.DefaultBatchRunsChunkSize
+	}
+
+	if runIds := request.GetRunIds(); len(runIds) > 0 {
+		if request.GetFilter() != nil || request.GetResourceReferenceKey() != nil {
+			return nil, util.NewInvalidInputError("Runs must be selected either by run IDs or by filter, not both.")
+		}
+		for start := 0; start < len(runIds); start += chunkSize {
+			end := start + chunkSize
+			if end > len(runIds) {
+				end = len(runIds)
+			}
+			processChunk(runIds[start:end])
+		}
+		return response, nil
+	}
+
+	// An empty filter would select every run under the resource reference, so
+	// it is rejected rather than applying the operation to all of them.
+	filter := request.GetFilter()
+	if request.GetResourceReferenceKey() == nil || filter == nil || (len(filter.GetPredicates()) == 0 && len(filter.GetGroups()) == 0) {
+		return nil, util.NewInvalidInputError("Runs must be selected either by run IDs or by a non-empty filter within a resource reference.")
+	}
+	filterContext, err := ValidateFilter(request.GetResourceReferenceKey())
+	if err != nil {
+		return nil, util.Wrap(err, "Validating filter failed.")
+	}
+	err = s.canListRuns(ctx, filterContext.ReferenceKey)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := list.NewOptions(&model.Run{}, chunkSize, "", filter)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create list options")
+	}
+	for {
+		runs, _, nextPageToken, err := s.resourceManager.ListRuns(filterContext, opts)
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to list runs.")
+		}
+		runIds := make([]string, 0, len(runs))
+		for _, run := range runs {
+			runIds = append(runIds, run.UUID)
+		}
+		processChunk(runIds)
+		if nextPageToken == "" {
+			return response, nil
+		}
+		// The page token holds the sort key of the last listed run, so
+		// the runs modified above don't shift the next chunk.
+		opts, err = list.NewOptionsFromToken(nextPageToken, chunkSize)
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to create list options")
+		}
+	}
+}
+
+func (s *RunServer) WatchRun(request *api.WatchRunRequest, stream api.RunService_WatchRunServer) error {
+	if s.options.CollectMetrics {
+		watchRunRequests.Inc()
+	}
+	return s.watchRun(stream.Context(), request, stream.Send)
+}
+
+func (s *RunServer) WatchRuns(request *api.WatchRunsRequest, stream api.RunService_WatchRunsServer) error {
+	if s.options.CollectMetrics {
+		watchRunsRequests.Inc()
+	}
+	return s.watchRuns(stream.Context(), request, stream.Send)
+}
+
+// watchRun sends the run in its current state, and then every time its
+// condition or metrics change until the context is done.
+func (s *RunServer) watchRun(ctx context.Context, request *api.WatchRunRequest, send func(*api.RunDetail) error) error {
+	runId := request.GetRunId()
+	if runId == "" {
+		return util.NewInvalidInputError("Run ID is empty. Please specify a valid ID")
+	}
+	err := s.canAccessRun(ctx, runId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbGet})
+	if err != nil {
+		return util.Wrap(err, "Failed to authorize the request")
+	}
+
+	// The watch starts before the run is read, so that no update is missed.
+	updates, stop := s.resourceManager.WatchRunUpdates()
+	defer stop()
+	sendRun := func() error {
+		run, err := s.resourceManager.GetRun(runId)
+		if err != nil {
+			return util.Wrap(err, "Failed to get the watched run")
+		}
+		return send(ToApiRunDetail(run))
+	}
+	if err := sendRun(); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case update, ok := <-updates:
+			if !ok {
+				return errRunWatchFellBehind()
+			}
+			if update.RunUUID != runId {
+				continue
+			}
+			if err := sendRun(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// watchRuns sends the runs matching the request every time their condition or
+// metrics change, until the context is done.
+func (s *RunServer) watchRuns(ctx context.Context, request *api.WatchRunsRequest, send func(*api.Run) error) error {
+	filter, err := parseAPIFilter(request.GetFilter())
+	if err != nil {
+		return util.Wrap(err, "Failed to create list options")
+	}
+	if filter == nil {
+		filter = &api.Filter{}
+	}
+	// Validate the filter before the runs are watched. The list options
+	// rewrite the keys of the filter they're created with, so they're created
+	// with copies.
+	if _, err := list.NewOptions(&model.Run{}, 1, "", proto.Clone(filter).(*api.Filter)); err != nil {
+		return util.Wrap(err, "Failed to create list options")
+	}
+	filterContext, err := ValidateFilter(request.GetResourceReferenceKey())
+	if err != nil {
+		return util.Wrap(err, "Validating filter failed.")
+	}
+	err = s.canListRuns(ctx, filterContext.ReferenceKey)
+	if err != nil {
+		return err
+	}
+
+	updates, stop := s.resourceManager.WatchRunUpdates()
+	defer stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case update, ok := <-updates:
+			if !ok {
+				return errRunWatchFellBehind()
+			}
+			if !isRunUpdateInFilterContext(update, filterContext) {
+				continue
+			}
+			// The updated run is sent if it is listed with the filter of the watch.
+			filter := proto.Clone(filter).(*api.Filter)
+			runFilter := &api.Filter{
+				Predicates: append([]*api.Predicate{{
+					Key:   "id",
+					Op:    api.Predicate_EQUALS,
+					Value: &api.Predicate_StringValue{StringValue: update.RunUUID},
+				}}, filter.Predicates...),
+				Groups: filter.Groups,
+			}
+			opts, err := list.NewOptions(&model.Run{}, 1, "", runFilter)
+			if err != nil {
+				return util.Wrap(err, "Failed to create list options")
+			}
+			runs, _, _, err := s.resourceManager.ListRuns(filterContext, opts)
+			if err != nil {
+				return util.Wrap(err, "Failed to list the watched runs.")
+			}
+			for _, run := range runs {
+				if err := send(toApiRun(run)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// isRunUpdateInFilterContext returns whether the updated run may be in the
+// namespace or the experiment that the runs are watched in, so that the updates
+// of the other runs are skipped without reading them.
+func isRunUpdateInFilterContext(update model.RunUpdate, filterContext *common.FilterContext) bool {
+	refKey := filterContext.ReferenceKey
+	switch {
+	case refKey == nil:
+		return true
+	case refKey.Type == common.Namespace:
+		return update.Namespace == refKey.ID
+	case refKey.Type == common.Experiment:
+		return update.ExperimentUUID == refKey.ID
+	default:
+		return true
+	}
+}
+
+func errRunWatchFellBehind() error {
+	return util.NewUnavailableError(errors.New("run watch fell behind"),
+		"The watch fell behind the run updates. Please watch the runs again.")
+}
+
+// canListRuns authorizes listing the runs under the resource reference.
+func (s *RunServer) canListRuns(ctx context.Context, refKey *common.ReferenceKey) error {
+	if !common.IsMultiUserMode() {
+		// Skip authz if not multi-user mode.
+		return nil
+	}
+	if refKey == nil {
+		return util.NewInvalidInputError("ListRuns must filter by resource reference in multi-user mode.")
+	}
+	if refKey.Type == common.Namespace {
+		namespace := refKey.ID
+		if len(namespace) == 0 {
+			return util.NewInvalidInputError("Invalid resource references for ListRuns. Namespace is empty.")
+		}
+		resourceAttributes := &authorizationv1.ResourceAttributes{
+			Namespace: namespace,
+			Verb:      common.RbacResourceVerbList,
+		}
+		err := s.canAccessRun(ctx, "", resourceAttributes)
+		if err != nil {
+			return util.Wrap(err, "Failed to authorize with namespace resource reference.")
+		}
+	} else if refKey.Type == common.Experiment || refKey.Type == "ExperimentUUID" {
+		// "ExperimentUUID" was introduced for perf optimization. We accept both refKey.Type for backward-compatible reason.
+		experimentID := refKey.ID
+		if len(experimentID) == 0 {
+			return util.NewInvalidInputError("Invalid resource references for run. Experiment ID is empty.")
+		}
+		namespace, err := s.resourceManager.GetNamespaceFromExperimentID(experimentID)
+		if err != nil {
+			return util.Wrap(err, "Run's experiment has no namespace.")
+		}
+		resourceAttributes := &authorizationv1.ResourceAttributes{
+			Namespace: namespace,
+			Verb:      common.RbacResourceVerbList,
+		}
+		err = s.canAccessRun(ctx, "", resourceAttributes)
+		if err != nil {
+			return util.Wrap(err, "Failed to authorize with namespace in experiment resource reference.")
+		}
+	} else {
+		return util.NewInvalidInputError("Invalid resource references for ListRuns. Got %+v", refKey)
+	}
+	return nil
+}
+
 func (s *RunServer) canAccessRun(ctx context.Context, runId string, resourceAttributes *authorizationv1.ResourceAttributes) error {
 	if common.IsMultiUserMode() == false {
 		// Skip authz if not multi-user mode.
@@ -1081,3 +1081,177 @@ func TestReadArtifacts_Resource_NotFound(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.True(t, util.IsUserErrorCodeMatch(err, codes.NotFound))
 }
+
+func initWithThreeRuns(t *testing.T) (*resource.FakeClientManager, *resource.ResourceManager, []string) {
+	clientManager, _, exp := initWithExperiment(t)
+	runIds := []string{
+		"123e4567-e89b-12d3-a456-426655441001",
+		"123e4567-e89b-12d3-a456-426655441002",
+		"123e4567-e89b-12d3-a456-426655441003",
+	}
+	for i, name := range []string{"run1", "run2", "keep"} {
+		runId := runIds[i]
+		clientManager.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(runId, nil))
+		manager := resource.NewResourceManager(clientManager)
+		_, err := manager.CreateRun(context.Background(), &api.Run{
+			Name: name,
+			PipelineSpec: &api.PipelineSpec{
+				WorkflowManifest: testWorkflow.ToStringForStore(),
+			},
+			ResourceReferences: []*api.ResourceReference{
+				{
+					Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+					Relationship: api.Relationship_OWNER,
+				},
+			},
+		})
+		assert.Nil(t, err)
+	}
+	return clientManager, resource.NewResourceManager(clientManager), runIds
+}
+
+func TestBatchArchiveRuns_ByRunIds(t *testing.T) {
+	clientManager, manager, runIds := initWithThreeRuns(t)
+	defer clientManager.Close()
+	runServer := RunServer{resourceManager: manager, options: &RunServerOptions{CollectMetrics: false}}
+
+	response, err := runServer.BatchArchiveRuns(context.Background(), &api.BatchRunsRequest{
+		RunIds: []string{runIds[0], "missing-run", runIds[1]},
+	})
+	assert.Nil(t, err)
+	expectedResponse := &api.BatchRunsResponse{
+		Results: []*api.BatchRunsResponse_BatchRunResult{
+			{RunId: runIds[0], Status: api.BatchRunsResponse_BatchRunResult_OK},
+			{RunId: "missing-run", Status: api.BatchRunsResponse_BatchRunResult_NOT_FOUND},
+			{RunId: runIds[1], Status: api.BatchRunsResponse_BatchRunResult_OK},
+		},
+	}
+	// Message fields, which are not reliable, are ignored from the test.
+	for _, result := range response.Results {
+		result.Message = ""
+	}
+	assert.Equal(t, expectedResponse, response)
+
+	for i, expectedState := range []string{"STORAGESTATE_ARCHIVED", "STORAGESTATE_ARCHIVED", "STORAGESTATE_AVAILABLE"} {
+		run, err := manager.GetRun(runIds[i])
+		assert.Nil(t, err)
+		assert.Equal(t, expectedState, run.StorageState)
+	}
+}
+
+func TestBatchDeleteRuns_ByFilter(t *testing.T) {
+	viper.Set(common.BatchRunsChunkSize, "1")
+	defer viper.Set(common.BatchRunsChunkSize, "")
+
+	clientManager, manager, runIds := initWithThreeRuns(t)
+	defer clientManager.Close()
+	runServer := RunServer{resourceManager: manager, options: &RunServerOptions{CollectMetrics: false}}
+
+	response, err := runServer.BatchDeleteRuns(context.Background(), &api.BatchRunsRequest{
+		ResourceReferenceKey: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: resource.DefaultFakeUUID},
+		Filter: &api.Filter{
+			Predicates: []*api.Predicate{{
+				Key:   "name",
+				Op:    api.Predicate_NOT_EQUALS,
+				Value: &api.Predicate_StringValue{StringValue: "keep"},
+			}},
+		},
+	})
+	assert.Nil(t, err)
+	expectedResponse := &api.BatchRunsResponse{
+		Results: []*api.BatchRunsResponse_BatchRunResult{
+			{RunId: runIds[0], Status: api.BatchRunsResponse_BatchRunResult_OK},
+			{RunId: runIds[1], Status: api.BatchRunsResponse_BatchRunResult_OK},
+		},
+	}
+	assert.Equal(t, expectedResponse, response)
+
+	_, err = manager.GetRun(runIds[0])
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.NotFound))
+	_, err = manager.GetRun(runIds[1])
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.NotFound))
+	_, err = manager.GetRun(runIds[2])
+	assert.Nil(t, err)
+}
+
+func TestBatchRuns_InvalidRequest(t *testing.T) {
+	clientManager, manager, runIds := initWithThreeRuns(t)
+	defer clientManager.Close()
+	runServer := RunServer{resourceManager: manager, options: &RunServerOptions{CollectMetrics: false}}
+
+	tests := []struct {
+		name    string
+		request *api.BatchRunsRequest
+	}{
+		{
+			"Empty request",
+			&api.BatchRunsRequest{},
+		},
+		{
+			"Filter without resource reference",
+			&api.BatchRunsRequest{Filter: &api.Filter{}},
+		},
+		{
+			"Resource reference without filter",
+			&api.BatchRunsRequest{
+				ResourceReferenceKey: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: resource.DefaultFakeUUID},
+			},
+		},
+		{
+			"Resource reference with empty filter",
+			&api.BatchRunsRequest{
+				ResourceReferenceKey: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: resource.DefaultFakeUUID},
+				Filter:               &api.Filter{},
+			},
+		},
+		{
+			"Run IDs and filter",
+			&api.BatchRunsRequest{
+				RunIds:               runIds,
+				ResourceReferenceKey: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: resource.DefaultFakeUUID},
+				Filter:               &api.Filter{},
+			},
+		},
+	}
+	for _, tc := range tests {
+		_, err := runServer.BatchDeleteRuns(context.Background(), tc.request)
+		assert.True(t, util.IsUserErrorCodeMatch(err, codes.InvalidArgument), tc.name)
+	}
+	_, err := manager.GetRun(runIds[0])
+	assert.Nil(t, err)
+}
+
+func TestBatchTerminateRuns_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+
+	clients, manager, experiment := initWithExperiment_SubjectAccessReview_Unauthorized(t)
+	defer clients.Close()
+	runServer := RunServer{resourceManager: manager, options: &RunServerOptions{CollectMetrics: false}}
+
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	runDetail, err := manager.CreateRun(context.Background(), &api.Run{
+		Name: "run1",
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	response, err := runServer.BatchTerminateRuns(ctx, &api.BatchRunsRequest{RunIds: []string{runDetail.UUID}})
+	assert.Nil(t, err)
+	assert.Len(t, response.Results, 1)
+	assert.Equal(t, api.BatchRunsResponse_BatchRunResult_PERMISSION_DENIED, response.Results[0].Status)
+
+	run, err := manager.GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.NotEqual(t, "Terminating", run.Conditions)
+}
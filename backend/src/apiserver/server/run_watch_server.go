@@ -0,0 +1,162 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	WatchRunFilterKey       = "filter"
+	WatchRunResourceTypeKey = "resource_reference_key.type"
+	WatchRunResourceIdKey   = "resource_reference_key.id"
+)
+
+// The messages are marshalled the same way as by the grpc-gateway.
+var runWatchMarshaler = &jsonpb.Marshaler{OrigName: true}
+
+// RunWatchServer streams the WatchRun and WatchRuns updates as server-sent
+// events. Each run is sent as the data of an event, and an error ends the
+// stream with an "error" event holding a Status.
+// These endpoints are not exposed through the grpc-gateway, since it cannot
+// stream server-sent events.
+type RunWatchServer struct {
+	runServer *RunServer
+}
+
+func (s *RunWatchServer) WatchRun(w http.ResponseWriter, r *http.Request) {
+	request := &api.WatchRunRequest{RunId: mux.Vars(r)[RunKey]}
+	s.serveEvents(w, r, func(ctx context.Context, send func(proto.Message) error) error {
+		return s.runServer.watchRun(ctx, request, func(run *api.RunDetail) error { return send(run) })
+	})
+}
+
+func (s *RunWatchServer) WatchRuns(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	request := &api.WatchRunsRequest{Filter: query.Get(WatchRunFilterKey)}
+	if query.Get(WatchRunResourceTypeKey) != "" || query.Get(WatchRunResourceIdKey) != "" {
+		resourceType, ok := api.ResourceType_value[query.Get(WatchRunResourceTypeKey)]
+		if !ok {
+			s.writeErrorToResponse(w, util.NewInvalidInputError("Unrecognized resource reference type %q.",
+				query.Get(WatchRunResourceTypeKey)))
+			return
+		}
+		request.ResourceReferenceKey = &api.ResourceKey{
+			Type: api.ResourceType(resourceType),
+			Id:   query.Get(WatchRunResourceIdKey),
+		}
+	}
+	s.serveEvents(w, r, func(ctx context.Context, send func(proto.Message) error) error {
+		return s.runServer.watchRuns(ctx, request, func(run *api.Run) error { return send(run) })
+	})
+}
+
+// serveEvents streams the messages sent by the watch as server-sent events.
+// Errors returned before the first message are responded with their HTTP
+// status instead.
+func (s *RunWatchServer) serveEvents(w http.ResponseWriter, r *http.Request,
+	watch func(ctx context.Context, send func(proto.Message) error) error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		s.writeErrorToResponse(w, util.NewInternalServerError(fmt.Errorf("streaming unsupported"),
+			"The response writer doesn't support streaming"))
+		return
+	}
+
+	// Forward the identity of the user, the way the grpc-gateway does.
+	headers := map[string]string{}
+	for _, key := range []string{common.GetKubeflowUserIDHeader(), common.AuthorizationBearerTokenHeader} {
+		if value := r.Header.Get(key); value != "" {
+			headers[key] = value
+		}
+	}
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.New(headers))
+
+	started := false
+	send := func(message proto.Message) error {
+		data, err := runWatchMarshaler.MarshalToString(message)
+		if err != nil {
+			return util.NewInternalServerError(err, "Failed to marshal the watched run")
+		}
+		if !started {
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache, private")
+			w.WriteHeader(http.StatusOK)
+			started = true
+		}
+		if _, err := fmt.Fprintf(w, "data: %s\n\n", data); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	}
+
+	err := watch(ctx, send)
+	if err == nil {
+		return
+	}
+	if !started {
+		s.writeErrorToResponse(w, err)
+		return
+	}
+	glog.Errorf("Failed to watch runs. Error: %+v", err)
+	data, marshalErr := runWatchMarshaler.MarshalToString(toApiStatus(err))
+	if marshalErr != nil {
+		return
+	}
+	fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+	flusher.Flush()
+}
+
+func (s *RunWatchServer) writeErrorToResponse(w http.ResponseWriter, err error) {
+	glog.Errorf("Failed to watch runs. Error: %+v", err)
+	apiStatus := toApiStatus(err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(status.Code(util.ToGRPCError(err))))
+	data, marshalErr := runWatchMarshaler.MarshalToString(apiStatus)
+	if marshalErr != nil {
+		w.Write([]byte("Error watching runs"))
+		return
+	}
+	w.Write([]byte(data))
+}
+
+// toApiStatus converts the error the way the grpc-gateway does.
+func toApiStatus(err error) *api.Status {
+	grpcStatus := status.Convert(util.ToGRPCError(err))
+	return &api.Status{
+		Error:   grpcStatus.Message(),
+		Code:    int32(grpcStatus.Code()),
+		Details: grpcStatus.Proto().GetDetails(),
+	}
+}
+
+func NewRunWatchServer(resourceManager *resource.ResourceManager) *RunWatchServer {
+	return &RunWatchServer{runServer: NewRunServer(resourceManager, &RunServerOptions{CollectMetrics: false})}
+}
@@ -0,0 +1,241 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/gorilla/mux"
+	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type fakeWatchRunServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	send func(run *api.RunDetail) error
+}
+
+func (s *fakeWatchRunServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchRunServer) Send(run *api.RunDetail) error {
+	return s.send(run)
+}
+
+func startRunWatchServer(manager *resource.ResourceManager) *httptest.Server {
+	runWatchServer := NewRunWatchServer(manager)
+	router := mux.NewRouter()
+	router.HandleFunc("/apis/v1beta1/runs:watch", runWatchServer.WatchRuns).Methods(http.MethodGet)
+	router.HandleFunc("/apis/v1beta1/runs/{run_id}:watch", runWatchServer.WatchRun).Methods(http.MethodGet)
+	return httptest.NewServer(router)
+}
+
+// readEvent reads the next server-sent event and returns its type and data.
+func readEvent(t *testing.T, reader *bufio.Reader) (string, string) {
+	eventType, data := "", ""
+	for {
+		line, err := reader.ReadString('\n')
+		require.Nil(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			return eventType, data
+		}
+		if strings.HasPrefix(line, "event: ") {
+			eventType = strings.TrimPrefix(line, "event: ")
+		} else if strings.HasPrefix(line, "data: ") {
+			data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+func TestWatchRun(t *testing.T) {
+	clients, manager, run := initWithOneTimeRun(t)
+	defer clients.Close()
+	server := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var conditions []string
+	stream := &fakeWatchRunServer{ctx: ctx, send: func(runDetail *api.RunDetail) error {
+		assert.Equal(t, run.UUID, runDetail.Run.Id)
+		conditions = append(conditions, runDetail.Run.Status)
+		switch len(conditions) {
+		case 1:
+			// Runs are only sent when their condition changes.
+			assert.Nil(t, clients.RunStore().UpdateRun(run.UUID, run.Conditions, 0, "workflow"))
+			assert.Nil(t, clients.RunStore().UpdateRun(run.UUID, "Succeeded", 10, "workflow"))
+		default:
+			cancel()
+		}
+		return nil
+	}}
+	err := server.WatchRun(&api.WatchRunRequest{RunId: run.UUID}, stream)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{run.Conditions, "Succeeded"}, conditions)
+}
+
+func TestWatchRun_RunNotFound(t *testing.T) {
+	clients, manager, _ := initWithOneTimeRun(t)
+	defer clients.Close()
+	server := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+
+	stream := &fakeWatchRunServer{ctx: context.Background(), send: func(runDetail *api.RunDetail) error {
+		t.Fatalf("Unexpected run %v", runDetail)
+		return nil
+	}}
+	err := server.WatchRun(&api.WatchRunRequest{RunId: "missing"}, stream)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestRunWatchServer_WatchRun(t *testing.T) {
+	clients, manager, run := initWithOneTimeRun(t)
+	defer clients.Close()
+	httpServer := startRunWatchServer(manager)
+	defer httpServer.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, httpServer.URL+"/apis/v1beta1/runs/"+run.UUID+":watch", nil)
+	require.Nil(t, err)
+	response, err := http.DefaultClient.Do(request)
+	require.Nil(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+	reader := bufio.NewReader(response.Body)
+
+	_, data := readEvent(t, reader)
+	runDetail := &api.RunDetail{}
+	require.Nil(t, jsonpb.UnmarshalString(data, runDetail))
+	assert.Equal(t, run.UUID, runDetail.Run.Id)
+	assert.Equal(t, run.Conditions, runDetail.Run.Status)
+
+	require.Nil(t, clients.RunStore().UpdateRun(run.UUID, "Failed", 10, "workflow"))
+	eventType, data := readEvent(t, reader)
+	assert.Empty(t, eventType)
+	require.Nil(t, jsonpb.UnmarshalString(data, runDetail))
+	assert.Equal(t, "Failed", runDetail.Run.Status)
+}
+
+func TestRunWatchServer_WatchRun_RunNotFound(t *testing.T) {
+	clients, manager, _ := initWithOneTimeRun(t)
+	defer clients.Close()
+	httpServer := startRunWatchServer(manager)
+	defer httpServer.Close()
+
+	response, err := http.Get(httpServer.URL + "/apis/v1beta1/runs/missing:watch")
+	require.Nil(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+	body, err := ioutil.ReadAll(response.Body)
+	require.Nil(t, err)
+	apiStatus := &api.Status{}
+	require.Nil(t, jsonpb.UnmarshalString(string(body), apiStatus))
+	assert.Equal(t, int32(5), apiStatus.Code)
+	assert.Contains(t, apiStatus.Error, "not found")
+}
+
+func TestRunWatchServer_WatchRuns(t *testing.T) {
+	clients, manager, run := initWithOneTimeRun(t)
+	defer clients.Close()
+	httpServer := startRunWatchServer(manager)
+	defer httpServer.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	query := url.Values{}
+	query.Set(WatchRunResourceTypeKey, "EXPERIMENT")
+	query.Set(WatchRunResourceIdKey, run.ExperimentUUID)
+	query.Set(WatchRunFilterKey, url.QueryEscape(`{"predicates": [{"key": "status", "op": "EQUALS", "string_value": "Succeeded"}]}`))
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		httpServer.URL+"/apis/v1beta1/runs:watch?"+query.Encode(), nil)
+	require.Nil(t, err)
+
+	// Updates are watched once the response headers are sent, which happens
+	// with the first event, so the runs are updated until one is received.
+	go func() {
+		for ctx.Err() == nil {
+			clients.RunStore().UpdateRun(run.UUID, "Running", 0, "workflow")
+			clients.RunStore().UpdateRun(run.UUID, "Succeeded", 10, "workflow")
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+	response, err := http.DefaultClient.Do(request)
+	require.Nil(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	reader := bufio.NewReader(response.Body)
+
+	for i := 0; i < 2; i++ {
+		_, data := readEvent(t, reader)
+		apiRun := &api.Run{}
+		require.Nil(t, jsonpb.UnmarshalString(data, apiRun))
+		assert.Equal(t, run.UUID, apiRun.Id)
+		// The runs that don't match the filter are not sent.
+		assert.Equal(t, "Succeeded", apiRun.Status)
+	}
+}
+
+func TestIsRunUpdateInFilterContext(t *testing.T) {
+	update := model.RunUpdate{RunUUID: "run1", Namespace: "ns1", ExperimentUUID: "exp1"}
+	tests := []struct {
+		name   string
+		refKey *common.ReferenceKey
+		want   bool
+	}{
+		{name: "no reference", refKey: nil, want: true},
+		{name: "same namespace", refKey: &common.ReferenceKey{Type: common.Namespace, ID: "ns1"}, want: true},
+		{name: "other namespace", refKey: &common.ReferenceKey{Type: common.Namespace, ID: "ns2"}, want: false},
+		{name: "same experiment", refKey: &common.ReferenceKey{Type: common.Experiment, ID: "exp1"}, want: true},
+		{name: "other experiment", refKey: &common.ReferenceKey{Type: common.Experiment, ID: "exp2"}, want: false},
+		// The runs of a job are only known by reading them.
+		{name: "job", refKey: &common.ReferenceKey{Type: common.Job, ID: "job1"}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isRunUpdateInFilterContext(update, &common.FilterContext{ReferenceKey: tt.refKey})
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRunWatchServer_WatchRuns_InvalidFilter(t *testing.T) {
+	clients, manager, _ := initWithOneTimeRun(t)
+	defer clients.Close()
+	httpServer := startRunWatchServer(manager)
+	defer httpServer.Close()
+
+	response, err := http.Get(httpServer.URL + "/apis/v1beta1/runs:watch?filter=invalid")
+	require.Nil(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
@@ -3,9 +3,12 @@ package server
 import (
 	"context"
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	"strings"
 )
 
@@ -91,6 +94,96 @@ func (s *TaskServer) ListTasks(ctx context.Context, request *api.ListTasksReques
 		nil
 }
 
+func (s *TaskServer) GetTask(ctx context.Context, request *api.GetTaskRequest) (*api.Task, error) {
+	if request.GetId() == "" {
+		return nil, util.NewInvalidInputError("Task ID is empty. Please specify a valid ID")
+	}
+	task, err := s.resourceManager.GetTask(request.GetId())
+	if err != nil {
+		return nil, util.Wrap(err, "Get task failed.")
+	}
+	err = s.canAccessTask(ctx, task)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	return ToApiTask(task), nil
+}
+
+// canAccessTask authorizes reading the cache entry, which is only visible to
+// the users of its namespace in multi-user mode.
+func (s *TaskServer) canAccessTask(ctx context.Context, task *model.Task) error {
+	if !common.IsMultiUserMode() {
+		// Skip authz if not multi-user mode.
+		return nil
+	}
+	if task.Namespace == "" {
+		return util.NewInternalServerError(errors.New("Empty namespace"), "The task doesn't have a valid namespace.")
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: task.Namespace,
+		Verb:      common.RbacResourceVerbGet,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeRuns,
+	}
+	return isAuthorized(s.resourceManager, ctx, resourceAttributes)
+}
+
+func (s *TaskServer) InvalidateCache(ctx context.Context, request *api.InvalidateCacheRequest) (
+	*api.InvalidateCacheResponse, error) {
+	var createdBeforeInSec int64
+	if request.GetCreatedBefore() != nil {
+		if err := request.GetCreatedBefore().CheckValid(); err != nil {
+			return nil, util.NewInvalidInputError("Invalid created_before: %v", err)
+		}
+		createdBeforeInSec = request.GetCreatedBefore().AsTime().Unix()
+		if createdBeforeInSec <= 0 {
+			return nil, util.NewInvalidInputError("Invalid created_before: must be after the Unix epoch")
+		}
+	}
+	if request.GetFingerprint() == "" && request.GetPipelineName() == "" && request.GetNamespace() == "" && createdBeforeInSec == 0 {
+		return nil, util.NewInvalidInputError("Invalid cache invalidation request: must specify at least one of fingerprint, pipeline_name, namespace or created_before")
+	}
+	if strings.HasPrefix(request.GetPipelineName(), "namespace/") {
+		s := strings.SplitN(request.GetPipelineName(), "/", 4)
+		if len(s) == 4 && request.GetNamespace() != "" && s[1] != request.GetNamespace() {
+			return nil, util.NewInvalidInputError("the namespace %s extracted from pipeline_name is not equal to the namespace %s in the request", s[1], request.GetNamespace())
+		}
+	}
+
+	err := s.canInvalidateCache(ctx, request.GetNamespace())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	count, err := s.resourceManager.InvalidateCache(request.GetFingerprint(), request.GetPipelineName(), request.GetNamespace(), createdBeforeInSec)
+	if err != nil {
+		return nil, util.Wrap(err, "Invalidate cache failed.")
+	}
+	return &api.InvalidateCacheResponse{InvalidatedCount: int32(count)}, nil
+}
+
+// canInvalidateCache authorizes invalidating the cache entries of the
+// namespace. In multi-user mode, the cache entries can only be invalidated one
+// namespace at a time.
+func (s *TaskServer) canInvalidateCache(ctx context.Context, namespace string) error {
+	if !common.IsMultiUserMode() {
+		// Skip authz if not multi-user mode.
+		return nil
+	}
+	if namespace == "" {
+		return util.NewInvalidInputError("Invalid cache invalidation request: must specify a namespace in multi-user mode")
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      common.RbacResourceVerbInvalidateCache,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeRuns,
+	}
+	return isAuthorized(s.resourceManager, ctx, resourceAttributes)
+}
+
 func NewTaskServer(resourceManager *resource.ResourceManager) *TaskServer {
 	return &TaskServer{resourceManager: resourceManager}
 }
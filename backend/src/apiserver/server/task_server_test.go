@@ -0,0 +1,243 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	cachemodel "github.com/kubeflow/pipelines/backend/src/cache/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+func initWithTasks(t *testing.T) (*resource.FakeClientManager, *TaskServer, []*api.Task) {
+	clientManager := resource.NewFakeClientManagerOrFatalV2()
+	server := NewTaskServer(resource.NewResourceManager(clientManager))
+	var tasks []*api.Task
+	for _, task := range []*api.Task{
+		{PipelineName: "pipeline/p1", RunId: "run1", MlmdExecutionID: "1", Fingerprint: "f1", CreatedAt: &timestamp.Timestamp{Seconds: 10}},
+		{PipelineName: "pipeline/p1", RunId: "run1", MlmdExecutionID: "2", Fingerprint: "f2", CreatedAt: &timestamp.Timestamp{Seconds: 20}},
+		{PipelineName: "pipeline/p2", RunId: "run2", MlmdExecutionID: "3", Fingerprint: "f1", CreatedAt: &timestamp.Timestamp{Seconds: 30}},
+	} {
+		created, err := server.CreateTask(nil, &api.CreateTaskRequest{Task: task})
+		assert.Nil(t, err)
+		tasks = append(tasks, created)
+	}
+	return clientManager, server, tasks
+}
+
+func TestGetTask(t *testing.T) {
+	clientManager, server, tasks := initWithTasks(t)
+	defer clientManager.Close()
+
+	task, err := server.GetTask(nil, &api.GetTaskRequest{Id: tasks[1].Id})
+	assert.Nil(t, err)
+	assert.Equal(t, tasks[1], task)
+
+	_, err = server.GetTask(nil, &api.GetTaskRequest{Id: "not-exist"})
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestGetTask_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	userIdentity := "user@google.com"
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + userIdentity})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clientManager := resource.NewFakeClientManagerOrFatalV2()
+	defer clientManager.Close()
+	server := NewTaskServer(resource.NewResourceManager(clientManager))
+	task, err := server.CreateTask(nil, &api.CreateTaskRequest{Task: &api.Task{
+		PipelineName: "pipeline/p1", Namespace: "ns1", RunId: "run1", MlmdExecutionID: "1", Fingerprint: "f1", CreatedAt: &timestamp.Timestamp{Seconds: 10},
+	}})
+	assert.Nil(t, err)
+
+	got, err := server.GetTask(ctx, &api.GetTaskRequest{Id: task.Id})
+	assert.Nil(t, err)
+	assert.Equal(t, task, got)
+
+	// The cache entries of other namespaces aren't visible.
+	clientManager.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	server = NewTaskServer(resource.NewResourceManager(clientManager))
+	_, err = server.GetTask(ctx, &api.GetTaskRequest{Id: task.Id})
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.PermissionDenied, err.(*util.UserError).ExternalStatusCode())
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: "ns1",
+		Verb:      common.RbacResourceVerbGet,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeRuns,
+	}
+	assert.EqualError(
+		t,
+		err,
+		wrapFailedAuthzRequestError(getPermissionDeniedError(userIdentity, resourceAttributes)).Error(),
+	)
+}
+
+func TestInvalidateCache(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       *api.InvalidateCacheRequest
+		wantCount     int32
+		wantRemaining []int
+	}{
+		{
+			name:          "fingerprint",
+			request:       &api.InvalidateCacheRequest{Fingerprint: "f1"},
+			wantCount:     2,
+			wantRemaining: []int{1},
+		},
+		{
+			name:          "pipeline",
+			request:       &api.InvalidateCacheRequest{PipelineName: "pipeline/p1"},
+			wantCount:     2,
+			wantRemaining: []int{2},
+		},
+		{
+			name:          "created before",
+			request:       &api.InvalidateCacheRequest{CreatedBefore: &timestamp.Timestamp{Seconds: 25}},
+			wantCount:     2,
+			wantRemaining: []int{2},
+		},
+		{
+			name:          "nothing matched",
+			request:       &api.InvalidateCacheRequest{Fingerprint: "f2", PipelineName: "pipeline/p2"},
+			wantCount:     0,
+			wantRemaining: []int{0, 1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientManager, server, tasks := initWithTasks(t)
+			defer clientManager.Close()
+
+			response, err := server.InvalidateCache(nil, tt.request)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantCount, response.InvalidatedCount)
+
+			listResponse, err := server.ListTasks(nil, &api.ListTasksRequest{PageSize: 10})
+			assert.Nil(t, err)
+			var want []*api.Task
+			for _, i := range tt.wantRemaining {
+				want = append(want, tasks[i])
+			}
+			assert.ElementsMatch(t, want, listResponse.Tasks)
+		})
+	}
+}
+
+func TestInvalidateCache_NoCondition(t *testing.T) {
+	clientManager, server, _ := initWithTasks(t)
+	defer clientManager.Close()
+
+	_, err := server.InvalidateCache(nil, &api.InvalidateCacheRequest{})
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "must specify at least one of")
+}
+
+func TestInvalidateCache_V1ExecutionCaches(t *testing.T) {
+	clientManager, server, _ := initWithTasks(t)
+	defer clientManager.Close()
+	for _, key := range []string{"key1", "key2"} {
+		_, err := clientManager.ExecutionCacheStore().CreateExecutionCache(&cachemodel.ExecutionCache{
+			ExecutionCacheKey: key,
+			ExecutionTemplate: "template",
+			MaxCacheStaleness: -1,
+		})
+		assert.Nil(t, err)
+	}
+
+	// The v1 execution caches don't record their pipeline.
+	response, err := server.InvalidateCache(nil, &api.InvalidateCacheRequest{PipelineName: "pipeline/p1"})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), response.InvalidatedCount)
+	_, err = clientManager.ExecutionCacheStore().GetExecutionCache("key1", -1, -1)
+	assert.Nil(t, err)
+
+	response, err = server.InvalidateCache(nil, &api.InvalidateCacheRequest{Fingerprint: "key1"})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), response.InvalidatedCount)
+	_, err = clientManager.ExecutionCacheStore().GetExecutionCache("key1", -1, -1)
+	assert.Contains(t, err.Error(), "not found")
+
+	response, err = server.InvalidateCache(nil, &api.InvalidateCacheRequest{CreatedBefore: &timestamp.Timestamp{Seconds: 1000}})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), response.InvalidatedCount)
+	_, err = clientManager.ExecutionCacheStore().GetExecutionCache("key2", -1, -1)
+	assert.Contains(t, err.Error(), "not found")
+	listResponse, err := server.ListTasks(nil, &api.ListTasksRequest{PageSize: 10})
+	assert.Nil(t, err)
+	assert.Empty(t, listResponse.Tasks)
+}
+
+func TestInvalidateCache_MultiUser(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clientManager := resource.NewFakeClientManagerOrFatalV2()
+	defer clientManager.Close()
+	server := NewTaskServer(resource.NewResourceManager(clientManager))
+	for _, task := range []*api.Task{
+		{PipelineName: "pipeline/p1", Namespace: "ns1", RunId: "run1", MlmdExecutionID: "1", Fingerprint: "f1", CreatedAt: &timestamp.Timestamp{Seconds: 10}},
+		{PipelineName: "pipeline/p1", Namespace: "ns2", RunId: "run2", MlmdExecutionID: "2", Fingerprint: "f1", CreatedAt: &timestamp.Timestamp{Seconds: 10}},
+	} {
+		_, err := server.CreateTask(nil, &api.CreateTaskRequest{Task: task})
+		assert.Nil(t, err)
+	}
+
+	// The cache entries of every namespace can't be invalidated at once.
+	_, err := server.InvalidateCache(ctx, &api.InvalidateCacheRequest{Fingerprint: "f1"})
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "must specify a namespace in multi-user mode")
+
+	response, err := server.InvalidateCache(ctx, &api.InvalidateCacheRequest{Fingerprint: "f1", Namespace: "ns1"})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), response.InvalidatedCount)
+	listResponse, err := server.ListTasks(nil, &api.ListTasksRequest{PageSize: 10})
+	assert.Nil(t, err)
+	assert.Len(t, listResponse.Tasks, 1)
+	assert.Equal(t, "ns2", listResponse.Tasks[0].Namespace)
+}
+
+func TestInvalidateCache_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	userIdentity := "user@google.com"
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + userIdentity})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clientManager := resource.NewFakeClientManagerOrFatalV2()
+	defer clientManager.Close()
+	clientManager.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	server := NewTaskServer(resource.NewResourceManager(clientManager))
+
+	_, err := server.InvalidateCache(ctx, &api.InvalidateCacheRequest{Fingerprint: "f1", Namespace: "ns1"})
+	assert.NotNil(t, err)
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: "ns1",
+		Verb:      common.RbacResourceVerbInvalidateCache,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeRuns,
+	}
+	assert.EqualError(
+		t,
+		err,
+		wrapFailedAuthzRequestError(getPermissionDeniedError(userIdentity, resourceAttributes)).Error(),
+	)
+}
@@ -38,7 +38,11 @@ func NewFakeDb() (*DB, error) {
 		&model.ResourceReference{},
 		&model.RunDetail{},
 		&model.RunMetric{},
+		&model.RunParameter{},
 		&model.Task{},
+		&model.NotificationSubscription{},
+		&model.NotificationDelivery{},
+		&model.RunUpdate{},
 		&model.DBStatus{},
 		&model.DefaultExperiment{})
 
@@ -30,7 +30,8 @@ var jobColumns = []string{"UUID", "DisplayName", "Name", "Namespace", "ServiceAc
 	"NoCatchup", "CreatedAtInSec", "UpdatedAtInSec", "Enabled", "CronScheduleStartTimeInSec", "CronScheduleEndTimeInSec",
 	"Schedule", "PeriodicScheduleStartTimeInSec", "PeriodicScheduleEndTimeInSec", "IntervalSecond",
 	"PipelineId", "PipelineName", "PipelineSpecManifest", "WorkflowSpecManifest", "Parameters", "Conditions",
-	"RuntimeParameters", "PipelineRoot",
+	"RuntimeParameters", "PipelineRoot", "CronScheduleTimeZone", "RunCompletionUpstreamType",
+	"RunCompletionUpstreamId", "RunCompletionStates", "ConcurrencyPolicy", "StartingDeadlineSeconds",
 }
 
 type JobStoreInterface interface {
@@ -40,6 +41,8 @@ type JobStoreInterface interface {
 	DeleteJob(id string) error
 	EnableJob(id string, enabled bool) error
 	UpdateJob(swf *util.ScheduledWorkflow) error
+	ReplaceJob(*model.Job) error
+	ListJobsTriggeredByRunCompletion(upstreamType model.ResourceType, upstreamId string) ([]*model.Job, error)
 }
 
 type JobStore struct {
@@ -171,6 +174,34 @@ func (s *JobStore) GetJob(id string) (*model.Job, error) {
 	return jobs[0], nil
 }
 
+// ListJobsTriggeredByRunCompletion returns the enabled jobs that are triggered
+// by the completion of the runs of the given upstream resource.
+func (s *JobStore) ListJobsTriggeredByRunCompletion(upstreamType model.ResourceType, upstreamId string) ([]*model.Job, error) {
+	sql, args, err := s.addResourceReferences(sq.Select(jobColumns...).From("jobs").
+		Where(sq.Eq{
+			"RunCompletionUpstreamType": string(upstreamType),
+			"RunCompletionUpstreamId":   upstreamId,
+			"Enabled":                   true,
+		})).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list jobs triggered by %v %v: %v",
+			upstreamType, upstreamId, err.Error())
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list jobs triggered by %v %v: %v",
+			upstreamType, upstreamId, err.Error())
+	}
+	defer rows.Close()
+	jobs, err := s.scanRows(rows)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list jobs triggered by %v %v: %v",
+			upstreamType, upstreamId, err.Error())
+	}
+	return jobs, nil
+}
+
 func (s *JobStore) addResourceReferences(filteredSelectBuilder sq.SelectBuilder) sq.SelectBuilder {
 	resourceRefConcatQuery := s.db.Concat([]string{`"["`, s.db.GroupConcat("r.Payload", ","), `"]"`}, "")
 	return sq.
@@ -185,47 +216,58 @@ func (s *JobStore) scanRows(r *sql.Rows) ([]*model.Job, error) {
 	var jobs []*model.Job
 	for r.Next() {
 		var uuid, displayName, name, namespace, pipelineId, pipelineName, conditions, serviceAccount,
-			description, parameters, pipelineSpecManifest, workflowSpecManifest string
+			description, parameters, pipelineSpecManifest, workflowSpecManifest, concurrencyPolicy string
 		var cronScheduleStartTimeInSec, cronScheduleEndTimeInSec,
 			periodicScheduleStartTimeInSec, periodicScheduleEndTimeInSec, intervalSecond sql.NullInt64
-		var cron, resourceReferencesInString, runtimeParameters, pipelineRoot sql.NullString
+		var cron, cronScheduleTimeZone, resourceReferencesInString, runtimeParameters, pipelineRoot,
+			runCompletionUpstreamType, runCompletionUpstreamId, runCompletionStates sql.NullString
 		var enabled, noCatchup bool
-		var createdAtInSec, updatedAtInSec, maxConcurrency int64
+		var createdAtInSec, updatedAtInSec, maxConcurrency, startingDeadlineSeconds int64
 		err := r.Scan(
 			&uuid, &displayName, &name, &namespace, &serviceAccount, &description,
 			&maxConcurrency, &noCatchup, &createdAtInSec, &updatedAtInSec, &enabled,
 			&cronScheduleStartTimeInSec, &cronScheduleEndTimeInSec, &cron,
 			&periodicScheduleStartTimeInSec, &periodicScheduleEndTimeInSec, &intervalSecond,
 			&pipelineId, &pipelineName, &pipelineSpecManifest, &workflowSpecManifest, &parameters,
-			&conditions, &runtimeParameters, &pipelineRoot, &resourceReferencesInString)
+			&conditions, &runtimeParameters, &pipelineRoot, &cronScheduleTimeZone, &runCompletionUpstreamType,
+			&runCompletionUpstreamId, &runCompletionStates, &concurrencyPolicy, &startingDeadlineSeconds,
+			&resourceReferencesInString)
 		if err != nil {
 			return nil, err
 		}
 		resourceReferences, err := parseResourceReferences(resourceReferencesInString)
 		runtimeConfig := parseRuntimeConfig(runtimeParameters, pipelineRoot)
 		jobs = append(jobs, &model.Job{
-			UUID:               uuid,
-			DisplayName:        displayName,
-			Name:               name,
-			Namespace:          namespace,
-			ServiceAccount:     serviceAccount,
-			Description:        description,
-			Enabled:            enabled,
-			Conditions:         conditions,
-			MaxConcurrency:     maxConcurrency,
-			NoCatchup:          noCatchup,
-			ResourceReferences: resourceReferences,
+			UUID:                    uuid,
+			DisplayName:             displayName,
+			Name:                    name,
+			Namespace:               namespace,
+			ServiceAccount:          serviceAccount,
+			Description:             description,
+			Enabled:                 enabled,
+			Conditions:              conditions,
+			MaxConcurrency:          maxConcurrency,
+			NoCatchup:               noCatchup,
+			ResourceReferences:      resourceReferences,
+			ConcurrencyPolicy:       concurrencyPolicy,
+			StartingDeadlineSeconds: startingDeadlineSeconds,
 			Trigger: model.Trigger{
 				CronSchedule: model.CronSchedule{
 					CronScheduleStartTimeInSec: NullInt64ToPointer(cronScheduleStartTimeInSec),
 					CronScheduleEndTimeInSec:   NullInt64ToPointer(cronScheduleEndTimeInSec),
 					Cron:                       NullStringToPointer(cron),
+					CronScheduleTimeZone:       NullStringToPointer(cronScheduleTimeZone),
 				},
 				PeriodicSchedule: model.PeriodicSchedule{
 					PeriodicScheduleStartTimeInSec: NullInt64ToPointer(periodicScheduleStartTimeInSec),
 					PeriodicScheduleEndTimeInSec:   NullInt64ToPointer(periodicScheduleEndTimeInSec),
 					IntervalSecond:                 NullInt64ToPointer(intervalSecond),
 				},
+				RunCompletionTrigger: model.RunCompletionTrigger{
+					RunCompletionUpstreamType: NullStringToPointer(runCompletionUpstreamType),
+					RunCompletionUpstreamId:   NullStringToPointer(runCompletionUpstreamId),
+					RunCompletionStates:       NullStringToPointer(runCompletionStates),
+				},
 			},
 			PipelineSpec: model.PipelineSpec{
 				PipelineId:           pipelineId,
@@ -283,14 +325,20 @@ func (s *JobStore) CreateJob(j *model.Job) (*model.Job, error) {
 			"Description":                    j.Description,
 			"MaxConcurrency":                 j.MaxConcurrency,
 			"NoCatchup":                      j.NoCatchup,
+			"ConcurrencyPolicy":              j.ConcurrencyPolicy,
+			"StartingDeadlineSeconds":        j.StartingDeadlineSeconds,
 			"Enabled":                        j.Enabled,
 			"Conditions":                     j.Conditions,
 			"CronScheduleStartTimeInSec":     PointerToNullInt64(j.CronScheduleStartTimeInSec),
 			"CronScheduleEndTimeInSec":       PointerToNullInt64(j.CronScheduleEndTimeInSec),
 			"Schedule":                       PointerToNullString(j.Cron),
+			"CronScheduleTimeZone":           PointerToNullString(j.CronScheduleTimeZone),
 			"PeriodicScheduleStartTimeInSec": PointerToNullInt64(j.PeriodicScheduleStartTimeInSec),
 			"PeriodicScheduleEndTimeInSec":   PointerToNullInt64(j.PeriodicScheduleEndTimeInSec),
 			"IntervalSecond":                 PointerToNullInt64(j.IntervalSecond),
+			"RunCompletionUpstreamType":      PointerToNullString(j.RunCompletionUpstreamType),
+			"RunCompletionUpstreamId":        PointerToNullString(j.RunCompletionUpstreamId),
+			"RunCompletionStates":            PointerToNullString(j.RunCompletionStates),
 			"CreatedAtInSec":                 j.CreatedAtInSec,
 			"UpdatedAtInSec":                 j.UpdatedAtInSec,
 			"PipelineId":                     j.PipelineId,
@@ -366,14 +414,20 @@ func (s *JobStore) UpdateJob(swf *util.ScheduledWorkflow) error {
 			"Conditions":                     swf.ConditionSummary(),
 			"MaxConcurrency":                 swf.MaxConcurrencyOr0(),
 			"NoCatchup":                      swf.NoCatchupOrFalse(),
+			"ConcurrencyPolicy":              string(swf.Spec.ConcurrencyPolicy),
+			"StartingDeadlineSeconds":        swf.StartingDeadlineSecondsOr0(),
 			"Parameters":                     parameters,
 			"UpdatedAtInSec":                 now,
 			"CronScheduleStartTimeInSec":     PointerToNullInt64(swf.CronScheduleStartTimeInSecOrNull()),
 			"CronScheduleEndTimeInSec":       PointerToNullInt64(swf.CronScheduleEndTimeInSecOrNull()),
 			"Schedule":                       swf.CronOrEmpty(),
+			"CronScheduleTimeZone":           PointerToNullString(swf.CronScheduleTimeZoneOrNull()),
 			"PeriodicScheduleStartTimeInSec": PointerToNullInt64(swf.PeriodicScheduleStartTimeInSecOrNull()),
 			"PeriodicScheduleEndTimeInSec":   PointerToNullInt64(swf.PeriodicScheduleEndTimeInSecOrNull()),
-			"IntervalSecond":                 swf.IntervalSecondOr0()}).
+			"IntervalSecond":                 swf.IntervalSecondOr0(),
+			"RunCompletionUpstreamType":      PointerToNullString(swf.RunCompletionUpstreamTypeOrNull()),
+			"RunCompletionUpstreamId":        PointerToNullString(swf.RunCompletionUpstreamIdOrNull()),
+			"RunCompletionStates":            PointerToNullString(swf.RunCompletionStatesOrNull())}).
 		Where(sq.Eq{"UUID": string(swf.UID)}).
 		ToSql()
 	if err != nil {
@@ -404,6 +458,94 @@ func (s *JobStore) UpdateJob(swf *util.ScheduledWorkflow) error {
 	return nil
 }
 
+// ReplaceJob overwrites the user-defined fields of an existing job and its
+// resource references in a single transaction. The UUID, name, namespace and
+// creation time of the job are kept.
+func (s *JobStore) ReplaceJob(j *model.Job) error {
+	jobSql, jobArgs, err := sq.
+		Update("jobs").
+		SetMap(sq.Eq{
+			"DisplayName":                    j.DisplayName,
+			"ServiceAccount":                 j.ServiceAccount,
+			"Description":                    j.Description,
+			"MaxConcurrency":                 j.MaxConcurrency,
+			"NoCatchup":                      j.NoCatchup,
+			"ConcurrencyPolicy":              j.ConcurrencyPolicy,
+			"StartingDeadlineSeconds":        j.StartingDeadlineSeconds,
+			"Enabled":                        j.Enabled,
+			"CronScheduleStartTimeInSec":     PointerToNullInt64(j.CronScheduleStartTimeInSec),
+			"CronScheduleEndTimeInSec":       PointerToNullInt64(j.CronScheduleEndTimeInSec),
+			"Schedule":                       PointerToNullString(j.Cron),
+			"CronScheduleTimeZone":           PointerToNullString(j.CronScheduleTimeZone),
+			"PeriodicScheduleStartTimeInSec": PointerToNullInt64(j.PeriodicScheduleStartTimeInSec),
+			"PeriodicScheduleEndTimeInSec":   PointerToNullInt64(j.PeriodicScheduleEndTimeInSec),
+			"IntervalSecond":                 PointerToNullInt64(j.IntervalSecond),
+			"RunCompletionUpstreamType":      PointerToNullString(j.RunCompletionUpstreamType),
+			"RunCompletionUpstreamId":        PointerToNullString(j.RunCompletionUpstreamId),
+			"RunCompletionStates":            PointerToNullString(j.RunCompletionStates),
+			"UpdatedAtInSec":                 j.UpdatedAtInSec,
+			"PipelineId":                     j.PipelineId,
+			"PipelineName":                   j.PipelineName,
+			"PipelineSpecManifest":           j.PipelineSpecManifest,
+			"WorkflowSpecManifest":           j.WorkflowSpecManifest,
+			"Parameters":                     j.Parameters,
+			"RuntimeParameters":              j.PipelineSpec.RuntimeConfig.Parameters,
+			"PipelineRoot":                   j.PipelineSpec.RuntimeConfig.PipelineRoot,
+		}).
+		Where(sq.Eq{"UUID": j.UUID}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to replace job %v: %v", j.UUID, err.Error())
+	}
+
+	// Use a transaction to make sure the job and its resource references are replaced together.
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to replace job.")
+	}
+	r, err := tx.Exec(jobSql, jobArgs...)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to replace job %v", j.UUID)
+	}
+	rowsAffected, err := r.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to get affected rows while replacing job %v", j.UUID)
+	}
+	if rowsAffected <= 0 {
+		tx.Rollback()
+		return util.NewResourceNotFoundError("Job", j.UUID)
+	}
+	// Only the references owned by the job are replaced. References from the
+	// runs triggered by the job point at the same job UUID and are kept.
+	refSql, refArgs, err := sq.
+		Delete("resource_references").
+		Where(sq.Eq{"ResourceUUID": j.UUID, "ResourceType": common.Job}).
+		ToSql()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to create query to delete resource references of job %v", j.UUID)
+	}
+	_, err = tx.Exec(refSql, refArgs...)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete resource references of job %v", j.UUID)
+	}
+	err = s.resourceReferenceStore.CreateResourceReferences(tx, j.ResourceReferences)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to store resource references to table for job %v", j.UUID)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to replace job %v and its resource references", j.UUID)
+	}
+	return nil
+}
+
 // factory function for job store
 func NewJobStore(db *DB, time util.TimeInterface) *JobStore {
 	return &JobStore{
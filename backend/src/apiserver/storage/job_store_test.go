@@ -427,6 +427,58 @@ func TestListJobs_FilterByReferenceKey(t *testing.T) {
 	assert.Equal(t, 2, total_size) // both test jobs belong to namespace `n1`
 }
 
+func TestListJobsTriggeredByRunCompletion(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+
+	newDownstreamJob := func(uuid string, upstreamType model.ResourceType, upstreamId string, enabled bool) *model.Job {
+		return &model.Job{
+			UUID:      uuid,
+			Name:      "downstream" + uuid,
+			Namespace: "n1",
+			Enabled:   enabled,
+			Trigger: model.Trigger{
+				RunCompletionTrigger: model.RunCompletionTrigger{
+					RunCompletionUpstreamType: util.StringPointer(string(upstreamType)),
+					RunCompletionUpstreamId:   util.StringPointer(upstreamId),
+					RunCompletionStates:       util.StringPointer("Succeeded,Failed"),
+				},
+			},
+			ResourceReferences: []*model.ResourceReference{
+				{
+					ResourceUUID: uuid, ResourceType: common.Job, ReferenceUUID: defaultFakeExpId,
+					ReferenceName: "e1", ReferenceType: common.Experiment,
+					Relationship: common.Owner,
+				},
+			},
+		}
+	}
+	_, err := jobStore.CreateJob(newDownstreamJob("3", common.Job, "1", true))
+	assert.Nil(t, err)
+	_, err = jobStore.CreateJob(newDownstreamJob("4", common.Job, "1", false))
+	assert.Nil(t, err)
+	_, err = jobStore.CreateJob(newDownstreamJob("5", common.Job, "2", true))
+	assert.Nil(t, err)
+	_, err = jobStore.CreateJob(newDownstreamJob("6", common.PipelineVersion, "1", true))
+	assert.Nil(t, err)
+
+	jobs, err := jobStore.ListJobsTriggeredByRunCompletion(common.Job, "1")
+	assert.Nil(t, err)
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, "3", jobs[0].UUID)
+	assert.Equal(t, "Succeeded,Failed", *jobs[0].RunCompletionStates)
+	assert.Len(t, jobs[0].ResourceReferences, 1)
+
+	jobs, err = jobStore.ListJobsTriggeredByRunCompletion(common.PipelineVersion, "1")
+	assert.Nil(t, err)
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, "6", jobs[0].UUID)
+
+	jobs, err = jobStore.ListJobsTriggeredByRunCompletion(common.Job, "3")
+	assert.Nil(t, err)
+	assert.Empty(t, jobs)
+}
+
 func TestListJobsError(t *testing.T) {
 	db, jobStore := initializeDbAndStore()
 	defer db.Close()
@@ -785,9 +837,11 @@ func TestUpdateJob_Success(t *testing.T) {
 			UID:       "1",
 		},
 		Spec: swfapi.ScheduledWorkflowSpec{
-			Enabled:        false,
-			MaxConcurrency: util.Int64Pointer(200),
-			NoCatchup:      util.BoolPointer(true),
+			Enabled:                 false,
+			MaxConcurrency:          util.Int64Pointer(200),
+			NoCatchup:               util.BoolPointer(true),
+			ConcurrencyPolicy:       swfapi.ForbidConcurrent,
+			StartingDeadlineSeconds: util.Int64Pointer(60),
 			Workflow: &swfapi.WorkflowResource{
 				Parameters: []swfapi.Parameter{
 					{Name: "PARAM1", Value: "NEW_VALUE1"},
@@ -838,6 +892,8 @@ func TestUpdateJob_Success(t *testing.T) {
 			PipelineName: "p1",
 			Parameters:   "[{\"name\":\"PARAM1\",\"value\":\"NEW_VALUE1\"}]",
 		},
+		ConcurrencyPolicy:       "Forbid",
+		StartingDeadlineSeconds: 60,
 		Trigger: model.Trigger{
 			CronSchedule: model.CronSchedule{
 				CronScheduleStartTimeInSec: util.Int64Pointer(10),
@@ -988,6 +1044,111 @@ func TestUpdateJob_InternalError(t *testing.T) {
 	assert.Equal(t, err.(*util.UserError).ExternalStatusCode(), codes.Internal)
 }
 
+func TestReplaceJob(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+	resourceReferenceStore := NewResourceReferenceStore(db)
+	// A run triggered by job 1 references the job.
+	tx, err := db.Begin()
+	assert.Nil(t, err)
+	err = resourceReferenceStore.CreateResourceReferences(tx, []*model.ResourceReference{
+		{
+			ResourceUUID: "run1", ResourceType: common.Run,
+			ReferenceUUID: "1", ReferenceName: "pp1", ReferenceType: common.Job,
+			Relationship: common.Creator,
+		},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, tx.Commit())
+
+	job := &model.Job{
+		UUID:        "1",
+		DisplayName: "pp 1 updated",
+		Name:        "ignored",
+		Namespace:   "ignored",
+		Description: "new description",
+		Enabled:     false,
+		Trigger: model.Trigger{
+			CronSchedule: model.CronSchedule{
+				Cron:                 util.StringPointer("0 0 * * * *"),
+				CronScheduleTimeZone: util.StringPointer("America/New_York"),
+			},
+		},
+		MaxConcurrency: 2,
+		PipelineSpec: model.PipelineSpec{
+			PipelineId:   "2",
+			PipelineName: "p2",
+		},
+		CreatedAtInSec: 100,
+		UpdatedAtInSec: 3,
+		ResourceReferences: []*model.ResourceReference{
+			{
+				ResourceUUID: "1", ResourceType: common.Job, ReferenceUUID: defaultFakeExpId,
+				ReferenceName: "e1", ReferenceType: common.Experiment,
+				Relationship: common.Owner,
+			},
+		},
+	}
+	err = jobStore.ReplaceJob(job)
+	assert.Nil(t, err)
+
+	jobExpected := &model.Job{
+		UUID:        "1",
+		DisplayName: "pp 1 updated",
+		Name:        "pp1",
+		Namespace:   "n1",
+		Description: "new description",
+		Enabled:     false,
+		Conditions:  "ready",
+		Trigger: model.Trigger{
+			CronSchedule: model.CronSchedule{
+				Cron:                 util.StringPointer("0 0 * * * *"),
+				CronScheduleTimeZone: util.StringPointer("America/New_York"),
+			},
+		},
+		MaxConcurrency: 2,
+		PipelineSpec: model.PipelineSpec{
+			PipelineId:   "2",
+			PipelineName: "p2",
+		},
+		CreatedAtInSec: 1,
+		UpdatedAtInSec: 3,
+		ResourceReferences: []*model.ResourceReference{
+			{
+				ResourceUUID: "1", ResourceType: common.Job, ReferenceUUID: defaultFakeExpId,
+				ReferenceName: "e1", ReferenceType: common.Experiment,
+				Relationship: common.Owner,
+			},
+		},
+	}
+	jobUpdated, err := jobStore.GetJob("1")
+	assert.Nil(t, err)
+	assert.Equal(t, jobExpected, jobUpdated)
+
+	// The references of the runs triggered by the job are kept.
+	r, err := resourceReferenceStore.GetResourceReference("run1", common.Run, common.Job)
+	assert.Nil(t, err)
+	assert.Equal(t, "1", r.ReferenceUUID)
+}
+
+func TestReplaceJob_NotFound(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+
+	err := jobStore.ReplaceJob(&model.Job{UUID: "unknown"})
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestReplaceJob_InternalError(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	db.Close()
+
+	err := jobStore.ReplaceJob(&model.Job{UUID: "1"})
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode())
+}
+
 func TestDeleteJob(t *testing.T) {
 	db, jobStore := initializeDbAndStore()
 	defer db.Close()
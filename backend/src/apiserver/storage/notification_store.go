@@ -0,0 +1,379 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+var (
+	notificationSubscriptionColumns = []string{"UUID", "ResourceType", "ResourceUUID", "States", "URL", "Secret",
+		"CreatedAtInSec"}
+	notificationDeliveryColumns = []string{"UUID", "SubscriptionUUID", "RunUUID", "State", "Status", "Attempts",
+		"ResponseCode", "Error", "NextAttemptAtInSec", "CreatedAtInSec", "UpdatedAtInSec"}
+)
+
+type NotificationStoreInterface interface {
+	// Create a subscription entry in the database
+	CreateSubscription(subscription *model.NotificationSubscription) (*model.NotificationSubscription, error)
+
+	GetSubscription(id string) (*model.NotificationSubscription, error)
+
+	// List the subscriptions of a resource
+	ListSubscriptions(resourceType model.ResourceType, resourceId string) ([]*model.NotificationSubscription, error)
+
+	// List the subscriptions to the runs of any of the resources
+	ListSubscriptionsOfResources(resourceKeys []*common.ReferenceKey) ([]*model.NotificationSubscription, error)
+
+	// Delete a subscription and its deliveries
+	DeleteSubscription(id string) error
+
+	// Create a pending delivery, unless the run was already delivered in the state
+	CreateDelivery(subscriptionId string, runId string, state string) error
+
+	// List the pending deliveries due at the given time
+	ListPendingDeliveries(dueAtInSec int64, limit int) ([]*model.NotificationDelivery, error)
+
+	// Claim a pending delivery until the given time, so that it is only
+	// attempted by one API server. Returns false if it was claimed already.
+	ClaimDelivery(delivery *model.NotificationDelivery, claimedUntilInSec int64) (bool, error)
+
+	// List the latest deliveries of a subscription
+	ListDeliveries(subscriptionId string, limit int) ([]*model.NotificationDelivery, error)
+
+	// Update the status of a delivery after an attempt
+	UpdateDelivery(delivery *model.NotificationDelivery) error
+}
+
+type NotificationStore struct {
+	db   *DB
+	time util.TimeInterface
+	uuid util.UUIDGeneratorInterface
+}
+
+// NewNotificationStore creates a new NotificationStore.
+func NewNotificationStore(db *DB, time util.TimeInterface, uuid util.UUIDGeneratorInterface) *NotificationStore {
+	return &NotificationStore{
+		db:   db,
+		time: time,
+		uuid: uuid,
+	}
+}
+
+func (s *NotificationStore) CreateSubscription(subscription *model.NotificationSubscription) (*model.NotificationSubscription, error) {
+	newSubscription := *subscription
+	id, err := s.uuid.NewRandom()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create a subscription id.")
+	}
+	newSubscription.UUID = id.String()
+	newSubscription.CreatedAtInSec = s.time.Now().Unix()
+
+	sql, args, err := sq.
+		Insert("notification_subscriptions").
+		SetMap(sq.Eq{
+			"UUID":           newSubscription.UUID,
+			"ResourceType":   newSubscription.ResourceType,
+			"ResourceUUID":   newSubscription.ResourceUUID,
+			"States":         newSubscription.States,
+			"URL":            newSubscription.URL,
+			"Secret":         newSubscription.Secret,
+			"CreatedAtInSec": newSubscription.CreatedAtInSec,
+		}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to insert subscription to subscription table: %v",
+			err.Error())
+	}
+	_, err = s.db.Exec(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to add subscription to subscription table: %v",
+			err.Error())
+	}
+	return &newSubscription, nil
+}
+
+func (s *NotificationStore) GetSubscription(id string) (*model.NotificationSubscription, error) {
+	subscriptions, err := s.querySubscriptions(sq.Eq{"UUID": id})
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to get subscription %v", id)
+	}
+	if len(subscriptions) == 0 {
+		return nil, util.NewResourceNotFoundError("NotificationSubscription", id)
+	}
+	return subscriptions[0], nil
+}
+
+func (s *NotificationStore) ListSubscriptions(resourceType model.ResourceType, resourceId string) ([]*model.NotificationSubscription, error) {
+	subscriptions, err := s.querySubscriptions(sq.Eq{"ResourceType": resourceType, "ResourceUUID": resourceId})
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to list the subscriptions of %v %v", resourceType, resourceId)
+	}
+	return subscriptions, nil
+}
+
+func (s *NotificationStore) ListSubscriptionsOfResources(resourceKeys []*common.ReferenceKey) ([]*model.NotificationSubscription, error) {
+	conditions := sq.Or{}
+	for _, key := range resourceKeys {
+		conditions = append(conditions, sq.Eq{"ResourceType": key.Type, "ResourceUUID": key.ID})
+	}
+	if len(conditions) == 0 {
+		return []*model.NotificationSubscription{}, nil
+	}
+	subscriptions, err := s.querySubscriptions(conditions)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list the subscriptions of resources")
+	}
+	return subscriptions, nil
+}
+
+func (s *NotificationStore) querySubscriptions(condition sq.Sqlizer) ([]*model.NotificationSubscription, error) {
+	sql, args, err := sq.
+		Select(notificationSubscriptionColumns...).
+		From("notification_subscriptions").
+		Where(condition).
+		OrderBy("CreatedAtInSec", "UUID").
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to get subscriptions: %v", err.Error())
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get subscriptions: %v", err.Error())
+	}
+	defer rows.Close()
+	subscriptions := []*model.NotificationSubscription{}
+	for rows.Next() {
+		subscription := &model.NotificationSubscription{}
+		err := rows.Scan(&subscription.UUID, &subscription.ResourceType, &subscription.ResourceUUID, &subscription.States,
+			&subscription.URL, &subscription.Secret, &subscription.CreatedAtInSec)
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to scan subscriptions: %v", err.Error())
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, nil
+}
+
+func (s *NotificationStore) DeleteSubscription(id string) error {
+	subscriptionSql, subscriptionArgs, err := sq.Delete("notification_subscriptions").Where(sq.Eq{"UUID": id}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to create query to delete subscription: %s", id)
+	}
+	deliverySql, deliveryArgs, err := sq.Delete("notification_deliveries").Where(sq.Eq{"SubscriptionUUID": id}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to create query to delete the deliveries of subscription: %s", id)
+	}
+
+	// Use a transaction to make sure the deliveries are deleted with the subscription.
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to delete subscription.")
+	}
+	_, err = tx.Exec(deliverySql, deliveryArgs...)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err,
+			"Failed to delete the deliveries of subscription %s from table", id)
+	}
+	_, err = tx.Exec(subscriptionSql, subscriptionArgs...)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err,
+			"Failed to delete subscription %s from table", id)
+	}
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete subscription %s and its deliveries", id)
+	}
+	return nil
+}
+
+func (s *NotificationStore) CreateDelivery(subscriptionId string, runId string, state string) error {
+	sql, args, err := sq.
+		Select("count(*)").
+		From("notification_deliveries").
+		Where(sq.Eq{"SubscriptionUUID": subscriptionId, "RunUUID": runId, "State": state}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to check the deliveries of run %v: %v",
+			runId, err.Error())
+	}
+	var count int
+	if err := s.db.QueryRow(sql, args...).Scan(&count); err != nil {
+		return util.NewInternalServerError(err, "Failed to check the deliveries of run %v: %v", runId, err.Error())
+	}
+	if count > 0 {
+		// The run was already delivered in this state, e.g. when its workflow is
+		// reported again.
+		return nil
+	}
+
+	id, err := s.uuid.NewRandom()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a delivery id.")
+	}
+	now := s.time.Now().Unix()
+	sql, args, err = sq.
+		Insert("notification_deliveries").
+		SetMap(sq.Eq{
+			"UUID":               id.String(),
+			"SubscriptionUUID":   subscriptionId,
+			"RunUUID":            runId,
+			"State":              state,
+			"Status":             model.NotificationDeliveryPending,
+			"Attempts":           0,
+			"ResponseCode":       0,
+			"Error":              "",
+			"NextAttemptAtInSec": now,
+			"CreatedAtInSec":     now,
+			"UpdatedAtInSec":     now,
+		}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to insert delivery to delivery table: %v",
+			err.Error())
+	}
+	_, err = s.db.Exec(sql, args...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to add delivery to delivery table: %v",
+			err.Error())
+	}
+	return nil
+}
+
+func (s *NotificationStore) ListPendingDeliveries(dueAtInSec int64, limit int) ([]*model.NotificationDelivery, error) {
+	sql, args, err := sq.
+		Select(notificationDeliveryColumns...).
+		From("notification_deliveries").
+		Where(sq.And{
+			sq.Eq{"Status": model.NotificationDeliveryPending},
+			sq.LtOrEq{"NextAttemptAtInSec": dueAtInSec},
+		}).
+		OrderBy("NextAttemptAtInSec", "UUID").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list pending deliveries: %v", err.Error())
+	}
+	return s.queryDeliveries(sql, args)
+}
+
+func (s *NotificationStore) ClaimDelivery(delivery *model.NotificationDelivery, claimedUntilInSec int64) (bool, error) {
+	// The delivery is only claimed if it wasn't attempted or claimed since it
+	// was listed, i.e. its next attempt is still the listed one.
+	sql, args, err := sq.
+		Update("notification_deliveries").
+		SetMap(sq.Eq{
+			"NextAttemptAtInSec": claimedUntilInSec,
+			"UpdatedAtInSec":     s.time.Now().Unix(),
+		}).
+		Where(sq.Eq{
+			"UUID":               delivery.UUID,
+			"Status":             model.NotificationDeliveryPending,
+			"NextAttemptAtInSec": delivery.NextAttemptAtInSec,
+		}).
+		ToSql()
+	if err != nil {
+		return false, util.NewInternalServerError(err,
+			"Failed to create query to claim delivery %s. error: '%v'", delivery.UUID, err.Error())
+	}
+	result, err := s.db.Exec(sql, args...)
+	if err != nil {
+		return false, util.NewInternalServerError(err,
+			"Failed to claim delivery %s. error: '%v'", delivery.UUID, err.Error())
+	}
+	r, err := result.RowsAffected()
+	if err != nil {
+		return false, util.NewInternalServerError(err,
+			"Failed to claim delivery %s. error: '%v'", delivery.UUID, err.Error())
+	}
+	if r == 0 {
+		return false, nil
+	}
+	delivery.NextAttemptAtInSec = claimedUntilInSec
+	return true, nil
+}
+
+func (s *NotificationStore) ListDeliveries(subscriptionId string, limit int) ([]*model.NotificationDelivery, error) {
+	sql, args, err := sq.
+		Select(notificationDeliveryColumns...).
+		From("notification_deliveries").
+		Where(sq.Eq{"SubscriptionUUID": subscriptionId}).
+		OrderBy("CreatedAtInSec DESC", "UUID").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list the deliveries of subscription %v: %v",
+			subscriptionId, err.Error())
+	}
+	return s.queryDeliveries(sql, args)
+}
+
+func (s *NotificationStore) queryDeliveries(query string, args []interface{}) ([]*model.NotificationDelivery, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list deliveries: %v", err.Error())
+	}
+	defer rows.Close()
+	return s.scanDeliveryRows(rows)
+}
+
+func (s *NotificationStore) scanDeliveryRows(rows *sql.Rows) ([]*model.NotificationDelivery, error) {
+	deliveries := []*model.NotificationDelivery{}
+	for rows.Next() {
+		d := &model.NotificationDelivery{}
+		err := rows.Scan(&d.UUID, &d.SubscriptionUUID, &d.RunUUID, &d.State, &d.Status, &d.Attempts,
+			&d.ResponseCode, &d.Error, &d.NextAttemptAtInSec, &d.CreatedAtInSec, &d.UpdatedAtInSec)
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to scan deliveries: %v", err.Error())
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, nil
+}
+
+func (s *NotificationStore) UpdateDelivery(delivery *model.NotificationDelivery) error {
+	sql, args, err := sq.
+		Update("notification_deliveries").
+		SetMap(sq.Eq{
+			"Status":             delivery.Status,
+			"Attempts":           delivery.Attempts,
+			"ResponseCode":       delivery.ResponseCode,
+			"Error":              delivery.Error,
+			"NextAttemptAtInSec": delivery.NextAttemptAtInSec,
+			"UpdatedAtInSec":     s.time.Now().Unix(),
+		}).
+		Where(sq.Eq{"UUID": delivery.UUID}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to create query to update delivery %s. error: '%v'", delivery.UUID, err.Error())
+	}
+	_, err = s.db.Exec(sql, args...)
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to update delivery %s. error: '%v'", delivery.UUID, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func initializeNotificationStore() (*DB, *NotificationStore) {
+	db := NewFakeDbOrFatal()
+	return db, NewNotificationStore(db, util.NewFakeTimeForEpoch(), util.NewUUIDGenerator())
+}
+
+func TestNotificationSubscriptions(t *testing.T) {
+	db, store := initializeNotificationStore()
+	defer db.Close()
+
+	subscription, err := store.CreateSubscription(&model.NotificationSubscription{
+		ResourceType: common.Experiment,
+		ResourceUUID: defaultFakeExpId,
+		States:       "Succeeded,Failed",
+		URL:          "http://hook/1",
+		Secret:       "secret",
+	})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, subscription.UUID)
+	_, err = store.CreateSubscription(&model.NotificationSubscription{
+		ResourceType: common.Namespace,
+		ResourceUUID: "ns1",
+		States:       "Error",
+		URL:          "http://hook/2",
+	})
+	assert.Nil(t, err)
+	_, err = store.CreateSubscription(&model.NotificationSubscription{
+		ResourceType: common.Namespace,
+		ResourceUUID: "ns2",
+		States:       "Error",
+		URL:          "http://hook/3",
+	})
+	assert.Nil(t, err)
+
+	stored, err := store.GetSubscription(subscription.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, subscription, stored)
+
+	subscriptions, err := store.ListSubscriptions(common.Experiment, defaultFakeExpId)
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.NotificationSubscription{subscription}, subscriptions)
+
+	subscriptions, err = store.ListSubscriptionsOfResources([]*common.ReferenceKey{
+		{Type: common.Experiment, ID: defaultFakeExpId},
+		{Type: common.Namespace, ID: "ns1"},
+	})
+	assert.Nil(t, err)
+	assert.Len(t, subscriptions, 2)
+	assert.Equal(t, "http://hook/1", subscriptions[0].URL)
+	assert.Equal(t, "http://hook/2", subscriptions[1].URL)
+
+	assert.Nil(t, store.CreateDelivery(subscription.UUID, defaultFakeRunId, "Succeeded"))
+	assert.Nil(t, store.DeleteSubscription(subscription.UUID))
+	_, err = store.GetSubscription(subscription.UUID)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.NotFound))
+	deliveries, err := store.ListDeliveries(subscription.UUID, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, deliveries)
+}
+
+func TestNotificationDeliveries(t *testing.T) {
+	db, store := initializeNotificationStore()
+	defer db.Close()
+
+	assert.Nil(t, store.CreateDelivery("sub1", defaultFakeRunId, "Succeeded"))
+	// Reporting the same state of the run again doesn't create another delivery.
+	assert.Nil(t, store.CreateDelivery("sub1", defaultFakeRunId, "Succeeded"))
+	assert.Nil(t, store.CreateDelivery("sub1", defaultFakeRunIdTwo, "Failed"))
+
+	deliveries, err := store.ListDeliveries("sub1", 10)
+	assert.Nil(t, err)
+	assert.Len(t, deliveries, 2)
+	// The latest delivery is listed first.
+	assert.Equal(t, defaultFakeRunIdTwo, deliveries[0].RunUUID)
+	assert.Equal(t, model.NotificationDeliveryPending, deliveries[0].Status)
+
+	pending, err := store.ListPendingDeliveries(deliveries[0].NextAttemptAtInSec, 10)
+	assert.Nil(t, err)
+	assert.Len(t, pending, 2)
+
+	retried := pending[0]
+	retried.Attempts = 1
+	retried.ResponseCode = 500
+	retried.Error = "Internal Server Error"
+	retried.NextAttemptAtInSec = 1000
+	assert.Nil(t, store.UpdateDelivery(retried))
+	succeeded := pending[1]
+	succeeded.Attempts = 1
+	succeeded.ResponseCode = 200
+	succeeded.Status = model.NotificationDeliverySucceeded
+	assert.Nil(t, store.UpdateDelivery(succeeded))
+
+	pending, err = store.ListPendingDeliveries(999, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, pending)
+	pending, err = store.ListPendingDeliveries(1000, 10)
+	assert.Nil(t, err)
+	assert.Len(t, pending, 1)
+	assert.Equal(t, retried.UUID, pending[0].UUID)
+	assert.Equal(t, int32(1), pending[0].Attempts)
+	assert.Equal(t, int32(500), pending[0].ResponseCode)
+	assert.Equal(t, "Internal Server Error", pending[0].Error)
+}
+
+func TestNotificationDeliveries_Claim(t *testing.T) {
+	db, store := initializeNotificationStore()
+	defer db.Close()
+
+	assert.Nil(t, store.CreateDelivery("sub1", defaultFakeRunId, "Succeeded"))
+	pending, err := store.ListPendingDeliveries(1000, 10)
+	assert.Nil(t, err)
+	assert.Len(t, pending, 1)
+	// Another API server listed the same delivery.
+	listed := *pending[0]
+
+	claimed, err := store.ClaimDelivery(pending[0], 1300)
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+	assert.Equal(t, int64(1300), pending[0].NextAttemptAtInSec)
+	claimed, err = store.ClaimDelivery(&listed, 1300)
+	assert.Nil(t, err)
+	assert.False(t, claimed)
+
+	// The claimed delivery isn't due until the claim expires.
+	pending, err = store.ListPendingDeliveries(1299, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, pending)
+	pending, err = store.ListPendingDeliveries(1300, 10)
+	assert.Nil(t, err)
+	assert.Len(t, pending, 1)
+}
@@ -17,12 +17,15 @@ package storage
 import (
 	"database/sql"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 
 	sq "github.com/Masterminds/squirrel"
 	workflowapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/golang/glog"
+	"github.com/google/uuid"
 
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
@@ -65,12 +68,55 @@ type RunStoreInterface interface {
 
 	// Terminate a run
 	TerminateRun(runId string) error
+
+	// List the IDs of the finished runs selected by a retention policy
+	ListExpiredRunIDs(filter *ExpiredRunsFilter, afterRunId string, limit int) ([]string, error)
+
+	// Get the creation time of the run of a job at the offset, newest first
+	GetJobRunCreatedAtInSec(jobId string, offset int) (int64, error)
+
+	// Watch the runs that are created, or whose condition or metrics change.
+	// The channel is closed when the watch is stopped or falls behind.
+	WatchRunUpdates() (updates <-chan model.RunUpdate, stop func())
+
+	// Notify the watchers of the runs updated by the other API servers since
+	// the last poll. Returns the number of updates polled.
+	PollRunUpdates() (int, error)
+}
+
+// ExpiredRunsFilter selects the finished runs that a retention policy applies
+// to. Empty fields don't restrict the selection.
+type ExpiredRunsFilter struct {
+	// Only runs in this storage state are selected.
+	StorageState string
+	// Only runs created before this time are selected.
+	CreatedBeforeInSec int64
+	// Only runs of this experiment are selected.
+	ExperimentUUID string
+	// Only runs in this namespace are selected.
+	Namespace string
+	// Only runs created by this job are selected.
+	JobUUID string
+	// Runs of these experiments are skipped, as they have policies of their own.
+	ExcludedExperimentUUIDs []string
+	// Runs in these namespaces are skipped, as they have policies of their own.
+	ExcludedNamespaces []string
 }
 
 type RunStore struct {
 	db                     *DB
 	resourceReferenceStore *ResourceReferenceStore
 	time                   util.TimeInterface
+	updates                *runUpdateBroadcaster
+	// Identifies the run updates made through this run store.
+	updateSource string
+	// The ID of the last run update polled, or -1 before the first poll.
+	lastPolledUpdateId int64
+	// The IDs below the last polled one that weren't polled yet, and when they
+	// were skipped. IDs are assigned when the updates are inserted, so an
+	// update may be committed after the updates with higher IDs are polled.
+	skippedUpdateIds map[int64]time.Time
+	pollMutex        sync.Mutex
 }
 
 // Runs two SQL queries in a transaction to return a list of matching runs, as well as their
@@ -419,20 +465,65 @@ func (s *RunStore) CreateRun(r *model.RunDetail) (*model.RunDetail, error) {
 		tx.Rollback()
 		return nil, util.NewInternalServerError(err, "Failed to store resource references to table for run %v ", r.Name)
 	}
+	err = s.createRunParameters(tx, r)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
 		return nil, util.NewInternalServerError(err, "Failed to store run %v and its resource references to table", r.Name)
 	}
+	s.publishUpdate(model.RunUpdate{RunUUID: r.UUID, Namespace: r.Namespace, ExperimentUUID: r.ExperimentUUID})
 	return r, nil
 }
 
+// createRunParameters stores the v1 parameters and the v2 runtime parameters of
+// the run to the run_parameters table, so that runs can be filtered by them.
+// Runs with malformed parameters are stored without them.
+func (s *RunStore) createRunParameters(tx *sql.Tx, r *model.RunDetail) error {
+	params, err := r.PipelineSpec.RunParameters(r.UUID)
+	if err != nil {
+		glog.Warningf("Failed to parse the parameters of run %v, so they can't be filtered on: %v", r.UUID, err)
+		return nil
+	}
+	if len(params) == 0 {
+		return nil
+	}
+	sqlBuilder := sq.Insert("run_parameters").Columns("RunUUID", "Name", "Value")
+	for _, param := range params {
+		sqlBuilder = sqlBuilder.Values(param.RunUUID, param.Name, param.Value)
+	}
+	paramSql, paramArgs, err := sqlBuilder.ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to store parameters of run %v", r.Name)
+	}
+	_, err = tx.Exec(paramSql, paramArgs...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to store parameters of run %v to table", r.Name)
+	}
+	return nil
+}
+
 func (s *RunStore) UpdateRun(runID string, condition string, finishedAtInSec int64, workflowRuntimeManifest string) (err error) {
 	tx, err := s.db.DB.Begin()
 	if err != nil {
 		return util.NewInternalServerError(err, "transaction creation failed")
 	}
 
+	// The previous condition is read so that the watchers of the run are only
+	// notified when it changes.
+	var previousCondition string
+	update := model.RunUpdate{RunUUID: runID}
+	err = tx.QueryRow("SELECT Conditions, Namespace, ExperimentUUID FROM run_details WHERE UUID = ?", runID).
+		Scan(&previousCondition, &update.Namespace, &update.ExperimentUUID)
+	if err != nil && err != sql.ErrNoRows {
+		tx.Rollback()
+		return util.NewInternalServerError(err,
+			"Failed to get the condition of run %s. error: '%v'", runID, err.Error())
+	}
+
 	sql, args, err := sq.
 		Update("run_details").
 		SetMap(sq.Eq{
@@ -469,6 +560,9 @@ func (s *RunStore) UpdateRun(runID string, condition string, finishedAtInSec int
 	if err := tx.Commit(); err != nil {
 		return util.NewInternalServerError(err, "failed to commit transaction for run %s", runID)
 	}
+	if condition != previousCondition {
+		s.publishUpdate(update)
+	}
 	return nil
 }
 
@@ -510,6 +604,95 @@ func (s *RunStore) ArchiveRun(runId string) error {
 	return nil
 }
 
+// ListExpiredRunIDs returns the IDs of at most limit finished runs selected by
+// the filter, ordered by ID and starting after the given run ID, so that the
+// runs can be processed a chunk at a time.
+func (s *RunStore) ListExpiredRunIDs(filter *ExpiredRunsFilter, afterRunId string, limit int) ([]string, error) {
+	conditions := sq.And{
+		sq.Gt{"FinishedAtInSec": 0},
+		sq.Lt{"CreatedAtInSec": filter.CreatedBeforeInSec},
+		sq.Gt{"UUID": afterRunId},
+	}
+	if filter.StorageState != "" {
+		conditions = append(conditions, sq.Eq{"StorageState": filter.StorageState})
+	}
+	if filter.ExperimentUUID != "" {
+		conditions = append(conditions, sq.Eq{"ExperimentUUID": filter.ExperimentUUID})
+	}
+	if filter.Namespace != "" {
+		conditions = append(conditions, sq.Eq{"Namespace": filter.Namespace})
+	}
+	if len(filter.ExcludedExperimentUUIDs) > 0 {
+		conditions = append(conditions, sq.NotEq{"ExperimentUUID": filter.ExcludedExperimentUUIDs})
+	}
+	if len(filter.ExcludedNamespaces) > 0 {
+		conditions = append(conditions, sq.NotEq{"Namespace": filter.ExcludedNamespaces})
+	}
+	selectBuilder := sq.Select("UUID").From("run_details").Where(conditions)
+	if filter.JobUUID != "" {
+		selectBuilder = selectBuilder.Where(
+			"UUID IN (SELECT ResourceUUID FROM resource_references WHERE ResourceType = ? AND ReferenceType = ? AND ReferenceUUID = ?)",
+			common.Run, common.Job, filter.JobUUID)
+	}
+	sql, args, err := selectBuilder.OrderBy("UUID").Limit(uint64(limit)).ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err,
+			"Failed to create query to list expired runs. error: '%v'", err.Error())
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err,
+			"Failed to list expired runs. error: '%v'", err.Error())
+	}
+	defer rows.Close()
+	runIds := []string{}
+	for rows.Next() {
+		var runId string
+		if err := rows.Scan(&runId); err != nil {
+			return nil, util.NewInternalServerError(err,
+				"Failed to scan expired runs. error: '%v'", err.Error())
+		}
+		runIds = append(runIds, runId)
+	}
+	return runIds, nil
+}
+
+// GetJobRunCreatedAtInSec returns the creation time of the run of the job at
+// the offset, ordered from the newest run, or 0 if the job has fewer runs.
+func (s *RunStore) GetJobRunCreatedAtInSec(jobId string, offset int) (int64, error) {
+	sql, args, err := sq.
+		Select("run_details.CreatedAtInSec").
+		From("run_details").
+		Join("resource_references AS rf ON rf.ResourceUUID = run_details.UUID").
+		Where(sq.Eq{
+			"rf.ResourceType":  common.Run,
+			"rf.ReferenceType": common.Job,
+			"rf.ReferenceUUID": jobId,
+		}).
+		OrderBy("run_details.CreatedAtInSec DESC").
+		Limit(1).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return 0, util.NewInternalServerError(err,
+			"Failed to create query to get the runs of job %s. error: '%v'", jobId, err.Error())
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return 0, util.NewInternalServerError(err,
+			"Failed to get the runs of job %s. error: '%v'", jobId, err.Error())
+	}
+	defer rows.Close()
+	var createdAtInSec int64
+	if rows.Next() {
+		if err := rows.Scan(&createdAtInSec); err != nil {
+			return 0, util.NewInternalServerError(err,
+				"Failed to scan the runs of job %s. error: '%v'", jobId, err.Error())
+		}
+	}
+	return createdAtInSec, nil
+}
+
 func (s *RunStore) UnarchiveRun(runId string) error {
 	sql, args, err := sq.
 		Update("run_details").
@@ -591,6 +774,7 @@ func (s *RunStore) ReportMetric(metric *model.RunMetric) (err error) {
 		}
 		return util.NewInternalServerError(err, "failed to insert metric: %v", metric)
 	}
+	s.publishUpdateOfRun(metric.RunUUID)
 	return nil
 }
 
@@ -616,7 +800,137 @@ func NewRunStore(db *DB, time util.TimeInterface) *RunStore {
 		db:                     db,
 		resourceReferenceStore: NewResourceReferenceStore(db),
 		time:                   time,
+		updates:                newRunUpdateBroadcaster(),
+		updateSource:           uuid.New().String(),
+		lastPolledUpdateId:     -1,
+	}
+}
+
+func (s *RunStore) WatchRunUpdates() (<-chan model.RunUpdate, func()) {
+	return s.updates.watch()
+}
+
+// publishUpdateOfRun publishes the update of the run, after reading its
+// namespace and experiment.
+func (s *RunStore) publishUpdateOfRun(runId string) {
+	update := model.RunUpdate{RunUUID: runId}
+	err := s.db.QueryRow("SELECT Namespace, ExperimentUUID FROM run_details WHERE UUID = ?", runId).
+		Scan(&update.Namespace, &update.ExperimentUUID)
+	if err != nil {
+		glog.Warningf("Failed to get the namespace and the experiment of the updated run %v: %v", runId, err)
+	}
+	s.publishUpdate(update)
+}
+
+// publishUpdate notifies the watchers of this API server of the run update,
+// and records it for the other API servers to poll. The run is already
+// updated, so failing to record the update is only logged.
+func (s *RunStore) publishUpdate(update model.RunUpdate) {
+	update.Source = s.updateSource
+	s.updates.publish(update)
+	sql, args, err := sq.
+		Insert("run_updates").
+		SetMap(sq.Eq{
+			"RunUUID":        update.RunUUID,
+			"Namespace":      update.Namespace,
+			"ExperimentUUID": update.ExperimentUUID,
+			"Source":         update.Source}).
+		ToSql()
+	if err == nil {
+		_, err = s.db.Exec(sql, args...)
+	}
+	if err != nil {
+		glog.Warningf("Failed to record the update of run %v for the other API servers: %v", update.RunUUID, err)
+	}
+}
+
+func (s *RunStore) PollRunUpdates() (int, error) {
+	s.pollMutex.Lock()
+	defer s.pollMutex.Unlock()
+	if s.lastPolledUpdateId < 0 {
+		// The updates made before the first poll aren't watched by anyone.
+		var lastUpdateId sql.NullInt64
+		if err := s.db.QueryRow("SELECT MAX(ID) FROM run_updates").Scan(&lastUpdateId); err != nil {
+			return 0, util.NewInternalServerError(err, "Failed to get the last run update: %v", err.Error())
+		}
+		s.lastPolledUpdateId = lastUpdateId.Int64
+		s.skippedUpdateIds = map[int64]time.Time{}
+		return 0, nil
 	}
+
+	now := s.time.Now()
+	for id, skippedAt := range s.skippedUpdateIds {
+		// The skipped IDs that aren't committed by now were rolled back or
+		// never used.
+		if now.Sub(skippedAt) > runUpdateSkipTimeout {
+			delete(s.skippedUpdateIds, id)
+		}
+	}
+	newUpdates := sq.Gt{"ID": s.lastPolledUpdateId}
+	var where sq.Sqlizer = newUpdates
+	if len(s.skippedUpdateIds) > 0 {
+		skippedIds := make([]int64, 0, len(s.skippedUpdateIds))
+		for id := range s.skippedUpdateIds {
+			skippedIds = append(skippedIds, id)
+		}
+		where = sq.Or{newUpdates, sq.Eq{"ID": skippedIds}}
+	}
+	sql, args, err := sq.
+		Select("ID", "RunUUID", "Namespace", "ExperimentUUID", "Source").
+		From("run_updates").
+		Where(where).
+		OrderBy("ID").
+		Limit(runUpdatePollSize).
+		ToSql()
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to create query to poll run updates: %v", err.Error())
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to poll run updates: %v", err.Error())
+	}
+	defer rows.Close()
+	count := 0
+	for rows.Next() {
+		var update model.RunUpdate
+		if err := rows.Scan(&update.ID, &update.RunUUID, &update.Namespace, &update.ExperimentUUID, &update.Source); err != nil {
+			return count, util.NewInternalServerError(err, "Failed to scan run updates: %v", err.Error())
+		}
+		// The watchers were notified of the updates made through this run store
+		// when they were made.
+		if update.Source != s.updateSource {
+			s.updates.publish(update)
+		}
+		if _, ok := s.skippedUpdateIds[update.ID]; ok {
+			delete(s.skippedUpdateIds, update.ID)
+		} else {
+			for id := s.lastPolledUpdateId + 1; id < update.ID && len(s.skippedUpdateIds) < runUpdatePollSize; id++ {
+				s.skippedUpdateIds[id] = now
+			}
+			s.lastPolledUpdateId = update.ID
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return count, util.NewInternalServerError(err, "Failed to poll run updates: %v", err.Error())
+	}
+	if count == 0 {
+		return 0, nil
+	}
+
+	// Only the latest updates are kept, since they are polled by every API
+	// server shortly after they are made.
+	sql, args, err = sq.
+		Delete("run_updates").
+		Where(sq.LtOrEq{"ID": s.lastPolledUpdateId - runUpdateRetentionSize}).
+		ToSql()
+	if err != nil {
+		return count, util.NewInternalServerError(err, "Failed to create query to delete old run updates: %v", err.Error())
+	}
+	if _, err := s.db.Exec(sql, args...); err != nil {
+		return count, util.NewInternalServerError(err, "Failed to delete old run updates: %v", err.Error())
+	}
+	return count, nil
 }
 
 func (s *RunStore) TerminateRun(runId string) error {
@@ -635,17 +949,25 @@ func (s *RunStore) TerminateRun(runId string) error {
 		return util.NewInvalidInputError("Failed to terminate run %s. Row not found.", runId)
 	}
 
+	s.publishUpdateOfRun(runId)
 	return nil
 }
 
 // Add a metric as a new field to the select clause by join the passed-in SQL query with run_metrics table.
 // With the metric as a field in the select clause enable sorting on this metric afterwards.
+// Parameters are added the same way by joining the run_parameters table.
 // TODO(jingzhang36): example of resulting SQL query and explanation for it.
 func (s *RunStore) AddSortByRunMetricToSelect(sqlBuilder sq.SelectBuilder, opts *list.Options) sq.SelectBuilder {
 	var r model.Run
 	if r.IsRegularField(opts.SortByFieldName) {
 		return sqlBuilder
 	}
+	if parameterName, ok := model.GetRunParameterName(opts.SortByFieldName); ok {
+		return sq.
+			Select("selected_runs.*, run_parameters.Value as "+opts.SortByFieldName).
+			FromSelect(sqlBuilder, "selected_runs").
+			LeftJoin("run_parameters ON selected_runs.UUID=run_parameters.RunUUID AND run_parameters.Name=?", parameterName)
+	}
 	// TODO(jingzhang36): address the case where runs doesn't have the specified metric.
 	return sq.
 		Select("selected_runs.*, run_metrics.numbervalue as "+opts.SortByFieldName).
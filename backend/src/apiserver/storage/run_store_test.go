@@ -369,6 +369,131 @@ func TestListRuns_Pagination_WithSortingOnMetrics(t *testing.T) {
 	assert.Empty(t, nextPageToken)
 }
 
+func initializeRunStoreWithParameters() (*DB, *RunStore) {
+	db := NewFakeDbOrFatal()
+	expStore := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(defaultFakeExpId, nil))
+	expStore.CreateExperiment(&model.Experiment{Name: "exp1"})
+	runStore := NewRunStore(db, util.NewFakeTimeForEpoch())
+
+	newRun := func(uuid string, createdAtInSec int64, spec model.PipelineSpec, accuracy float64) {
+		runStore.CreateRun(&model.RunDetail{
+			Run: model.Run{
+				UUID:           uuid,
+				ExperimentUUID: defaultFakeExpId,
+				Name:           "run" + uuid,
+				DisplayName:    "run" + uuid,
+				Namespace:      "n1",
+				CreatedAtInSec: createdAtInSec,
+				Conditions:     "Succeeded",
+				PipelineSpec:   spec,
+			},
+			PipelineRuntime: model.PipelineRuntime{WorkflowRuntimeManifest: "workflow" + uuid},
+		})
+		runStore.ReportMetric(&model.RunMetric{
+			RunUUID:     uuid,
+			NodeID:      "node1",
+			Name:        "accuracy",
+			NumberValue: accuracy,
+		})
+	}
+	// v1 runs store parameters in the Parameters field and v2 runs in the RuntimeConfig.
+	newRun("1", 1, model.PipelineSpec{Parameters: `[{"name":"learning_rate","value":"0.01"},{"name":"epochs","value":"10"}]`}, 0.95)
+	newRun("2", 2, model.PipelineSpec{RuntimeConfig: model.RuntimeConfig{Parameters: `{"learning_rate":0.01,"epochs":20}`}}, 0.8)
+	newRun("3", 3, model.PipelineSpec{Parameters: `[{"name":"learning_rate","value":"0.1"}]`}, 0.99)
+	return db, runStore
+}
+
+func TestListRuns_FilterByParametersAndMetrics(t *testing.T) {
+	db, runStore := initializeRunStoreWithParameters()
+	defer db.Close()
+
+	tests := []struct {
+		name    string
+		filter  *api.Filter
+		wantIds []string
+	}{
+		{
+			name: "parameter",
+			filter: &api.Filter{Predicates: []*api.Predicate{
+				{Key: "parameter:learning_rate", Op: api.Predicate_EQUALS, Value: &api.Predicate_StringValue{StringValue: "0.01"}},
+			}},
+			wantIds: []string{"1", "2"},
+		},
+		{
+			name: "parameter and metric",
+			filter: &api.Filter{Predicates: []*api.Predicate{
+				{Key: "parameter:learning_rate", Op: api.Predicate_EQUALS, Value: &api.Predicate_StringValue{StringValue: "0.01"}},
+				{Key: "metric:accuracy", Op: api.Predicate_GREATER_THAN, Value: &api.Predicate_StringValue{StringValue: "0.9"}},
+			}},
+			wantIds: []string{"1"},
+		},
+		{
+			name: "metric",
+			filter: &api.Filter{Predicates: []*api.Predicate{
+				{Key: "metric:accuracy", Op: api.Predicate_GREATER_THAN_EQUALS, Value: &api.Predicate_StringValue{StringValue: "0.95"}},
+			}},
+			wantIds: []string{"1", "3"},
+		},
+		{
+			name: "missing parameter",
+			filter: &api.Filter{Predicates: []*api.Predicate{
+				{Key: "parameter:epochs", Op: api.Predicate_IS_NULL},
+			}},
+			wantIds: []string{"3"},
+		},
+		{
+			name: "v2 parameter",
+			filter: &api.Filter{Predicates: []*api.Predicate{
+				{Key: "parameter:epochs", Op: api.Predicate_EQUALS, Value: &api.Predicate_StringValue{StringValue: "20"}},
+			}},
+			wantIds: []string{"2"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			opts, err := list.NewOptions(&model.Run{}, 10, "", tc.filter)
+			assert.Nil(t, err)
+			runs, totalSize, _, err := runStore.ListRuns(&common.FilterContext{}, opts)
+			assert.Nil(t, err)
+			assert.Equal(t, len(tc.wantIds), totalSize)
+			var ids []string
+			for _, run := range runs {
+				ids = append(ids, run.UUID)
+			}
+			assert.Equal(t, tc.wantIds, ids)
+		})
+	}
+}
+
+func TestListRuns_Pagination_WithSortingOnParameters(t *testing.T) {
+	db, runStore := initializeRunStoreWithParameters()
+	defer db.Close()
+
+	opts, err := list.NewOptions(&model.Run{}, 1, "parameter:epochs desc", nil)
+	assert.Nil(t, err)
+	runs, totalSize, nextPageToken, err := runStore.ListRuns(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, totalSize)
+	assert.Len(t, runs, 1)
+	assert.Equal(t, "2", runs[0].UUID)
+	assert.NotEmpty(t, nextPageToken)
+
+	opts, err = list.NewOptionsFromToken(nextPageToken, 1)
+	assert.Nil(t, err)
+	runs, _, _, err = runStore.ListRuns(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Len(t, runs, 1)
+	assert.Equal(t, "1", runs[0].UUID)
+}
+
+func TestListRuns_FilterByInvalidParameterName(t *testing.T) {
+	_, err := list.NewOptions(&model.Run{}, 10, "", &api.Filter{Predicates: []*api.Predicate{
+		{Key: "parameter:name'; DROP TABLE run_details; --", Op: api.Predicate_IS_NULL},
+	}})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "unrecognized field")
+}
+
 func TestListRuns_TotalSizeWithNoFilter(t *testing.T) {
 	db, runStore := initializeRunStore()
 	defer db.Close()
@@ -916,6 +1041,140 @@ func TestUpdateRun_RunNotExist(t *testing.T) {
 	assert.Contains(t, err.Error(), "not found")
 }
 
+func TestWatchRunUpdates(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	updates, stop := runStore.WatchRunUpdates()
+	// The watchers are only notified when the condition changes.
+	assert.Nil(t, runStore.UpdateRun("1", "Running", 0, "workflow1"))
+	assert.Nil(t, runStore.UpdateRun("1", "Succeeded", 1, "workflow1"))
+	assert.Nil(t, runStore.ReportMetric(&model.RunMetric{RunUUID: "2", NodeID: "node1", Name: "accuracy", NumberValue: 1}))
+	assert.Equal(t, "1", (<-updates).RunUUID)
+	assert.Equal(t, "2", (<-updates).RunUUID)
+	assert.Empty(t, updates)
+
+	stop()
+	_, ok := <-updates
+	assert.False(t, ok)
+}
+
+func TestWatchRunUpdates_CreateRun(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	updates, stop := runStore.WatchRunUpdates()
+	defer stop()
+	_, err := runStore.CreateRun(&model.RunDetail{Run: model.Run{
+		UUID:           "1000",
+		ExperimentUUID: defaultFakeExpId,
+		Name:           "run1000",
+		DisplayName:    "run1000",
+		Namespace:      "n1",
+		Conditions:     "Pending",
+	}})
+	assert.Nil(t, err)
+	update := <-updates
+	assert.Equal(t, "1000", update.RunUUID)
+	assert.Equal(t, "n1", update.Namespace)
+	assert.Equal(t, defaultFakeExpId, update.ExperimentUUID)
+	assert.Empty(t, updates)
+}
+
+func TestPollRunUpdates(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+	// The run store of another API server.
+	otherRunStore := NewRunStore(db, util.NewFakeTimeForEpoch())
+
+	// The updates made before the first poll are skipped.
+	assert.Nil(t, otherRunStore.UpdateRun("1", "Running", 0, "workflow1"))
+	count, err := runStore.PollRunUpdates()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+
+	updates, stop := runStore.WatchRunUpdates()
+	defer stop()
+	assert.Nil(t, otherRunStore.UpdateRun("1", "Succeeded", 1, "workflow1"))
+	assert.Nil(t, otherRunStore.ReportMetric(&model.RunMetric{RunUUID: "2", NodeID: "node1", Name: "accuracy", NumberValue: 1}))
+	assert.Empty(t, updates)
+	count, err = runStore.PollRunUpdates()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+	// The updates are polled with the namespace and the experiment of the runs.
+	update := <-updates
+	assert.Equal(t, "1", update.RunUUID)
+	assert.Equal(t, "n1", update.Namespace)
+	assert.Equal(t, defaultFakeExpId, update.ExperimentUUID)
+	update = <-updates
+	assert.Equal(t, "2", update.RunUUID)
+	assert.Equal(t, "n2", update.Namespace)
+	assert.Equal(t, defaultFakeExpId, update.ExperimentUUID)
+	assert.Empty(t, updates)
+
+	// The updates made through the run store aren't broadcast again.
+	assert.Nil(t, runStore.UpdateRun("2", "Running", 1, "workflow2"))
+	assert.Equal(t, "2", (<-updates).RunUUID)
+	count, err = runStore.PollRunUpdates()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	assert.Empty(t, updates)
+	count, err = runStore.PollRunUpdates()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestPollRunUpdates_CommittedOutOfOrder(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+	count, err := runStore.PollRunUpdates()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+	var lastUpdateId int64
+	assert.Nil(t, db.QueryRow("SELECT MAX(ID) FROM run_updates").Scan(&lastUpdateId))
+	insertUpdate := func(id int64, runId string) {
+		_, err := db.Exec("INSERT INTO run_updates (ID, RunUUID, Namespace, ExperimentUUID, Source) VALUES (?, ?, ?, ?, ?)",
+			id, runId, "n1", defaultFakeExpId, "another-server")
+		assert.Nil(t, err)
+	}
+
+	updates, stop := runStore.WatchRunUpdates()
+	defer stop()
+	// The update with the higher ID is committed first.
+	insertUpdate(lastUpdateId+2, "2")
+	count, err = runStore.PollRunUpdates()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, "2", (<-updates).RunUUID)
+
+	// The update with the lower ID is still polled once it is committed.
+	insertUpdate(lastUpdateId+1, "1")
+	count, err = runStore.PollRunUpdates()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, "1", (<-updates).RunUUID)
+	count, err = runStore.PollRunUpdates()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+	assert.Empty(t, updates)
+}
+
+func TestWatchRunUpdates_WatcherFallsBehind(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	updates, stop := runStore.WatchRunUpdates()
+	defer stop()
+	for i := 0; i <= runUpdateBufferSize; i++ {
+		assert.Nil(t, runStore.ReportMetric(&model.RunMetric{RunUUID: "1", NodeID: "node1", Name: fmt.Sprintf("m%v", i)}))
+	}
+	count := 0
+	for range updates {
+		count++
+	}
+	assert.Equal(t, runUpdateBufferSize, count)
+}
+
 func TestTerminateRun(t *testing.T) {
 	db, runStore := initializeRunStore()
 	defer db.Close()
@@ -1378,3 +1637,149 @@ func TestParseResourceReferences(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, expectedResourceReferences, actualResourceReferences)
 }
+
+func initializeRunStoreForRetention() (*DB, *RunStore) {
+	db := NewFakeDbOrFatal()
+	expStore := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(defaultFakeExpId, nil))
+	expStore.CreateExperiment(&model.Experiment{Name: "exp1"})
+	expStore = NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(defaultFakeExpIdTwo, nil))
+	expStore.CreateExperiment(&model.Experiment{Name: "exp2"})
+	jobStore := NewJobStore(db, util.NewFakeTimeForEpoch())
+	jobStore.CreateJob(&model.Job{
+		UUID:       "job1",
+		Name:       "j1",
+		Namespace:  "n1",
+		Conditions: "ready",
+		ResourceReferences: []*model.ResourceReference{
+			{
+				ResourceUUID: "job1", ResourceType: common.Job,
+				ReferenceUUID: defaultFakeExpId, ReferenceName: "e", ReferenceType: common.Experiment,
+				Relationship: common.Owner,
+			},
+		},
+	})
+	runStore := NewRunStore(db, util.NewFakeTimeForEpoch())
+	runs := []struct {
+		uuid            string
+		experimentUUID  string
+		namespace       string
+		storageState    api.Run_StorageState
+		createdAtInSec  int64
+		finishedAtInSec int64
+	}{
+		{"1", defaultFakeExpId, "n1", api.Run_STORAGESTATE_AVAILABLE, 1, 2},
+		{"2", defaultFakeExpId, "n1", api.Run_STORAGESTATE_ARCHIVED, 2, 3},
+		{"3", defaultFakeExpIdTwo, "n2", api.Run_STORAGESTATE_AVAILABLE, 3, 0},
+		{"4", defaultFakeExpIdTwo, "n2", api.Run_STORAGESTATE_AVAILABLE, 4, 5},
+	}
+	for _, r := range runs {
+		references := []*model.ResourceReference{
+			{
+				ResourceUUID: r.uuid, ResourceType: common.Run,
+				ReferenceUUID: r.experimentUUID, ReferenceName: "e",
+				ReferenceType: common.Experiment, Relationship: common.Owner,
+			},
+		}
+		if r.uuid != "4" {
+			references = append(references, &model.ResourceReference{
+				ResourceUUID: r.uuid, ResourceType: common.Run,
+				ReferenceUUID: "job1", ReferenceName: "j1",
+				ReferenceType: common.Job, Relationship: common.Creator,
+			})
+		}
+		runStore.CreateRun(&model.RunDetail{
+			Run: model.Run{
+				UUID:               r.uuid,
+				ExperimentUUID:     r.experimentUUID,
+				Name:               "run" + r.uuid,
+				DisplayName:        "run" + r.uuid,
+				StorageState:       r.storageState.String(),
+				Namespace:          r.namespace,
+				CreatedAtInSec:     r.createdAtInSec,
+				FinishedAtInSec:    r.finishedAtInSec,
+				Conditions:         "done",
+				ResourceReferences: references,
+			},
+		})
+	}
+	return db, runStore
+}
+
+func TestListExpiredRunIDs(t *testing.T) {
+	db, runStore := initializeRunStoreForRetention()
+	defer db.Close()
+
+	tests := []struct {
+		name           string
+		filter         *ExpiredRunsFilter
+		expectedRunIds []string
+	}{
+		{
+			"Finished runs created before",
+			&ExpiredRunsFilter{CreatedBeforeInSec: 5},
+			[]string{"1", "2", "4"},
+		},
+		{
+			"Storage state",
+			&ExpiredRunsFilter{CreatedBeforeInSec: 5, StorageState: api.Run_STORAGESTATE_AVAILABLE.String()},
+			[]string{"1", "4"},
+		},
+		{
+			"Experiment",
+			&ExpiredRunsFilter{CreatedBeforeInSec: 5, ExperimentUUID: defaultFakeExpIdTwo},
+			[]string{"4"},
+		},
+		{
+			"Namespace",
+			&ExpiredRunsFilter{CreatedBeforeInSec: 2, Namespace: "n1"},
+			[]string{"1"},
+		},
+		{
+			"Job",
+			&ExpiredRunsFilter{CreatedBeforeInSec: 5, JobUUID: "job1"},
+			[]string{"1", "2"},
+		},
+		{
+			"Excluded experiments and namespaces",
+			&ExpiredRunsFilter{
+				CreatedBeforeInSec:      5,
+				ExcludedExperimentUUIDs: []string{defaultFakeExpIdTwo},
+				ExcludedNamespaces:      []string{"n3"},
+			},
+			[]string{"1", "2"},
+		},
+		{
+			"Excluded namespaces",
+			&ExpiredRunsFilter{CreatedBeforeInSec: 5, ExcludedNamespaces: []string{"n1"}},
+			[]string{"4"},
+		},
+	}
+	for _, tc := range tests {
+		runIds, err := runStore.ListExpiredRunIDs(tc.filter, "", 10)
+		assert.Nil(t, err, tc.name)
+		assert.Equal(t, tc.expectedRunIds, runIds, tc.name)
+	}
+
+	// Runs are listed a chunk at a time.
+	filter := &ExpiredRunsFilter{CreatedBeforeInSec: 5}
+	runIds, err := runStore.ListExpiredRunIDs(filter, "", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1", "2"}, runIds)
+	runIds, err = runStore.ListExpiredRunIDs(filter, "2", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"4"}, runIds)
+}
+
+func TestGetJobRunCreatedAtInSec(t *testing.T) {
+	db, runStore := initializeRunStoreForRetention()
+	defer db.Close()
+
+	for offset, expectedCreatedAtInSec := range []int64{3, 2, 1, 0} {
+		createdAtInSec, err := runStore.GetJobRunCreatedAtInSec("job1", offset)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedCreatedAtInSec, createdAtInSec)
+	}
+	createdAtInSec, err := runStore.GetJobRunCreatedAtInSec("unknown-job", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), createdAtInSec)
+}
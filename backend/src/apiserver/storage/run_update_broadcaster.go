@@ -0,0 +1,82 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+)
+
+const (
+	// The number of run updates buffered for a watcher before it is dropped.
+	runUpdateBufferSize = 100
+	// The maximum number of run updates polled at a time.
+	runUpdatePollSize = 1000
+	// The number of latest run updates kept for the API servers to poll.
+	runUpdateRetentionSize = 10000
+	// How long the skipped run updates are polled for until they are committed.
+	runUpdateSkipTimeout = time.Minute
+)
+
+// runUpdateBroadcaster fans out the updates of the runs to the watchers of
+// this API server. The updates made through the other API servers are
+// broadcast when the run store polls them.
+type runUpdateBroadcaster struct {
+	mu       sync.Mutex
+	watchers map[chan model.RunUpdate]bool
+}
+
+func newRunUpdateBroadcaster() *runUpdateBroadcaster {
+	return &runUpdateBroadcaster{watchers: map[chan model.RunUpdate]bool{}}
+}
+
+// watch returns a channel receiving the updates of the runs, and a function
+// that stops the watch. The channel is closed when the watch is stopped, or
+// when the watcher falls behind and updates would be lost.
+func (b *runUpdateBroadcaster) watch() (<-chan model.RunUpdate, func()) {
+	updates := make(chan model.RunUpdate, runUpdateBufferSize)
+	b.mu.Lock()
+	b.watchers[updates] = true
+	b.mu.Unlock()
+	return updates, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.remove(updates)
+	}
+}
+
+func (b *runUpdateBroadcaster) publish(update model.RunUpdate) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for updates := range b.watchers {
+		select {
+		case updates <- update:
+		default:
+			glog.Warningf("Dropping a run watcher that fell behind by %v updates", runUpdateBufferSize)
+			b.remove(updates)
+		}
+	}
+}
+
+// remove must be called with the lock held.
+func (b *runUpdateBroadcaster) remove(updates chan model.RunUpdate) {
+	if b.watchers[updates] {
+		delete(b.watchers, updates)
+		close(updates)
+	}
+}
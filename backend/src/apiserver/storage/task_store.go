@@ -33,6 +33,10 @@ type TaskStoreInterface interface {
 	ListTasks(filterContext *common.FilterContext, opts *list.Options) ([]*model.Task, int, string, error)
 
 	GetTask(id string) (*model.Task, error)
+
+	// Delete the tasks matching all the non-empty conditions and return the
+	// number of deleted tasks. createdBeforeInSec is ignored when it is 0.
+	DeleteTasks(fingerprint, pipelineName, namespace string, createdBeforeInSec int64) (int64, error)
 }
 
 type TaskStore struct {
@@ -208,3 +212,35 @@ func (s *TaskStore) GetTask(id string) (*model.Task, error) {
 	}
 	return tasks[0], nil
 }
+
+func (s *TaskStore) DeleteTasks(fingerprint, pipelineName, namespace string, createdBeforeInSec int64) (int64, error) {
+	conditions := sq.And{}
+	if fingerprint != "" {
+		conditions = append(conditions, sq.Eq{"Fingerprint": fingerprint})
+	}
+	if pipelineName != "" {
+		conditions = append(conditions, sq.Eq{"PipelineName": pipelineName})
+	}
+	if namespace != "" {
+		conditions = append(conditions, sq.Eq{"Namespace": namespace})
+	}
+	if createdBeforeInSec != 0 {
+		conditions = append(conditions, sq.Lt{"CreatedTimestamp": createdBeforeInSec})
+	}
+	if len(conditions) == 0 {
+		return 0, util.NewInvalidInputError("Failed to delete tasks: at least one condition must be specified")
+	}
+	sql, args, err := sq.Delete(table_name).Where(conditions).ToSql()
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to create query to delete tasks: %v", err.Error())
+	}
+	result, err := s.db.Exec(sql, args...)
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to delete tasks: %v", err.Error())
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to get the number of deleted tasks: %v", err.Error())
+	}
+	return deleted, nil
+}
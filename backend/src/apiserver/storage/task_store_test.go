@@ -237,3 +237,70 @@ func TestListTasks(t *testing.T) {
 	assert.Equal(t, expectedSecondPageTasks, tasks, "Unexpected Tasks listed.")
 	assert.Empty(t, nextPageToken)
 }
+
+func TestDeleteTasks(t *testing.T) {
+	tests := []struct {
+		name               string
+		fingerprint        string
+		pipelineName       string
+		namespace          string
+		createdBeforeInSec int64
+		wantDeleted        int64
+		wantRemaining      []string
+	}{
+		{
+			name:          "by fingerprint",
+			fingerprint:   "1",
+			wantDeleted:   3,
+			wantRemaining: []string{defaultFakeTaskIdTwo, defaultFakeTaskIdFive},
+		},
+		{
+			name:          "by pipeline",
+			pipelineName:  "namespace/ns1/pipeline/pipeline1",
+			wantDeleted:   3,
+			wantRemaining: []string{defaultFakeTaskIdFour, defaultFakeTaskIdFive},
+		},
+		{
+			name:               "by creation time",
+			createdBeforeInSec: 5,
+			wantDeleted:        2,
+			wantRemaining:      []string{defaultFakeTaskIdThree, defaultFakeTaskIdFour, defaultFakeTaskIdFive},
+		},
+		{
+			name:          "all conditions must match",
+			fingerprint:   "1",
+			namespace:     "ns2",
+			wantDeleted:   1,
+			wantRemaining: []string{defaultFakeTaskId, defaultFakeTaskIdTwo, defaultFakeTaskIdThree, defaultFakeTaskIdFive},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, taskStore := initializeTaskStore()
+			defer db.Close()
+
+			deleted, err := taskStore.DeleteTasks(tt.fingerprint, tt.pipelineName, tt.namespace, tt.createdBeforeInSec)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantDeleted, deleted)
+
+			opts, err := list.NewOptions(&model.Task{}, 10, "", nil)
+			assert.Nil(t, err)
+			tasks, _, _, err := taskStore.ListTasks(&common.FilterContext{}, opts)
+			assert.Nil(t, err)
+			var remaining []string
+			for _, task := range tasks {
+				remaining = append(remaining, task.UUID)
+			}
+			assert.ElementsMatch(t, tt.wantRemaining, remaining)
+		})
+	}
+}
+
+func TestDeleteTasks_NoCondition(t *testing.T) {
+	db, taskStore := initializeTaskStore()
+	defer db.Close()
+
+	_, err := taskStore.DeleteTasks("", "", "", 0)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "at least one condition must be specified")
+}
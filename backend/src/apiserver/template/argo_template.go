@@ -105,7 +105,9 @@ func (t *Argo) ScheduledWorkflow(apiJob *api.Job) (*scheduledworkflow.ScheduledW
 				Parameters: toCRDParameter(apiJob.GetPipelineSpec().GetParameters()),
 				Spec:       workflow.ToStringForSchedule(),
 			},
-			NoCatchup: util.BoolPointer(apiJob.NoCatchup),
+			NoCatchup:               util.BoolPointer(apiJob.NoCatchup),
+			ConcurrencyPolicy:       scheduledworkflow.ConcurrencyPolicy(apiJob.ConcurrencyPolicy),
+			StartingDeadlineSeconds: toCRDStartingDeadlineSeconds(apiJob.StartingDeadlineSeconds),
 		},
 	}
 
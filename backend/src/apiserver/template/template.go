@@ -200,15 +200,29 @@ func toCRDTrigger(apiTrigger *api.Trigger) *scheduledworkflow.Trigger {
 	if apiTrigger.GetPeriodicSchedule() != nil {
 		crdTrigger.PeriodicSchedule = toCRDPeriodicSchedule(apiTrigger.GetPeriodicSchedule())
 	}
+	if apiTrigger.GetRunCompletionTrigger() != nil {
+		crdTrigger.RunCompletion = toCRDRunCompletionTrigger(apiTrigger.GetRunCompletionTrigger())
+	}
 	return &crdTrigger
 }
 
+func toCRDRunCompletionTrigger(runCompletionTrigger *api.RunCompletionTrigger) *scheduledworkflow.RunCompletionTrigger {
+	// The upstream type is validated by the API server.
+	upstreamType, _ := common.ToModelResourceType(runCompletionTrigger.GetUpstream().GetType())
+	return &scheduledworkflow.RunCompletionTrigger{
+		UpstreamType: string(upstreamType),
+		UpstreamID:   runCompletionTrigger.GetUpstream().GetId(),
+		States:       runCompletionTrigger.States,
+	}
+}
+
 func toCRDCronSchedule(cronSchedule *api.CronSchedule) *scheduledworkflow.CronSchedule {
 	if cronSchedule == nil || cronSchedule.Cron == "" {
 		return nil
 	}
 	crdCronSchedule := scheduledworkflow.CronSchedule{}
 	crdCronSchedule.Cron = cronSchedule.Cron
+	crdCronSchedule.TimeZone = cronSchedule.TimeZone
 
 	if cronSchedule.StartTime != nil {
 		startTime := metav1.NewTime(time.Unix(cronSchedule.StartTime.Seconds, 0))
@@ -238,6 +252,14 @@ func toCRDPeriodicSchedule(periodicSchedule *api.PeriodicSchedule) *scheduledwor
 	return &crdPeriodicSchedule
 }
 
+// toCRDStartingDeadlineSeconds returns nil if the job has no starting deadline.
+func toCRDStartingDeadlineSeconds(startingDeadlineSeconds int64) *int64 {
+	if startingDeadlineSeconds == 0 {
+		return nil
+	}
+	return util.Int64Pointer(startingDeadlineSeconds)
+}
+
 func toCRDParameter(apiParams []*api.Parameter) []scheduledworkflow.Parameter {
 	var swParams []scheduledworkflow.Parameter
 	for _, apiParam := range apiParams {
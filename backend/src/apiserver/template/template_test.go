@@ -17,6 +17,7 @@ package template
 import (
 	"github.com/golang/protobuf/ptypes/timestamp"
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	scheduledworkflow "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"testing"
@@ -25,6 +26,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/ghodss/yaml"
 	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 )
@@ -105,6 +107,27 @@ kind: CronWorkflow`,
 	}
 }
 
+func TestV2SpecRunWorkflow_CacheStaleness(t *testing.T) {
+	viper.Set(common.DefaultCacheStaleness, "P1D")
+	viper.Set(common.MaximumCacheStaleness, "P30D")
+	defer viper.Set(common.DefaultCacheStaleness, "")
+	defer viper.Set(common.MaximumCacheStaleness, "")
+
+	template, err := NewV2SpecTemplate([]byte(v2SpecHelloWorldYAML))
+	assert.Nil(t, err)
+	executionSpec, err := template.RunWorkflow(&api.Run{Name: "run1"}, RunWorkflowOptions{RunId: "run-1"})
+	assert.Nil(t, err)
+
+	// The cache staleness of the deployment is passed to the container driver.
+	var driverArgs []string
+	for _, tmpl := range executionSpec.(*commonutil.Workflow).Spec.Templates {
+		if tmpl.Name == "system-container-driver" {
+			driverArgs = tmpl.Container.Args
+		}
+	}
+	assert.Subset(t, driverArgs, []string{"--default_cache_staleness", "P1D", "--maximum_cache_staleness", "P30D"})
+}
+
 func unmarshalWf(yamlStr string) *v1alpha1.Workflow {
 	var wf v1alpha1.Workflow
 	err := yaml.Unmarshal([]byte(yamlStr), &wf)
@@ -9,6 +9,7 @@ import (
 	"github.com/ghodss/yaml"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	scheduledworkflow "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	"github.com/kubeflow/pipelines/backend/src/v2/compiler/argocompiler"
@@ -35,7 +36,7 @@ func (t *V2Spec) ScheduledWorkflow(apiJob *api.Job) (*scheduledworkflow.Schedule
 		return nil, util.Wrap(err, "Failed to convert to PipelineJob RuntimeConfig")
 	}
 	job.RuntimeConfig = jobRuntimeConfig
-	obj, err := argocompiler.Compile(job, nil)
+	obj, err := argocompiler.Compile(job, compileOptions())
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to compile job")
 	}
@@ -64,7 +65,9 @@ func (t *V2Spec) ScheduledWorkflow(apiJob *api.Job) (*scheduledworkflow.Schedule
 				Parameters: toCRDParameter(apiJob.GetPipelineSpec().GetParameters()),
 				Spec:       executionSpec.ToStringForSchedule(),
 			},
-			NoCatchup: util.BoolPointer(apiJob.NoCatchup),
+			NoCatchup:               util.BoolPointer(apiJob.NoCatchup),
+			ConcurrencyPolicy:       scheduledworkflow.ConcurrencyPolicy(apiJob.ConcurrencyPolicy),
+			StartingDeadlineSeconds: toCRDStartingDeadlineSeconds(apiJob.StartingDeadlineSeconds),
 		},
 	}
 	return scheduledWorkflow, nil
@@ -158,7 +161,7 @@ func (t *V2Spec) RunWorkflow(apiRun *api.Run, options RunWorkflowOptions) (util.
 		return nil, util.Wrap(err, "Failed to convert to PipelineJob RuntimeConfig")
 	}
 	job.RuntimeConfig = jobRuntimeConfig
-	obj, err := argocompiler.Compile(job, nil)
+	obj, err := argocompiler.Compile(job, compileOptions())
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to compile job")
 	}
@@ -181,3 +184,13 @@ func (t *V2Spec) RunWorkflow(apiRun *api.Run, options RunWorkflowOptions) (util.
 	executionSpec.SetPodMetadataLabels(util.LabelKeyWorkflowRunId, options.RunId)
 	return executionSpec, nil
 }
+
+// compileOptions returns the options the pipeline specs are compiled with. The
+// cache staleness of the deployment is passed to the drivers by the compiled
+// workflows, so that it can't be changed from the namespaces of the runs.
+func compileOptions() *argocompiler.Options {
+	return &argocompiler.Options{
+		DefaultCacheStaleness: common.GetDefaultCacheStaleness(),
+		MaximumCacheStaleness: common.GetMaximumCacheStaleness(),
+	}
+}
@@ -28,6 +28,7 @@ type ExecutionCacheStoreInterface interface {
 	GetExecutionCache(executionCacheKey string, cacheStaleness int64, maximumCacheStaleness int64) (*model.ExecutionCache, error)
 	CreateExecutionCache(*model.ExecutionCache) (*model.ExecutionCache, error)
 	DeleteExecutionCache(executionCacheKey string) error
+	DeleteExecutionCaches(executionCacheKey string, startedBeforeInSec int64) (int64, error)
 }
 
 type ExecutionCacheStore struct {
@@ -163,6 +164,27 @@ func (s *ExecutionCacheStore) DeleteExecutionCache(executionCacheID string) erro
 	return nil
 }
 
+// DeleteExecutionCaches deletes the execution caches matching all the
+// non-empty conditions, so that the pods with the same cache key are executed
+// instead of reusing them.
+func (s *ExecutionCacheStore) DeleteExecutionCaches(executionCacheKey string, startedBeforeInSec int64) (int64, error) {
+	db := s.db.Model(&model.ExecutionCache{})
+	if executionCacheKey == "" && startedBeforeInSec == 0 {
+		return 0, fmt.Errorf("Failed to delete execution caches: at least one condition must be specified")
+	}
+	if executionCacheKey != "" {
+		db = db.Where("ExecutionCacheKey = ?", executionCacheKey)
+	}
+	if startedBeforeInSec != 0 {
+		db = db.Where("StartedAtInSec < ?", startedBeforeInSec)
+	}
+	db = db.Delete(&model.ExecutionCache{})
+	if db.Error != nil {
+		return 0, fmt.Errorf("Failed to delete execution caches: %v", db.Error)
+	}
+	return db.RowsAffected, nil
+}
+
 // factory function for execution cache store
 func NewExecutionCacheStore(db *DB, time util.TimeInterface) *ExecutionCacheStore {
 	return &ExecutionCacheStore{
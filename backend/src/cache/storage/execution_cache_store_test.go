@@ -208,3 +208,29 @@ func TestDeleteExecutionCache(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Contains(t, err.Error(), "not found")
 }
+
+func TestDeleteExecutionCaches(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	executionCacheStore := NewExecutionCacheStore(db, util.NewFakeTimeForEpoch())
+	// The caches are started at 1, 2 and 3 seconds after the epoch.
+	executionCacheStore.CreateExecutionCache(createExecutionCache("testKey", "testOutput1"))
+	executionCacheStore.CreateExecutionCache(createExecutionCache("otherKey", "testOutput2"))
+	executionCacheStore.CreateExecutionCache(createExecutionCache("testKey", "testOutput3"))
+
+	deleted, err := executionCacheStore.DeleteExecutionCaches("testKey", 3)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), deleted)
+	executionCache, err := executionCacheStore.GetExecutionCache("testKey", -1, -1)
+	assert.Nil(t, err)
+	assert.Equal(t, "testOutput3", executionCache.ExecutionOutput)
+
+	deleted, err = executionCacheStore.DeleteExecutionCaches("", 100)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), deleted)
+	_, err = executionCacheStore.GetExecutionCache("otherKey", -1, -1)
+	assert.Contains(t, err.Error(), "not found")
+
+	_, err = executionCacheStore.DeleteExecutionCaches("", 0)
+	assert.Contains(t, err.Error(), "at least one condition must be specified")
+}
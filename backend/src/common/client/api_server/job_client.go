@@ -17,9 +17,12 @@ import (
 type JobInterface interface {
 	Create(params *params.CreateJobParams) (*model.V1beta1Job, error)
 	Get(params *params.GetJobParams) (*model.V1beta1Job, error)
+	Update(params *params.UpdateJobParams) (*model.V1beta1Job, error)
 	Delete(params *params.DeleteJobParams) error
 	Enable(params *params.EnableJobParams) error
 	Disable(params *params.DisableJobParams) error
+	Trigger(params *params.TriggerJobParams) error
+	Backfill(params *params.BackfillJobParams) error
 	List(params *params.ListJobsParams) ([]*model.V1beta1Job, int, string, error)
 	ListAll(params *params.ListJobsParams, maxResultSize int) ([]*model.V1beta1Job, error)
 }
@@ -107,6 +110,30 @@ func (c *JobClient) Get(parameters *params.GetJobParams) (*model.V1beta1Job,
 	return response.Payload, nil
 }
 
+func (c *JobClient) Update(parameters *params.UpdateJobParams) (*model.V1beta1Job,
+	error) {
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
+	defer cancel()
+
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.JobService.UpdateJob(parameters, c.authInfoWriter)
+	if err != nil {
+		if defaultError, ok := err.(*params.UpdateJobDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return nil, util.NewUserError(err,
+			fmt.Sprintf("Failed to update job. Params: '%+v'. Body: '%+v'", parameters, parameters.Body),
+			fmt.Sprintf("Failed to update job '%v'", parameters.ID))
+	}
+
+	return response.Payload, nil
+}
+
 func (c *JobClient) Delete(parameters *params.DeleteJobParams) error {
 	// Create context with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
@@ -176,6 +203,52 @@ func (c *JobClient) Disable(parameters *params.DisableJobParams) error {
 	return nil
 }
 
+func (c *JobClient) Trigger(parameters *params.TriggerJobParams) error {
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
+	defer cancel()
+
+	// Make service call
+	parameters.Context = ctx
+	_, err := c.apiClient.JobService.TriggerJob(parameters, c.authInfoWriter)
+	if err != nil {
+		if defaultError, ok := err.(*params.TriggerJobDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return util.NewUserError(err,
+			fmt.Sprintf("Failed to trigger job. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to trigger job '%v'", parameters.ID))
+	}
+
+	return nil
+}
+
+func (c *JobClient) Backfill(parameters *params.BackfillJobParams) error {
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
+	defer cancel()
+
+	// Make service call
+	parameters.Context = ctx
+	_, err := c.apiClient.JobService.BackfillJob(parameters, c.authInfoWriter)
+	if err != nil {
+		if defaultError, ok := err.(*params.BackfillJobDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return util.NewUserError(err,
+			fmt.Sprintf("Failed to backfill job. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to backfill job '%v'", parameters.ID))
+	}
+
+	return nil
+}
+
 func (c *JobClient) List(parameters *params.ListJobsParams) (
 	[]*model.V1beta1Job, int, string, error) {
 	// Create context with timeout
@@ -48,6 +48,16 @@ func (c *JobClientFake) Get(params *jobparams.GetJobParams) (
 	}
 }
 
+func (c *JobClientFake) Update(params *jobparams.UpdateJobParams) (
+	*jobmodel.V1beta1Job, error) {
+	switch params.ID {
+	case JobForClientErrorTest:
+		return nil, fmt.Errorf(ClientErrorString)
+	default:
+		return getDefaultJob(params.ID, params.Body.Name), nil
+	}
+}
+
 func (c *JobClientFake) Delete(params *jobparams.DeleteJobParams) error {
 	switch params.ID {
 	case JobForClientErrorTest:
@@ -75,6 +85,24 @@ func (c *JobClientFake) Disable(params *jobparams.DisableJobParams) error {
 	}
 }
 
+func (c *JobClientFake) Trigger(params *jobparams.TriggerJobParams) error {
+	switch params.ID {
+	case JobForClientErrorTest:
+		return fmt.Errorf(ClientErrorString)
+	default:
+		return nil
+	}
+}
+
+func (c *JobClientFake) Backfill(params *jobparams.BackfillJobParams) error {
+	switch params.ID {
+	case JobForClientErrorTest:
+		return fmt.Errorf(ClientErrorString)
+	default:
+		return nil
+	}
+}
+
 func (c *JobClientFake) List(params *jobparams.ListJobsParams) (
 	[]*jobmodel.V1beta1Job, int, string, error) {
 	const (
@@ -1,17 +1,26 @@
 package api_server
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
 
 	workflowapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/ghodss/yaml"
 	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 	apiclient "github.com/kubeflow/pipelines/backend/api/v1beta1/go_http_client/run_client"
 	params "github.com/kubeflow/pipelines/backend/api/v1beta1/go_http_client/run_client/run_service"
 	model "github.com/kubeflow/pipelines/backend/api/v1beta1/go_http_client/run_model"
+	exec "github.com/kubeflow/pipelines/backend/src/common"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"k8s.io/client-go/tools/clientcmd"
 )
@@ -23,10 +32,20 @@ type RunInterface interface {
 	ListAll(params *params.ListRunsParams, maxResultSize int) ([]*model.V1beta1Run, error)
 	Unarchive(params *params.UnarchiveRunParams) error
 	Terminate(params *params.TerminateRunParams) error
+	WaitForRun(runId string, timeout time.Duration) (*model.V1beta1RunDetail, error)
 }
 
+const (
+	runWatchPath          = "/apis/v1beta1/runs/{run_id}:watch"
+	eventStreamMediaType  = "text/event-stream"
+	runWatchRetryInterval = 5 * time.Second
+)
+
 type RunClient struct {
-	apiClient      *apiclient.Run
+	apiClient *apiclient.Run
+	// The runtime streaming the run watches. It never runs in debug mode,
+	// since dumping the response would read the whole stream.
+	watchRuntime   *httptransport.Runtime
 	authInfoWriter runtime.ClientAuthInfoWriter
 }
 
@@ -40,9 +59,15 @@ func NewRunClient(clientConfig clientcmd.ClientConfig, debug bool) (
 
 	apiClient := apiclient.New(runtime, strfmt.Default)
 
+	watchRuntime, err := NewHTTPRuntime(clientConfig, false)
+	if err != nil {
+		return nil, fmt.Errorf("Error occurred when creating run client: %w", err)
+	}
+
 	// Creating run client
 	return &RunClient{
-		apiClient: apiClient,
+		apiClient:    apiClient,
+		watchRuntime: withEventStreamConsumer(watchRuntime),
 	}, nil
 }
 
@@ -53,13 +78,23 @@ func NewKubeflowInClusterRunClient(namespace string, debug bool) (
 
 	apiClient := apiclient.New(runtime, strfmt.Default)
 
+	watchRuntime := NewKubeflowInClusterHTTPRuntime(namespace, false)
+
 	// Creating run client
 	return &RunClient{
 		apiClient:      apiClient,
+		watchRuntime:   withEventStreamConsumer(watchRuntime),
 		authInfoWriter: SATokenVolumeProjectionAuth,
 	}, nil
 }
 
+// withEventStreamConsumer lets the runtime respond with server-sent events,
+// which are read by the readers of the operations.
+func withEventStreamConsumer(watchRuntime *httptransport.Runtime) *httptransport.Runtime {
+	watchRuntime.Consumers[eventStreamMediaType] = runtime.ByteStreamConsumer()
+	return watchRuntime
+}
+
 func (c *RunClient) Create(parameters *params.CreateRunParams) (*model.V1beta1RunDetail,
 	*workflowapi.Workflow, error) {
 	// Create context with timeout
@@ -269,3 +304,137 @@ func (c *RunClient) Terminate(parameters *params.TerminateRunParams) error {
 	}
 	return nil
 }
+
+// WaitForRun waits until the run reaches a final state and returns its details.
+// The run is watched through the server-sent events of the API server instead
+// of being polled, and is watched again whenever the stream ends early.
+func (c *RunClient) WaitForRun(runId string, timeout time.Duration) (*model.V1beta1RunDetail, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	for {
+		result, err := c.watchRun(ctx, runId)
+		if ctx.Err() != nil {
+			return nil, util.NewUserError(ctx.Err(),
+				fmt.Sprintf("Timed out waiting for run. Run ID: %v. Timeout: %v", runId, timeout),
+				fmt.Sprintf("Timed out waiting for run %v", runId))
+		}
+		if err != nil {
+			return nil, util.NewUserError(CreateErrorCouldNotRecoverAPIStatus(err),
+				fmt.Sprintf("Failed to wait for run. Run ID: %v", runId),
+				fmt.Sprintf("Failed to wait for run %v", runId))
+		}
+		if result.runDetail != nil {
+			return result.runDetail, nil
+		}
+		// The watch is retried when it ends or falls behind the run updates.
+		if result.status != nil && result.status.Code != int32(codes.Unavailable) {
+			return nil, util.NewUserError(CreateErrorFromAPIStatus(result.status.Error, result.status.Code),
+				fmt.Sprintf("Failed to wait for run. Run ID: %v", runId),
+				fmt.Sprintf("Failed to wait for run %v", runId))
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(runWatchRetryInterval):
+		}
+	}
+}
+
+// runWatchResult is the result of a run watch, which either saw the run
+// finish, or ended with an error status. Neither is set when the stream ended
+// before the run finished.
+type runWatchResult struct {
+	runDetail *model.V1beta1RunDetail
+	status    *model.V1beta1Status
+}
+
+func (c *RunClient) watchRun(ctx context.Context, runId string) (*runWatchResult, error) {
+	result, err := c.watchRuntime.Submit(&runtime.ClientOperation{
+		ID:                 "WatchRun",
+		Method:             http.MethodGet,
+		PathPattern:        runWatchPath,
+		ProducesMediaTypes: []string{eventStreamMediaType, runtime.JSONMime},
+		ConsumesMediaTypes: []string{runtime.JSONMime},
+		Schemes:            []string{"http", "https"},
+		Params: runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, reg strfmt.Registry) error {
+			return r.SetPathParam("run_id", runId)
+		}),
+		Reader:   runtime.ClientResponseReaderFunc(readRunWatch),
+		AuthInfo: c.authInfoWriter,
+		Context:  ctx,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*runWatchResult), nil
+}
+
+// readRunWatch reads the server-sent events of a run watch until the run
+// reaches a final state.
+func readRunWatch(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	if response.Code() != http.StatusOK {
+		status := &model.V1beta1Status{}
+		if err := runtime.JSONConsumer().Consume(response.Body(), status); err != nil {
+			return nil, runtime.NewAPIError("WatchRun", response.Message(), response.Code())
+		}
+		return &runWatchResult{status: status}, nil
+	}
+
+	reader := bufio.NewReader(response.Body())
+	eventType, data := "", ""
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			// The stream ended, or was interrupted.
+			return &runWatchResult{}, nil
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if strings.HasPrefix(line, "event:") {
+			eventType = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+			continue
+		}
+		if strings.HasPrefix(line, "data:") {
+			data = strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+			continue
+		}
+		if line != "" {
+			continue
+		}
+
+		// An empty line ends the event.
+		switch {
+		case data == "":
+		case eventType == "error":
+			status := &model.V1beta1Status{}
+			if err := consumeEvent(data, status); err != nil {
+				return nil, err
+			}
+			return &runWatchResult{status: status}, nil
+		default:
+			runDetail := &model.V1beta1RunDetail{}
+			if err := consumeEvent(data, runDetail); err != nil {
+				return nil, err
+			}
+			if runDetail.Run != nil && isRunInFinalState(runDetail.Run.Status) {
+				return &runWatchResult{runDetail: runDetail}, nil
+			}
+		}
+		eventType, data = "", ""
+	}
+}
+
+func consumeEvent(data string, v interface{}) error {
+	if err := runtime.JSONConsumer().Consume(bytes.NewReader([]byte(data)), v); err != nil && err != io.EOF {
+		return fmt.Errorf("Failed to unmarshal the watched run: %w", err)
+	}
+	return nil
+}
+
+func isRunInFinalState(status string) bool {
+	switch exec.ExecutionPhase(status) {
+	case exec.ExecutionSucceeded, exec.ExecutionFailed, exec.ExecutionError:
+		return true
+	default:
+		return false
+	}
+}
@@ -2,6 +2,7 @@ package api_server
 
 import (
 	"fmt"
+	"time"
 
 	workflowapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/go-openapi/strfmt"
@@ -93,3 +94,14 @@ func (c *RunClientFake) Terminate(params *runparams.TerminateRunParams) error {
 		return fmt.Errorf(InvalidFakeRequest, params.RunID)
 	}
 }
+
+func (c *RunClientFake) WaitForRun(runId string, timeout time.Duration) (*runmodel.V1beta1RunDetail, error) {
+	switch runId {
+	case RunForClientErrorTest:
+		return nil, fmt.Errorf(ClientErrorString)
+	default:
+		runDetail := getDefaultRun(runId, "RUN_NAME")
+		runDetail.Run.Status = "Succeeded"
+		return runDetail, nil
+	}
+}
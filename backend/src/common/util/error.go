@@ -218,6 +218,14 @@ func NewPermissionDeniedError(err error, externalFormat string, a ...interface{}
 		codes.PermissionDenied)
 }
 
+func NewUnavailableError(err error, externalFormat string, a ...interface{}) *UserError {
+	externalMessage := fmt.Sprintf(externalFormat, a...)
+	return newUserError(
+		errors.Wrapf(err, fmt.Sprintf("Unavailable: %v", externalMessage)),
+		externalMessage,
+		codes.Unavailable)
+}
+
 func (e *UserError) ExternalMessage() string {
 	return e.externalMessage
 }
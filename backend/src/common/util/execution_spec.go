@@ -133,6 +133,9 @@ type ExecutionSpec interface {
 	// If the ExecutionSpec was terminated and not finished yet
 	IsTerminating() bool
 
+	// If the ExecutionSpec was terminated and is finished
+	IsTerminated() bool
+
 	// Get schedule time from label in second
 	ScheduledAtInSecOr0() int64
 
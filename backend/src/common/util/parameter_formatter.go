@@ -25,14 +25,15 @@ import (
 )
 
 const (
-	runUUIDExpression       = "[[RunUUID]]"
-	scheduledTimeExpression = "[[ScheduledTime]]"
-	currentTimeExpression   = "[[CurrentTime]]"
-	IndexExpression         = "[[Index]]"
-	scheduledTimePrefix     = "[[ScheduledTime."
-	currentTimePrefix       = "[[CurrentTime."
-	defaultTimeFormat       = "20060102150405"
-	suffix                  = "]]"
+	runUUIDExpression         = "[[RunUUID]]"
+	upstreamRunUUIDExpression = "[[UpstreamRunUUID]]"
+	scheduledTimeExpression   = "[[ScheduledTime]]"
+	currentTimeExpression     = "[[CurrentTime]]"
+	IndexExpression           = "[[Index]]"
+	scheduledTimePrefix       = "[[ScheduledTime."
+	currentTimePrefix         = "[[CurrentTime."
+	defaultTimeFormat         = "20060102150405"
+	suffix                    = "]]"
 
 	scheduledTimePrefix2 = "{{$.scheduledTime.strftime('"
 	currentTimePrefix2   = "{{$.currentTime.strftime('"
@@ -47,10 +48,11 @@ const (
 // in workflow parameters by information about the workflow execution (time at
 // which the workflow was started, time at which the workflow was scheduled, etc.)
 type ParameterFormatter struct {
-	runUUID        string
-	scheduledEpoch int64
-	nowEpoch       int64
-	index          int64
+	runUUID         string
+	upstreamRunUUID string
+	scheduledEpoch  int64
+	nowEpoch        int64
+	index           int64
 }
 
 // NewRunParameterFormatter returns a new ParameterFormatter to substitute run macros.
@@ -74,6 +76,13 @@ func NewSWFParameterFormatter(runUUID string, scheduledEpoch int64, nowEpoch int
 	}
 }
 
+// WithUpstreamRunUUID sets the ID of the upstream run whose completion
+// triggered the run, to substitute the upstream run macro.
+func (p *ParameterFormatter) WithUpstreamRunUUID(upstreamRunUUID string) *ParameterFormatter {
+	p.upstreamRunUUID = upstreamRunUUID
+	return p
+}
+
 func (p *ParameterFormatter) FormatWorkflowParameters(
 	parameters map[string]string) map[string]string {
 	result := make(map[string]string)
@@ -106,6 +115,8 @@ func (p *ParameterFormatter) createSubstitutes(match string) string {
 	// First ensure that the corresponding field is valid, then attempt to substitute
 	if len(p.runUUID) > 0 && strings.HasPrefix(match, runUUIDExpression) {
 		return p.runUUID
+	} else if len(p.upstreamRunUUID) > 0 && strings.HasPrefix(match, upstreamRunUUIDExpression) {
+		return p.upstreamRunUUID
 	} else if p.scheduledEpoch != disabledField && strings.HasPrefix(match, scheduledTimeExpression) {
 		return time.Unix(p.scheduledEpoch, 0).UTC().Format(defaultTimeFormat)
 	} else if p.nowEpoch != disabledField && strings.HasPrefix(match, currentTimeExpression) {
@@ -58,3 +58,19 @@ func TestParameterFormatter_Format(t *testing.T) {
 	assert.Equal(t, "FOO 1970-01-01 00:00:25 FOO", formatter.Format("FOO {{$.scheduledTime.strftime('%Y-%m-%d %H:%M:%S')}} FOO"))
 	assert.Equal(t, "FOO 1970-01-01 00:00:26 FOO", formatter.Format("FOO {{$.currentTime.strftime('%Y-%m-%d %H:%M:%S')}} FOO"))
 }
+
+func TestParameterFormatter_Format_UpstreamRunUUID(t *testing.T) {
+	formatter := NewSWFParameterFormatter(
+		"some-run-uuid",
+		25, /* scheduled time */
+		26, /* current time */
+		27 /* index */)
+
+	// Not substituted if there is no upstream run
+	assert.Equal(t, "FOO [[UpstreamRunUUID]] FOO", formatter.Format("FOO [[UpstreamRunUUID]] FOO"))
+
+	formatter.WithUpstreamRunUUID("some-upstream-run-uuid")
+	assert.Equal(t, "FOO some-upstream-run-uuid FOO", formatter.Format("FOO [[UpstreamRunUUID]] FOO"))
+	assert.Equal(t, "some-run-uuid some-upstream-run-uuid",
+		formatter.Format("[[RunUUID]] [[UpstreamRunUUID]]"))
+}
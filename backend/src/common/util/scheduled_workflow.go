@@ -15,6 +15,8 @@
 package util
 
 import (
+	"strings"
+
 	"github.com/golang/glog"
 	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	"k8s.io/apimachinery/pkg/util/json"
@@ -58,6 +60,34 @@ func (s *ScheduledWorkflow) CronOrEmpty() string {
 	return ""
 }
 
+func (s *ScheduledWorkflow) CronScheduleTimeZoneOrNull() *string {
+	if s.Spec.CronSchedule != nil && s.Spec.CronSchedule.TimeZone != "" {
+		return StringPointer(s.Spec.CronSchedule.TimeZone)
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) RunCompletionUpstreamTypeOrNull() *string {
+	if s.Spec.RunCompletion != nil {
+		return StringPointer(s.Spec.RunCompletion.UpstreamType)
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) RunCompletionUpstreamIdOrNull() *string {
+	if s.Spec.RunCompletion != nil {
+		return StringPointer(s.Spec.RunCompletion.UpstreamID)
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) RunCompletionStatesOrNull() *string {
+	if s.Spec.RunCompletion != nil {
+		return StringPointer(strings.Join(s.Spec.RunCompletion.States, ","))
+	}
+	return nil
+}
+
 func (s *ScheduledWorkflow) PeriodicScheduleStartTimeInSecOrNull() *int64 {
 	if s.Spec.PeriodicSchedule != nil && s.Spec.PeriodicSchedule.StartTime != nil {
 		return Int64Pointer(s.Spec.PeriodicSchedule.StartTime.Unix())
@@ -86,6 +116,13 @@ func (s *ScheduledWorkflow) NoCatchupOrFalse() bool {
 	return false
 }
 
+func (s *ScheduledWorkflow) StartingDeadlineSecondsOr0() int64 {
+	if s.Spec.StartingDeadlineSeconds != nil {
+		return *s.Spec.StartingDeadlineSeconds
+	}
+	return 0
+}
+
 func (s *ScheduledWorkflow) IntervalSecondOr0() int64 {
 	if s.Spec.PeriodicSchedule != nil {
 		return s.Spec.PeriodicSchedule.IntervalSecond
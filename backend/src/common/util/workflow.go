@@ -283,6 +283,14 @@ func (w *Workflow) IsTerminating() bool {
 		!w.IsInFinalState()
 }
 
+// IsTerminated whether the workflow was terminated and is finished. The
+// active deadline of a terminated workflow stays 0 after it finishes.
+func (w *Workflow) IsTerminated() bool {
+	return w.Spec.ActiveDeadlineSeconds != nil &&
+		*w.Spec.ActiveDeadlineSeconds == 0 &&
+		w.IsInFinalState()
+}
+
 // OverrideParameters overrides some of the parameters of a Workflow.
 func (w *Workflow) OverrideParameters(desiredParams map[string]string) {
 	desiredSlice := make([]workflowapi.Parameter, 0)
@@ -141,6 +141,16 @@ func (p *WorkflowClient) Create(ctx context.Context, namespace string, workflow
 	return result, nil
 }
 
+// Terminate terminates a workflow given its namespace and name.
+func (p *WorkflowClient) Terminate(ctx context.Context, namespace string, name string) error {
+	err := commonutil.TerminateWorkflow(ctx, p.clientSet.Execution(namespace), name)
+	if err != nil {
+		return wraperror.Wrapf(err, "Error terminating workflow (%v) in namespace (%v): %v", name,
+			namespace, err)
+	}
+	return nil
+}
+
 func getLabelSelectorToGetWorkflows(swfName string, completed bool, minIndex int64) *labels.Selector {
 	labelSelector := labels.NewSelector()
 	// The Argo workflow should be active or completed
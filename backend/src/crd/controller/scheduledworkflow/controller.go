@@ -411,21 +411,33 @@ func (c *Controller) syncHandler(ctx context.Context, key string) (
 			wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't fetch completed workflows: %v", name, err)
 	}
 
-	submitted, nextScheduledEpoch, err := c.submitNextWorkflowIfNeeded(ctx, swf, len(active), nowEpoch)
+	submitted, nextScheduledEpoch, skipReason, err := c.submitNextWorkflowIfNeeded(ctx, swf, active, nowEpoch)
 	if err != nil {
 		return false, true, swf,
 			wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't fetch completed workflows: %v", name, err)
 	}
 
-	err = c.updateStatus(ctx, swf, submitted, active, completed, nextScheduledEpoch, nowEpoch)
+	// Manual triggers are handled once the schedule has nothing to do.
+	var manualTrigger *swfapi.ManualTrigger
+	var manualScheduledEpoch int64
+	if !submitted && skipReason == "" {
+		manualTrigger, manualScheduledEpoch, err = c.submitNextManualWorkflowIfNeeded(ctx, swf, len(active), nowEpoch)
+		if err != nil {
+			return false, true, swf,
+				wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't submit manual workflow: %v", name, err)
+		}
+	}
+
+	err = c.updateStatus(ctx, swf, submitted, skipReason, active, completed, nextScheduledEpoch, nowEpoch,
+		manualTrigger, manualScheduledEpoch)
 	if err != nil {
 		return false, true, swf,
 			wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't update swf status: %v", name, err)
 	}
 
-	if submitted {
-		// Success. Since we created a new workflow, sync again soon since there might be one more
-		// resource to create.
+	if submitted || skipReason != "" || manualTrigger != nil {
+		// Success. Since we created a new workflow or skipped a scheduled time, sync again soon
+		// since there might be one more resource to create.
 		log.WithFields(log.Fields{
 			ScheduledWorkflow: name,
 		}).Infof("Syncing ScheduledWorkflow (%v): success, requeuing for further processing.", name)
@@ -439,26 +451,41 @@ func (c *Controller) syncHandler(ctx context.Context, key string) (
 	return false, false, swf, nil
 }
 
-// Submits the next workflow if a workflow is due to execute. Returns the submitted workflow,
-// an error (if any), and a boolean indicating (in case of an error) whether handling the
-// ScheduledWorkflow should be attempted again at a later time.
+// Submits the next workflow if a workflow is due to execute. Returns whether a workflow was
+// submitted, the scheduled epoch, the reason why the scheduled epoch was skipped (if it was),
+// and an error (if any).
 func (c *Controller) submitNextWorkflowIfNeeded(ctx context.Context, swf *util.ScheduledWorkflow,
-	activeWorkflowCount int, nowEpoch int64) (
-	submitted bool, nextScheduledEpoch int64, err error) {
+	active []swfapi.WorkflowStatus, nowEpoch int64) (
+	submitted bool, nextScheduledEpoch int64, skipReason string, err error) {
 	// Compute the next scheduled time.
-	nextScheduledEpoch, shouldRunNow := swf.GetNextScheduledEpoch(
-		int64(activeWorkflowCount), nowEpoch, *c.location)
+	nextScheduledEpoch, action, skipReason := swf.GetScheduleAction(
+		int64(len(active)), nowEpoch, *c.location)
 
-	if !shouldRunNow {
+	switch action {
+	case util.ScheduleActionWait:
 		log.WithFields(log.Fields{
 			ScheduledWorkflow: swf.Name,
 		}).Infof("Submitting workflow for ScheduledWorkflow (%v): nothing to submit (next scheduled at: %v)",
 			swf.Name, commonutil.FormatTimeForLogging(nextScheduledEpoch))
-		return false, nextScheduledEpoch, nil
+		return false, nextScheduledEpoch, "", nil
+	case util.ScheduleActionSkip:
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: swf.Name,
+		}).Infof("Submitting workflow for ScheduledWorkflow (%v): skipping the workflow scheduled at %v (reason: %v)",
+			swf.Name, commonutil.FormatTimeForLogging(nextScheduledEpoch), skipReason)
+		return false, nextScheduledEpoch, skipReason, nil
+	case util.ScheduleActionReplace:
+		if err := c.terminateOldestWorkflows(ctx, swf, active); err != nil {
+			log.WithFields(log.Fields{
+				ScheduledWorkflow: swf.Name,
+			}).Errorf("Submitting workflow for ScheduledWorkflow (%v): transient error while replacing active workflows: %v",
+				swf.Name, err)
+			return false, nextScheduledEpoch, "", err
+		}
 	}
 
 	var workflowName string
-	submitted, workflowName, err = c.submitNewWorkflowIfNotAlreadySubmitted(ctx, swf, nextScheduledEpoch, nowEpoch)
+	submitted, workflowName, err = c.submitNewWorkflowIfNotAlreadySubmitted(ctx, swf, nextScheduledEpoch, nowEpoch, "")
 	if err != nil {
 		log.WithFields(log.Fields{
 			ScheduledWorkflow: swf.Name,
@@ -466,19 +493,65 @@ func (c *Controller) submitNextWorkflowIfNeeded(ctx context.Context, swf *util.S
 			swf.Name, err)
 		// There was an error submitting a new workflow.
 		// We should attempt to handle the schedule again at a later time.
-		return false, nextScheduledEpoch, err
+		return false, nextScheduledEpoch, "", err
 	}
 	log.WithFields(log.Fields{
 		ScheduledWorkflow: swf.Name,
 		Workflow:          workflowName,
 	}).Infof("Submitting workflow for ScheduledWorkflow (%v): workflow (%v) successfully submitted (scheduled at: %v)",
 		swf.Name, workflowName, commonutil.FormatTimeForLogging(nextScheduledEpoch))
-	return submitted, nextScheduledEpoch, nil
+	return submitted, nextScheduledEpoch, "", nil
+}
+
+// Terminates the oldest active workflows of the ScheduledWorkflow so that a new workflow
+// can be submitted without exceeding its max concurrency.
+func (c *Controller) terminateOldestWorkflows(ctx context.Context, swf *util.ScheduledWorkflow,
+	active []swfapi.WorkflowStatus) error {
+	for _, workflow := range swf.GetWorkflowsToReplace(active) {
+		if err := c.workflowClient.Terminate(ctx, workflow.Namespace, workflow.Name); err != nil {
+			return err
+		}
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: swf.Name,
+			Workflow:          workflow.Name,
+		}).Infof("Submitting workflow for ScheduledWorkflow (%v): workflow (%v) terminated to be replaced",
+			swf.Name, workflow.Name)
+	}
+	return nil
+}
+
+// Submits the next workflow requested by the manual triggers of the ScheduledWorkflow, if any.
+// Returns the manual trigger for which a workflow was submitted and the scheduled epoch of
+// that workflow.
+func (c *Controller) submitNextManualWorkflowIfNeeded(ctx context.Context, swf *util.ScheduledWorkflow,
+	activeWorkflowCount int, nowEpoch int64) (
+	trigger *swfapi.ManualTrigger, scheduledEpoch int64, err error) {
+	trigger, scheduledEpoch, shouldRunNow := swf.GetNextManualTrigger(
+		int64(activeWorkflowCount), nowEpoch, *c.location)
+	if trigger == nil || !shouldRunNow {
+		return nil, 0, nil
+	}
+
+	_, workflowName, err := c.submitNewWorkflowIfNotAlreadySubmitted(ctx, swf, scheduledEpoch, nowEpoch,
+		trigger.UpstreamRunID)
+	if err != nil {
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: swf.Name,
+		}).Errorf("Submitting workflow for ScheduledWorkflow (%v): transient error while submitting workflow for manual trigger (%v): %v",
+			swf.Name, trigger.Name, err)
+		return nil, 0, err
+	}
+	log.WithFields(log.Fields{
+		ScheduledWorkflow: swf.Name,
+		Workflow:          workflowName,
+	}).Infof("Submitting workflow for ScheduledWorkflow (%v): workflow (%v) successfully submitted for manual trigger (%v) (scheduled at: %v)",
+		swf.Name, workflowName, trigger.Name, commonutil.FormatTimeForLogging(scheduledEpoch))
+	return trigger, scheduledEpoch, nil
 }
 
 func (c *Controller) submitNewWorkflowIfNotAlreadySubmitted(
 	ctx context.Context,
-	swf *util.ScheduledWorkflow, nextScheduledEpoch int64, nowEpoch int64) (
+	swf *util.ScheduledWorkflow, nextScheduledEpoch int64, nowEpoch int64, upstreamRunID string) (
 	bool, string, error) {
 
 	workflowName := swf.NextResourceName()
@@ -501,7 +574,7 @@ func (c *Controller) submitNewWorkflowIfNotAlreadySubmitted(
 	}
 
 	// If the workflow is not found, we need to create it.
-	newWorkflow, err := swf.NewWorkflow(nextScheduledEpoch, nowEpoch)
+	newWorkflow, err := swf.NewWorkflow(nextScheduledEpoch, nowEpoch, upstreamRunID)
 	createdWorkflow, err := c.workflowClient.Create(ctx, swf.Namespace, newWorkflow)
 	if err != nil {
 		return false, "", err
@@ -513,15 +586,25 @@ func (c *Controller) updateStatus(
 	ctx context.Context,
 	swf *util.ScheduledWorkflow,
 	submitted bool,
+	skipReason string,
 	active []swfapi.WorkflowStatus,
 	completed []swfapi.WorkflowStatus,
 	nextScheduledEpoch int64,
-	nowEpoch int64) error {
+	nowEpoch int64,
+	manualTrigger *swfapi.ManualTrigger,
+	manualScheduledEpoch int64) error {
 	// NEVER modify objects from the store. It's a read-only, local cache.
 	// You can use DeepCopy() to make a deep copy of original object and modify this copy
 	// Or create a copy manually for better performance
 	swfCopy := util.NewScheduledWorkflow(swf.Get().DeepCopy())
 	swfCopy.UpdateStatus(nowEpoch, submitted, nextScheduledEpoch, active, completed, c.location)
+	if skipReason != "" {
+		swfCopy.SkipScheduledTime(nextScheduledEpoch, skipReason, c.location)
+	}
+	if manualTrigger != nil {
+		swfCopy.UpdateManualTriggerStatus(manualTrigger.Name, manualScheduledEpoch)
+	}
+	swfCopy.PruneManualTriggers(nowEpoch, *c.location)
 
 	// Until #38113 is merged, we must use Update instead of UpdateStatus to
 	// update the Status block of the ScheduledWorkflow. UpdateStatus will not
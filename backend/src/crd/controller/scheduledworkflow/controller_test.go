@@ -0,0 +1,165 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	workflowapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/client"
+	"github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	minute = 60
+	hour   = 60 * minute
+)
+
+// fakeExecutionClient records the workflows that are created and patched.
+type fakeExecutionClient struct {
+	created  []string
+	patched  []string
+	patchErr error
+}
+
+func (c *fakeExecutionClient) Execution(namespace string) commonutil.ExecutionInterface {
+	return &fakeExecutionInterface{client: c}
+}
+
+type fakeExecutionInterface struct {
+	commonutil.ExecutionInterface
+	client *fakeExecutionClient
+}
+
+func (e *fakeExecutionInterface) Create(ctx context.Context, execution commonutil.ExecutionSpec,
+	opts metav1.CreateOptions) (commonutil.ExecutionSpec, error) {
+	e.client.created = append(e.client.created, execution.ExecutionName())
+	return execution, nil
+}
+
+func (e *fakeExecutionInterface) Patch(ctx context.Context, name string, pt types.PatchType, data []byte,
+	opts metav1.PatchOptions, subresources ...string) (commonutil.ExecutionSpec, error) {
+	if e.client.patchErr != nil {
+		return nil, e.client.patchErr
+	}
+	e.client.patched = append(e.client.patched, name+" "+string(data))
+	return nil, nil
+}
+
+// fakeExecutionInformer doesn't find any workflow.
+type fakeExecutionInformer struct {
+	commonutil.ExecutionInformer
+}
+
+func (i *fakeExecutionInformer) Get(namespace string, name string) (commonutil.ExecutionSpec, bool, error) {
+	return nil, true, errors.New("not found")
+}
+
+func newTestController(execClient *fakeExecutionClient) *Controller {
+	return &Controller{
+		workflowClient: client.NewWorkflowClient(execClient, &fakeExecutionInformer{}),
+		time:           commonutil.NewFakeTimeForEpoch(),
+		location:       time.UTC,
+	}
+}
+
+func newTestScheduledWorkflow(t *testing.T, policy swfapi.ConcurrencyPolicy) *util.ScheduledWorkflow {
+	spec, err := json.Marshal(workflowapi.WorkflowSpec{ServiceAccountName: "SERVICE_ACCOUNT"})
+	assert.Nil(t, err)
+	return util.NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "SCHEDULE1",
+			Namespace:         "NAMESPACE1",
+			CreationTimestamp: metav1.NewTime(time.Unix(9*hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:           true,
+			MaxConcurrency:    commonutil.Int64Pointer(int64(2)),
+			ConcurrencyPolicy: policy,
+			Trigger: swfapi.Trigger{
+				PeriodicSchedule: &swfapi.PeriodicSchedule{
+					IntervalSecond: int64(minute),
+				},
+			},
+			Workflow: &swfapi.WorkflowResource{Spec: string(spec)},
+		},
+	})
+}
+
+func TestSubmitNextWorkflowIfNeeded_ReplaceConcurrent(t *testing.T) {
+	execClient := &fakeExecutionClient{}
+	controller := newTestController(execClient)
+	swf := newTestScheduledWorkflow(t, swfapi.ReplaceConcurrent)
+	active := []swfapi.WorkflowStatus{
+		{Name: "WORKFLOW2", Namespace: "NAMESPACE1", ScheduledAt: metav1.NewTime(time.Unix(20, 0).UTC())},
+		{Name: "WORKFLOW1", Namespace: "NAMESPACE1", ScheduledAt: metav1.NewTime(time.Unix(10, 0).UTC())},
+	}
+
+	submitted, nextScheduledEpoch, skipReason, err := controller.submitNextWorkflowIfNeeded(
+		context.Background(), swf, active, int64(10*hour))
+	assert.Nil(t, err)
+	assert.True(t, submitted)
+	assert.Equal(t, int64(9*hour+minute), nextScheduledEpoch)
+	assert.Equal(t, "", skipReason)
+	// Only the oldest active workflow is terminated to make room for the new one.
+	assert.Equal(t, []string{`WORKFLOW1 {"spec":{"activeDeadlineSeconds":0}}`}, execClient.patched)
+	assert.Equal(t, []string{swf.NextResourceName()}, execClient.created)
+}
+
+func TestSubmitNextWorkflowIfNeeded_ReplaceConcurrentTerminateError(t *testing.T) {
+	execClient := &fakeExecutionClient{patchErr: errors.New("terminate failed")}
+	controller := newTestController(execClient)
+	swf := newTestScheduledWorkflow(t, swfapi.ReplaceConcurrent)
+	active := []swfapi.WorkflowStatus{
+		{Name: "WORKFLOW1", Namespace: "NAMESPACE1", ScheduledAt: metav1.NewTime(time.Unix(10, 0).UTC())},
+		{Name: "WORKFLOW2", Namespace: "NAMESPACE1", ScheduledAt: metav1.NewTime(time.Unix(20, 0).UTC())},
+	}
+
+	submitted, _, _, err := controller.submitNextWorkflowIfNeeded(
+		context.Background(), swf, active, int64(10*hour))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "terminate failed")
+	assert.False(t, submitted)
+	// The new workflow isn't created until the active one is terminated.
+	assert.Empty(t, execClient.created)
+}
+
+func TestSubmitNextWorkflowIfNeeded_ForbidConcurrent(t *testing.T) {
+	execClient := &fakeExecutionClient{}
+	controller := newTestController(execClient)
+	swf := newTestScheduledWorkflow(t, swfapi.ForbidConcurrent)
+	active := []swfapi.WorkflowStatus{
+		{Name: "WORKFLOW1", Namespace: "NAMESPACE1", ScheduledAt: metav1.NewTime(time.Unix(10, 0).UTC())},
+		{Name: "WORKFLOW2", Namespace: "NAMESPACE1", ScheduledAt: metav1.NewTime(time.Unix(20, 0).UTC())},
+	}
+
+	submitted, nextScheduledEpoch, skipReason, err := controller.submitNextWorkflowIfNeeded(
+		context.Background(), swf, active, int64(10*hour))
+	assert.Nil(t, err)
+	assert.False(t, submitted)
+	assert.Equal(t, int64(9*hour+minute), nextScheduledEpoch)
+	assert.Equal(t, util.SkipReasonConcurrencyPolicy, skipReason)
+	assert.Empty(t, execClient.patched)
+	assert.Empty(t, execClient.created)
+}
@@ -18,6 +18,9 @@ import (
 	"flag"
 	"strings"
 	"time"
+	// Embed the time zone database, so that the time zones of the schedules
+	// can be loaded in images without one.
+	_ "time/tzdata"
 
 	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/util"
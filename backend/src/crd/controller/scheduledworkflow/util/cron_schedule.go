@@ -80,6 +80,15 @@ func (s *CronSchedule) getNextScheduledTime(lastJobTime time.Time, location *tim
 		true /* nowEpoch doesn't matter when catchup=true */, time.Unix(0, 0), location)
 }
 
+// getLocation returns the location the schedule is evaluated in. The time zone
+// of the schedule takes precedence over the one of the controller.
+func (s *CronSchedule) getLocation(location *time.Location) (*time.Location, error) {
+	if s.TimeZone == "" {
+		return location, nil
+	}
+	return time.LoadLocation(s.TimeZone)
+}
+
 func (s *CronSchedule) getNextScheduledTimeImp(lastJobTime time.Time, catchup bool, nowTime time.Time, location *time.Location) time.Time {
 	schedule, err := cron.Parse(s.Cron)
 	if err != nil {
@@ -88,6 +97,14 @@ func (s *CronSchedule) getNextScheduledTimeImp(lastJobTime time.Time, catchup bo
 			"Found invalid schedule (%v): %v", s.Cron, err))
 		return maxTime.In(location)
 	}
+	scheduleLocation, err := s.getLocation(location)
+	if err != nil {
+		// This should never happen, validation should have caught this at resource creation.
+		log.Errorf("%+v", wraperror.Errorf(
+			"Found invalid time zone (%v): %v", s.TimeZone, err))
+		return maxTime.In(location)
+	}
+	location = scheduleLocation
 
 	startTime := lastJobTime
 	if s.StartTime != nil && s.StartTime.Time.After(startTime) {
@@ -95,6 +112,11 @@ func (s *CronSchedule) getNextScheduledTimeImp(lastJobTime time.Time, catchup bo
 	}
 
 	result := schedule.Next(startTime.In(location))
+	if isSameWallClockTime(result, startTime.In(location)) {
+		// When daylight saving time ends, the same wall clock time occurs twice.
+		// Only the first occurrence is scheduled.
+		result = schedule.Next(result)
+	}
 	var endTime time.Time = maxTime
 	if s.EndTime != nil {
 		endTime = s.EndTime.Time
@@ -123,3 +145,8 @@ func (s *CronSchedule) getNextScheduledTimeImp(lastJobTime time.Time, catchup bo
 	}
 	return next
 }
+
+func isSameWallClockTime(t1 time.Time, t2 time.Time) bool {
+	const layout = "2006-01-02 15:04:05"
+	return t1.Format(layout) == t2.Format(layout)
+}
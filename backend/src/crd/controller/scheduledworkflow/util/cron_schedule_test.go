@@ -198,3 +198,81 @@ func TestCronSchedule_GetNextScheduledTimeNoCatchup(t *testing.T) {
 	assert.Equal(t, time.Unix(10*hour+15*minute+minute, 0).UTC(),
 		schedule.GetNextScheduledTimeNoCatchup(nil, defaultStartTime, time.Unix(0, 0), location))
 }
+
+func TestCronSchedule_GetNextScheduledTime_TimeZone(t *testing.T) {
+	schedule := NewCronSchedule(&swfapi.CronSchedule{
+		Cron:     "0 0 9 * * *", // trigger every day at 09:00
+		TimeZone: "Asia/Shanghai",
+	})
+	// The schedule time zone takes precedence over the controller location.
+	location, _ := time.LoadLocation("UTC")
+	lastJobTime := v1.NewTime(time.Date(2021, 1, 10, 1, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2021, 1, 11, 1, 0, 0, 0, time.UTC),
+		schedule.GetNextScheduledTime(&lastJobTime, time.Unix(0, 0), location).UTC())
+}
+
+func TestCronSchedule_GetNextScheduledTime_InvalidTimeZone(t *testing.T) {
+	schedule := NewCronSchedule(&swfapi.CronSchedule{
+		Cron:     "0 0 9 * * *",
+		TimeZone: "Not/AZone",
+	})
+	location, _ := time.LoadLocation("America/New_York")
+	lastJobTime := v1.NewTime(time.Date(2021, 1, 10, 1, 0, 0, 0, time.UTC))
+	// Like for an invalid cron, the max time is returned in the controller location.
+	assert.Equal(t, maxTime.In(location),
+		schedule.GetNextScheduledTime(&lastJobTime, time.Unix(0, 0), location))
+}
+
+func TestCronSchedule_GetNextScheduledTime_DaylightSavingTime(t *testing.T) {
+	location, _ := time.LoadLocation("UTC")
+	tests := []struct {
+		name        string
+		cron        string
+		lastJobTime time.Time
+		expected    time.Time
+	}{
+		{
+			name:        "before spring forward",
+			cron:        "0 0 2 * * *",
+			lastJobTime: time.Date(2021, 3, 12, 7, 0, 0, 0, time.UTC), // 02:00 EST
+			expected:    time.Date(2021, 3, 13, 7, 0, 0, 0, time.UTC), // 02:00 EST
+		},
+		{
+			// 02:00 does not exist on 2021-03-14, so that day is skipped.
+			name:        "spring forward",
+			cron:        "0 0 2 * * *",
+			lastJobTime: time.Date(2021, 3, 13, 7, 0, 0, 0, time.UTC), // 02:00 EST
+			expected:    time.Date(2021, 3, 15, 6, 0, 0, 0, time.UTC), // 02:00 EDT
+		},
+		{
+			name:        "fall back",
+			cron:        "0 0 2 * * *",
+			lastJobTime: time.Date(2021, 11, 6, 6, 0, 0, 0, time.UTC), // 02:00 EDT
+			expected:    time.Date(2021, 11, 7, 7, 0, 0, 0, time.UTC), // 02:00 EST
+		},
+		{
+			name:        "first occurrence of a repeated time",
+			cron:        "0 30 1 * * *",
+			lastJobTime: time.Date(2021, 11, 6, 5, 30, 0, 0, time.UTC), // 01:30 EDT
+			expected:    time.Date(2021, 11, 7, 5, 30, 0, 0, time.UTC), // 01:30 EDT
+		},
+		{
+			// 01:30 occurs twice on 2021-11-07, but is only scheduled once.
+			name:        "second occurrence of a repeated time",
+			cron:        "0 30 1 * * *",
+			lastJobTime: time.Date(2021, 11, 7, 5, 30, 0, 0, time.UTC), // 01:30 EDT
+			expected:    time.Date(2021, 11, 8, 6, 30, 0, 0, time.UTC), // 01:30 EST
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := NewCronSchedule(&swfapi.CronSchedule{
+				Cron:     tt.cron,
+				TimeZone: "America/New_York",
+			})
+			lastJobTime := v1.NewTime(tt.lastJobTime)
+			assert.Equal(t, tt.expected,
+				schedule.GetNextScheduledTime(&lastJobTime, time.Unix(0, 0), location).UTC())
+		})
+	}
+}
@@ -67,6 +67,28 @@ func (s *PeriodicSchedule) getNextScheduledEpoch(lastJobEpoch int64) int64 {
 	return result
 }
 
+// GetNextScheduledEpochAfter returns the first epoch strictly after the given
+// epoch at which the schedule fires, or math.MaxInt64 if there is none.
+func (s *PeriodicSchedule) GetNextScheduledEpochAfter(epoch int64,
+	defaultStartEpoch int64) int64 {
+	startEpoch := defaultStartEpoch
+	if s.StartTime != nil {
+		startEpoch = s.StartTime.Unix()
+	}
+
+	// The schedule fires every interval, starting one interval after its start.
+	if epoch < startEpoch {
+		epoch = startEpoch
+	}
+	result := startEpoch + ((epoch-startEpoch)/s.getInterval()+1)*s.getInterval()
+
+	if s.EndTime != nil &&
+		s.EndTime.Unix() < result {
+		return math.MaxInt64
+	}
+	return result
+}
+
 func (s *PeriodicSchedule) getInterval() int64 {
 	interval := s.IntervalSecond
 	if interval == 0 {
@@ -162,3 +162,32 @@ func TestPeriodicSchedule_TestStarttime(t *testing.T) {
 	val := schedule.getNextScheduledEpoch(lastJobEpoch)
 	assert.Equal(t, t1.Unix(), val)
 }
+
+func TestPeriodicSchedule_GetNextScheduledEpochAfter(t *testing.T) {
+	schedule := NewPeriodicSchedule(&swfapi.PeriodicSchedule{
+		StartTime:      commonutil.Metav1TimePointer(v1.NewTime(time.Unix(10*hour, 0).UTC())),
+		EndTime:        commonutil.Metav1TimePointer(v1.NewTime(time.Unix(11*hour, 0).UTC())),
+		IntervalSecond: 60,
+	})
+	defaultStartEpoch := int64(9 * hour)
+
+	// Before the start of the schedule.
+	assert.Equal(t, int64(10*hour+minute),
+		schedule.GetNextScheduledEpochAfter(int64(8*hour), defaultStartEpoch))
+	// Aligned on the schedule.
+	assert.Equal(t, int64(10*hour+21*minute),
+		schedule.GetNextScheduledEpochAfter(int64(10*hour+20*minute), defaultStartEpoch))
+	// Between two slots.
+	assert.Equal(t, int64(10*hour+21*minute),
+		schedule.GetNextScheduledEpochAfter(int64(10*hour+20*minute+30), defaultStartEpoch))
+	// After the end of the schedule.
+	assert.Equal(t, int64(math.MaxInt64),
+		schedule.GetNextScheduledEpochAfter(int64(11*hour), defaultStartEpoch))
+
+	// No start time, falling back on the creation date of the workflow.
+	schedule = NewPeriodicSchedule(&swfapi.PeriodicSchedule{
+		IntervalSecond: 60,
+	})
+	assert.Equal(t, int64(9*hour+minute),
+		schedule.GetNextScheduledEpochAfter(int64(8*hour), defaultStartEpoch))
+}
@@ -35,8 +35,35 @@ const (
 	defaultMaxHistory     = int64(10)
 	minMaxHistory         = int64(0)
 	maxMaxHistory         = int64(100)
+	// Number of completed manual triggers whose names are kept in the status.
+	maxCompletedManualTriggers = 100
 )
 
+// ScheduleAction is what the controller should do about the next scheduled time.
+type ScheduleAction int
+
+const (
+	// ScheduleActionWait means that no workflow should be created yet.
+	ScheduleActionWait ScheduleAction = iota
+	// ScheduleActionSubmit means that a workflow should be created.
+	ScheduleActionSubmit
+	// ScheduleActionReplace means that the oldest active workflows should be
+	// terminated and a workflow should be created.
+	ScheduleActionReplace
+	// ScheduleActionSkip means that the scheduled time should be skipped.
+	ScheduleActionSkip
+)
+
+// Reasons for skipping a scheduled time.
+const (
+	SkipReasonConcurrencyPolicy        = "ConcurrencyPolicyForbid"
+	SkipReasonStartingDeadlineExceeded = "StartingDeadlineExceeded"
+)
+
+// ConditionReasonInvalidTimeZone is the reason of the error condition of a
+// cron schedule whose time zone cannot be loaded.
+const ConditionReasonInvalidTimeZone = "InvalidTimeZone"
+
 // ScheduledWorkflow is a type to help manipulate ScheduledWorkflow objects.
 type ScheduledWorkflow struct {
 	*swfapi.ScheduledWorkflow
@@ -79,6 +106,24 @@ func (s *ScheduledWorkflow) maxConcurrency() int64 {
 	return *s.Spec.MaxConcurrency
 }
 
+func (s *ScheduledWorkflow) concurrencyPolicy() swfapi.ConcurrencyPolicy {
+	switch s.Spec.ConcurrencyPolicy {
+	case swfapi.ForbidConcurrent, swfapi.ReplaceConcurrent:
+		return s.Spec.ConcurrencyPolicy
+	default:
+		return swfapi.AllowConcurrent
+	}
+}
+
+// startingDeadlineExceeded returns whether it is too late to create a workflow
+// for the scheduled epoch.
+func (s *ScheduledWorkflow) startingDeadlineExceeded(scheduledEpoch int64, nowEpoch int64) bool {
+	if s.Spec.StartingDeadlineSeconds == nil || *s.Spec.StartingDeadlineSeconds < 0 {
+		return false
+	}
+	return nowEpoch-scheduledEpoch > *s.Spec.StartingDeadlineSeconds
+}
+
 func (s *ScheduledWorkflow) maxHistory() int64 {
 	if s.Spec.MaxHistory == nil {
 		return defaultMaxHistory
@@ -123,7 +168,8 @@ func (s *ScheduledWorkflow) MinIndex() int64 {
 
 func (s *ScheduledWorkflow) isOneOffRun() bool {
 	return s.Spec.Trigger.CronSchedule == nil &&
-		s.Spec.Trigger.PeriodicSchedule == nil
+		s.Spec.Trigger.PeriodicSchedule == nil &&
+		s.Spec.Trigger.RunCompletion == nil
 }
 
 func (s *ScheduledWorkflow) nextResourceID() string {
@@ -149,9 +195,10 @@ func (s *ScheduledWorkflow) getWorkflowParametersAsMap() map[string]string {
 
 // NewWorkflow creates a workflow for this schedule. It also sets
 // the appropriate OwnerReferences on the resource so handleObject can discover
-// the Schedule resource that 'owns' it.
+// the Schedule resource that 'owns' it. The upstream run ID is empty unless the
+// workflow is triggered by the completion of an upstream run.
 func (s *ScheduledWorkflow) NewWorkflow(
-	nextScheduledEpoch int64, nowEpoch int64) (commonutil.ExecutionSpec, error) {
+	nextScheduledEpoch int64, nowEpoch int64, upstreamRunID string) (commonutil.ExecutionSpec, error) {
 
 	// Creating the workflow.
 	execSpec, err := commonutil.ScheduleSpecToExecutionSpec(commonutil.ArgoWorkflow, s.Spec.Workflow)
@@ -168,7 +215,8 @@ func (s *ScheduledWorkflow) NewWorkflow(
 	execSpec.SetExecutionName(s.NextResourceName())
 
 	// Get the workflow parameters and format them.
-	formatter := commonutil.NewSWFParameterFormatter(uuid.String(), nextScheduledEpoch, nowEpoch, s.nextIndex())
+	formatter := commonutil.NewSWFParameterFormatter(uuid.String(), nextScheduledEpoch, nowEpoch, s.nextIndex()).
+		WithUpstreamRunUUID(upstreamRunID)
 	formattedParams := formatter.FormatWorkflowParameters(s.getWorkflowParametersAsMap())
 
 	// Set the parameters.
@@ -193,26 +241,78 @@ func (s *ScheduledWorkflow) NewWorkflow(
 // and whether it should be run now.
 func (s *ScheduledWorkflow) GetNextScheduledEpoch(activeWorkflowCount int64, nowEpoch int64, location time.Location) (
 	nextScheduleEpoch int64, shouldRunNow bool) {
+	nextScheduledEpoch, action, _ := s.GetScheduleAction(activeWorkflowCount, nowEpoch, location)
+	return nextScheduledEpoch, action == ScheduleActionSubmit
+}
+
+// GetScheduleAction returns the next epoch at which a workflow should be scheduled,
+// and what should be done about it now. If the scheduled time should be skipped,
+// the returned epoch is the last scheduled time to skip, and the reason is returned.
+func (s *ScheduledWorkflow) GetScheduleAction(activeWorkflowCount int64, nowEpoch int64, location time.Location) (
+	nextScheduledEpoch int64, action ScheduleAction, skipReason string) {
 
 	// Get the next scheduled time.
-	nextScheduledEpoch := s.getNextScheduledEpoch(nowEpoch, location)
+	nextScheduledEpoch = s.getNextScheduledEpoch(nowEpoch, location)
 
 	// If the schedule is not enabled, we should not schedule the workflow now.
 	if s.enabled() == false {
-		return nextScheduledEpoch, false
+		return nextScheduledEpoch, ScheduleActionWait, ""
+	}
+
+	// If it is not yet time to schedule the next workflow...
+	if nextScheduledEpoch > nowEpoch {
+		return nextScheduledEpoch, ScheduleActionWait, ""
 	}
 
-	// If the maxConcurrency is exceeded, return.
+	// If it is too late to schedule the next workflow, all the scheduled times
+	// that are past the deadline are skipped at once.
+	if s.startingDeadlineExceeded(nextScheduledEpoch, nowEpoch) {
+		return s.getLastMissedEpoch(nextScheduledEpoch, nowEpoch, location), ScheduleActionSkip,
+			SkipReasonStartingDeadlineExceeded
+	}
+
+	// If the maxConcurrency is reached, apply the concurrency policy.
 	if activeWorkflowCount >= s.maxConcurrency() {
-		return nextScheduledEpoch, false
+		switch s.concurrencyPolicy() {
+		case swfapi.ForbidConcurrent:
+			return nextScheduledEpoch, ScheduleActionSkip, SkipReasonConcurrencyPolicy
+		case swfapi.ReplaceConcurrent:
+			return nextScheduledEpoch, ScheduleActionReplace, ""
+		default:
+			return nextScheduledEpoch, ScheduleActionWait, ""
+		}
 	}
 
-	// If it is not yet time to schedule the next workflow...
-	if nextScheduledEpoch > nowEpoch {
-		return nextScheduledEpoch, false
+	return nextScheduledEpoch, ScheduleActionSubmit, ""
+}
+
+// GetWorkflowsToReplace returns the oldest active workflows to terminate so that
+// a new workflow can be created without exceeding the max concurrency.
+func (s *ScheduledWorkflow) GetWorkflowsToReplace(active []swfapi.WorkflowStatus) []swfapi.WorkflowStatus {
+	count := int64(len(active)) - s.maxConcurrency() + 1
+	if count <= 0 {
+		return nil
 	}
+	oldest := make([]swfapi.WorkflowStatus, len(active))
+	copy(oldest, active)
+	sort.SliceStable(oldest, func(i, j int) bool {
+		return oldest[i].ScheduledAt.Unix() < oldest[j].ScheduledAt.Unix()
+	})
+	return oldest[:count]
+}
 
-	return nextScheduledEpoch, true
+// getLastMissedEpoch returns the last scheduled epoch, starting from the given
+// missed one, whose starting deadline is exceeded.
+func (s *ScheduledWorkflow) getLastMissedEpoch(missedEpoch int64, nowEpoch int64, location time.Location) int64 {
+	swf := NewScheduledWorkflow(s.Get().DeepCopy())
+	for {
+		swf.updateLastTriggeredTime(missedEpoch)
+		nextEpoch := swf.getNextScheduledEpoch(nowEpoch, location)
+		if nextEpoch <= missedEpoch || !swf.startingDeadlineExceeded(nextEpoch, nowEpoch) {
+			return missedEpoch
+		}
+		missedEpoch = nextEpoch
+	}
 }
 
 func (s *ScheduledWorkflow) getNextScheduledEpoch(nowEpoch int64, location time.Location) int64 {
@@ -234,6 +334,11 @@ func (s *ScheduledWorkflow) getNextScheduledEpoch(nowEpoch int64, location time.
 
 	}
 
+	// Run completion trigger: workflows are only created by manual triggers.
+	if s.Spec.Trigger.RunCompletion != nil {
+		return math.MaxInt64
+	}
+
 	// Cron schedule
 	if s.Spec.Trigger.CronSchedule != nil {
 		nowTime := time.Unix(nowEpoch, 0)
@@ -251,6 +356,135 @@ func (s *ScheduledWorkflow) getNextScheduledEpoch(nowEpoch int64, location time.
 	return s.getNextScheduledEpochForOneTimeRun()
 }
 
+// getNextScheduledEpochAfter returns the first epoch strictly after the given
+// epoch at which the schedule fires. The returned epoch is larger than any
+// valid time if there is none.
+func (s *ScheduledWorkflow) getNextScheduledEpochAfter(epoch int64, location time.Location) int64 {
+	if s.Spec.Trigger.PeriodicSchedule != nil {
+		return NewPeriodicSchedule(s.Spec.Trigger.PeriodicSchedule).GetNextScheduledEpochAfter(
+			epoch, s.creationEpoch())
+	}
+	if s.Spec.Trigger.CronSchedule != nil {
+		return NewCronSchedule(s.Spec.Trigger.CronSchedule).getNextScheduledTime(
+			time.Unix(epoch, 0), &location).Unix()
+	}
+	return math.MaxInt64
+}
+
+func (s *ScheduledWorkflow) getManualTriggerStatus(name string) *swfapi.ManualTriggerStatus {
+	for i := range s.Status.ManualTriggers {
+		if s.Status.ManualTriggers[i].Name == name {
+			return &s.Status.ManualTriggers[i]
+		}
+	}
+	return nil
+}
+
+// getNextManualTriggerEpoch returns the scheduled epoch of the next workflow
+// to create for the manual trigger, and whether all its workflows are created.
+func (s *ScheduledWorkflow) getNextManualTriggerEpoch(trigger *swfapi.ManualTrigger,
+	nowEpoch int64, location time.Location) (scheduledEpoch int64, done bool) {
+	if s.IsManualTriggerCompleted(trigger.Name) {
+		return 0, true
+	}
+	status := s.getManualTriggerStatus(trigger.Name)
+	if trigger.BackfillStartTime == nil || trigger.BackfillEndTime == nil {
+		// A single workflow is created immediately.
+		return nowEpoch, status != nil && status.LastScheduledTime != nil
+	}
+
+	lastEpoch := trigger.BackfillStartTime.Unix() - 1
+	if status != nil && status.LastScheduledTime != nil {
+		lastEpoch = status.LastScheduledTime.Unix()
+	}
+	scheduledEpoch = s.getNextScheduledEpochAfter(lastEpoch, location)
+	if scheduledEpoch > trigger.BackfillEndTime.Unix() {
+		return 0, true
+	}
+	return scheduledEpoch, false
+}
+
+// GetNextManualTrigger returns the manual trigger to create a workflow for,
+// the scheduled epoch of that workflow, and whether it should be created now.
+// Immediate triggers are processed before backfills. Unlike the schedule,
+// manual triggers are processed even if the schedule is disabled.
+func (s *ScheduledWorkflow) GetNextManualTrigger(activeWorkflowCount int64, nowEpoch int64,
+	location time.Location) (trigger *swfapi.ManualTrigger, scheduledEpoch int64, shouldRunNow bool) {
+	var backfill *swfapi.ManualTrigger
+	var backfillEpoch int64
+	for i := range s.Spec.ManualTriggers {
+		t := &s.Spec.ManualTriggers[i]
+		epoch, done := s.getNextManualTriggerEpoch(t, nowEpoch, location)
+		if done {
+			continue
+		}
+		if t.BackfillStartTime == nil || t.BackfillEndTime == nil {
+			return t, epoch, true
+		}
+		if backfill == nil {
+			backfill, backfillEpoch = t, epoch
+		}
+	}
+	if backfill == nil {
+		return nil, 0, false
+	}
+	return backfill, backfillEpoch, activeWorkflowCount < s.maxConcurrency()
+}
+
+// UpdateManualTriggerStatus records that a workflow scheduled at the given
+// epoch was created for the manual trigger.
+func (s *ScheduledWorkflow) UpdateManualTriggerStatus(name string, scheduledEpoch int64) {
+	scheduledTime := commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(scheduledEpoch, 0).UTC()))
+	if status := s.getManualTriggerStatus(name); status != nil {
+		status.LastScheduledTime = scheduledTime
+	} else {
+		s.Status.ManualTriggers = append(s.Status.ManualTriggers, swfapi.ManualTriggerStatus{
+			Name:              name,
+			LastScheduledTime: scheduledTime,
+		})
+	}
+	// Manual workflows share the index with the scheduled ones, but they do not
+	// move the schedule.
+	s.Status.Trigger.LastIndex = commonutil.Int64Pointer(s.nextIndex())
+}
+
+// IsManualTriggerCompleted returns whether the workflows of the manual trigger
+// with the given name were all created, even if the trigger was pruned since.
+func (s *ScheduledWorkflow) IsManualTriggerCompleted(name string) bool {
+	for _, completed := range s.Status.CompletedManualTriggers {
+		if completed == name {
+			return true
+		}
+	}
+	return false
+}
+
+// PruneManualTriggers removes the manual triggers whose workflows are all
+// created, along with their status. The names of the latest removed triggers
+// are kept in the status.
+func (s *ScheduledWorkflow) PruneManualTriggers(nowEpoch int64, location time.Location) {
+	var triggers []swfapi.ManualTrigger
+	var statuses []swfapi.ManualTriggerStatus
+	for i := range s.Spec.ManualTriggers {
+		t := s.Spec.ManualTriggers[i]
+		if _, done := s.getNextManualTriggerEpoch(&t, nowEpoch, location); done {
+			if !s.IsManualTriggerCompleted(t.Name) {
+				s.Status.CompletedManualTriggers = append(s.Status.CompletedManualTriggers, t.Name)
+			}
+			continue
+		}
+		triggers = append(triggers, t)
+		if status := s.getManualTriggerStatus(t.Name); status != nil {
+			statuses = append(statuses, *status)
+		}
+	}
+	s.Spec.ManualTriggers = triggers
+	s.Status.ManualTriggers = statuses
+	if extra := len(s.Status.CompletedManualTriggers) - maxCompletedManualTriggers; extra > 0 {
+		s.Status.CompletedManualTriggers = s.Status.CompletedManualTriggers[extra:]
+	}
+}
+
 func (s *ScheduledWorkflow) getNextScheduledEpochForOneTimeRun() int64 {
 	if s.Status.Trigger.LastTriggeredTime != nil {
 		return math.MaxInt64
@@ -273,14 +507,14 @@ func (s *ScheduledWorkflow) UpdateStatus(updatedEpoch int64, submitted bool,
 
 	updatedTime := metav1.NewTime(time.Unix(updatedEpoch, 0).UTC())
 
-	conditionType, status, message := s.getStatusAndMessage(len(active))
+	conditionType, status, reason, message := s.getStatusAndMessage(len(active))
 
 	condition := swfapi.ScheduledWorkflowCondition{
 		Type:               conditionType,
 		Status:             status,
 		LastProbeTime:      updatedTime,
 		LastTransitionTime: updatedTime,
-		Reason:             string(conditionType),
+		Reason:             reason,
 		Message:            message,
 	}
 
@@ -319,6 +553,16 @@ func (s *ScheduledWorkflow) UpdateStatus(updatedEpoch int64, submitted bool,
 	}
 }
 
+// SkipScheduledTime records that no workflow is created for the scheduled
+// epoch, and moves the schedule past it.
+func (s *ScheduledWorkflow) SkipScheduledTime(scheduledEpoch int64, reason string,
+	location *time.Location) {
+	s.updateLastTriggeredTime(scheduledEpoch)
+	s.Status.Trigger.LastSkippedTime = s.Status.Trigger.LastTriggeredTime.DeepCopy()
+	s.Status.Trigger.LastSkippedReason = reason
+	s.updateNextTriggeredTime(s.getNextScheduledEpoch(0, *location))
+}
+
 func (s *ScheduledWorkflow) updateLastTriggeredTime(epoch int64) {
 	s.Status.Trigger.LastTriggeredTime = commonutil.Metav1TimePointer(
 		metav1.NewTime(time.Unix(epoch, 0).UTC()))
@@ -333,9 +577,19 @@ func (s *ScheduledWorkflow) updateNextTriggeredTime(epoch int64) {
 	}
 }
 
+// getTimeZoneError returns the error loading the time zone of the cron
+// schedule, if any. The schedule never fires while its time zone is invalid.
+func (s *ScheduledWorkflow) getTimeZoneError() error {
+	if s.Spec.Trigger.CronSchedule == nil {
+		return nil
+	}
+	_, err := NewCronSchedule(s.Spec.Trigger.CronSchedule).getLocation(time.UTC)
+	return err
+}
+
 func (s *ScheduledWorkflow) getStatusAndMessage(activeCount int) (
 	conditionType swfapi.ScheduledWorkflowConditionType,
-	status core.ConditionStatus, message string) {
+	status core.ConditionStatus, reason string, message string) {
 	// Schedule messages
 	const (
 		ScheduleEnabledMessage   = "The schedule is enabled."
@@ -344,17 +598,27 @@ func (s *ScheduledWorkflow) getStatusAndMessage(activeCount int) (
 		ScheduleSucceededMessage = "The one-off schedule has succeeded."
 	)
 
+	if err := s.getTimeZoneError(); err != nil {
+		return swfapi.ScheduledWorkflowError, core.ConditionTrue, ConditionReasonInvalidTimeZone,
+			fmt.Sprintf("The time zone of the schedule (%v) is invalid: %v.",
+				s.Spec.Trigger.CronSchedule.TimeZone, err)
+	}
+
 	if s.isOneOffRun() {
 		if s.hasRunAtLeastOnce() && activeCount == 0 {
-			return swfapi.ScheduledWorkflowSucceeded, core.ConditionTrue, ScheduleSucceededMessage
+			return swfapi.ScheduledWorkflowSucceeded, core.ConditionTrue,
+				string(swfapi.ScheduledWorkflowSucceeded), ScheduleSucceededMessage
 		} else {
-			return swfapi.ScheduledWorkflowRunning, core.ConditionTrue, ScheduleRunningMessage
+			return swfapi.ScheduledWorkflowRunning, core.ConditionTrue,
+				string(swfapi.ScheduledWorkflowRunning), ScheduleRunningMessage
 		}
 	} else {
 		if s.enabled() {
-			return swfapi.ScheduledWorkflowEnabled, core.ConditionTrue, ScheduleEnabledMessage
+			return swfapi.ScheduledWorkflowEnabled, core.ConditionTrue,
+				string(swfapi.ScheduledWorkflowEnabled), ScheduleEnabledMessage
 		} else {
-			return swfapi.ScheduledWorkflowDisabled, core.ConditionTrue, ScheduleDisabledMessage
+			return swfapi.ScheduledWorkflowDisabled, core.ConditionTrue,
+				string(swfapi.ScheduledWorkflowDisabled), ScheduleDisabledMessage
 		}
 	}
 }
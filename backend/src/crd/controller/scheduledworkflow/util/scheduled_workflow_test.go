@@ -507,6 +507,342 @@ func TestScheduledWorkflow_GetNextScheduledEpoch_PeriodicSchedule(t *testing.T)
 
 }
 
+func TestScheduledWorkflow_GetScheduleAction_ConcurrencyPolicy(t *testing.T) {
+	nowEpoch := int64(10 * hour)
+	tests := []struct {
+		policy         swfapi.ConcurrencyPolicy
+		expectedAction ScheduleAction
+		expectedReason string
+	}{
+		{"", ScheduleActionWait, ""},
+		{swfapi.AllowConcurrent, ScheduleActionWait, ""},
+		{swfapi.ForbidConcurrent, ScheduleActionSkip, SkipReasonConcurrencyPolicy},
+		{swfapi.ReplaceConcurrent, ScheduleActionReplace, ""},
+	}
+	for _, tt := range tests {
+		schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+			ObjectMeta: metav1.ObjectMeta{
+				CreationTimestamp: metav1.NewTime(time.Unix(9*hour, 0).UTC()),
+			},
+			Spec: swfapi.ScheduledWorkflowSpec{
+				Enabled:           true,
+				MaxConcurrency:    commonutil.Int64Pointer(int64(1)),
+				ConcurrencyPolicy: tt.policy,
+				Trigger: swfapi.Trigger{
+					PeriodicSchedule: &swfapi.PeriodicSchedule{
+						IntervalSecond: int64(minute),
+					},
+				},
+			},
+		})
+
+		// Max concurrency is reached.
+		nextScheduledEpoch, action, reason := schedule.GetScheduleAction(
+			int64(1) /* active workflow count */, nowEpoch, time.Location{})
+		assert.Equal(t, tt.expectedAction, action, "policy %q", tt.policy)
+		assert.Equal(t, tt.expectedReason, reason, "policy %q", tt.policy)
+		assert.Equal(t, int64(9*hour+minute), nextScheduledEpoch)
+
+		// Max concurrency is not reached.
+		nextScheduledEpoch, action, reason = schedule.GetScheduleAction(
+			int64(0) /* active workflow count */, nowEpoch, time.Location{})
+		assert.Equal(t, ScheduleActionSubmit, action, "policy %q", tt.policy)
+		assert.Equal(t, "", reason)
+		assert.Equal(t, int64(9*hour+minute), nextScheduledEpoch)
+	}
+}
+
+func TestScheduledWorkflow_GetScheduleAction_StartingDeadline(t *testing.T) {
+	nowEpoch := int64(10 * hour)
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Unix(9*hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:                 true,
+			MaxConcurrency:          commonutil.Int64Pointer(int64(1)),
+			StartingDeadlineSeconds: commonutil.Int64Pointer(int64(5 * minute)),
+			Trigger: swfapi.Trigger{
+				PeriodicSchedule: &swfapi.PeriodicSchedule{
+					IntervalSecond: int64(minute),
+				},
+			},
+		},
+	})
+
+	// All the scheduled times past the deadline are skipped at once, even if
+	// the max concurrency is reached.
+	for _, activeWorkflowCount := range []int64{0, 1} {
+		nextScheduledEpoch, action, reason := schedule.GetScheduleAction(
+			activeWorkflowCount, nowEpoch, time.Location{})
+		assert.Equal(t, ScheduleActionSkip, action)
+		assert.Equal(t, SkipReasonStartingDeadlineExceeded, reason)
+		assert.Equal(t, int64(9*hour+54*minute), nextScheduledEpoch)
+	}
+
+	// The next scheduled time is within the deadline.
+	schedule.Status.Trigger.LastTriggeredTime = commonutil.Metav1TimePointer(
+		metav1.NewTime(time.Unix(9*hour+54*minute, 0).UTC()))
+	nextScheduledEpoch, action, reason := schedule.GetScheduleAction(
+		int64(0) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, ScheduleActionSubmit, action)
+	assert.Equal(t, "", reason)
+	assert.Equal(t, int64(9*hour+55*minute), nextScheduledEpoch)
+}
+
+func TestScheduledWorkflow_SkipScheduledTime(t *testing.T) {
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Unix(9*hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled: true,
+			Trigger: swfapi.Trigger{
+				PeriodicSchedule: &swfapi.PeriodicSchedule{
+					IntervalSecond: int64(minute),
+				},
+			},
+		},
+	})
+	location, _ := time.LoadLocation("UTC")
+	schedule.SkipScheduledTime(int64(9*hour+minute), SkipReasonConcurrencyPolicy, location)
+
+	expected := swfapi.TriggerStatus{
+		LastTriggeredTime: commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(9*hour+minute, 0).UTC())),
+		NextTriggeredTime: commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(9*hour+2*minute, 0).UTC())),
+		LastSkippedTime:   commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(9*hour+minute, 0).UTC())),
+		LastSkippedReason: SkipReasonConcurrencyPolicy,
+	}
+	assert.Equal(t, expected, schedule.Status.Trigger)
+}
+
+func TestScheduledWorkflow_UpdateStatus_InvalidTimeZone(t *testing.T) {
+	nowEpoch := int64(11 * hour)
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Unix(9*hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled: true,
+			Trigger: swfapi.Trigger{
+				CronSchedule: &swfapi.CronSchedule{
+					Cron:     "0 0 * * * *",
+					TimeZone: "Not/AZone",
+				},
+			},
+		},
+	})
+
+	// The job is never scheduled.
+	nextScheduledEpoch, action, skipReason := schedule.GetScheduleAction(
+		int64(0) /* active workflow count */, nowEpoch, *time.UTC)
+	assert.Equal(t, maxTime.Unix(), nextScheduledEpoch)
+	assert.Equal(t, ScheduleActionWait, action)
+	assert.Equal(t, "", skipReason)
+
+	schedule.UpdateStatus(nowEpoch, false, nextScheduledEpoch, nil, nil, time.UTC)
+
+	assert.Nil(t, schedule.Status.Trigger.NextTriggeredTime)
+	assert.Equal(t, 1, len(schedule.Status.Conditions))
+	condition := schedule.Status.Conditions[0]
+	assert.Equal(t, swfapi.ScheduledWorkflowError, condition.Type)
+	assert.Equal(t, core.ConditionTrue, condition.Status)
+	assert.Equal(t, ConditionReasonInvalidTimeZone, condition.Reason)
+	assert.Contains(t, condition.Message, "Not/AZone")
+	assert.Equal(t, string(swfapi.ScheduledWorkflowError),
+		schedule.Labels[commonutil.LabelKeyScheduledWorkflowStatus])
+}
+
+func TestScheduledWorkflow_GetWorkflowsToReplace(t *testing.T) {
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		Spec: swfapi.ScheduledWorkflowSpec{
+			MaxConcurrency: commonutil.Int64Pointer(int64(2)),
+		},
+	})
+	active := []swfapi.WorkflowStatus{
+		{Name: "wf3", ScheduledAt: metav1.NewTime(time.Unix(30, 0).UTC())},
+		{Name: "wf1", ScheduledAt: metav1.NewTime(time.Unix(10, 0).UTC())},
+		{Name: "wf2", ScheduledAt: metav1.NewTime(time.Unix(20, 0).UTC())},
+	}
+	assert.Equal(t, []swfapi.WorkflowStatus{active[1], active[2]}, schedule.GetWorkflowsToReplace(active))
+	assert.Equal(t, "wf3", active[0].Name)
+
+	// There is room for a new workflow.
+	assert.Empty(t, schedule.GetWorkflowsToReplace(active[:1]))
+}
+
+func TestScheduledWorkflow_GetNextManualTrigger_RunNow(t *testing.T) {
+	nowEpoch := int64(10 * hour)
+	lastTriggeredTime := commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(9*hour, 0).UTC()))
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled: false,
+			Trigger: swfapi.Trigger{
+				CronSchedule: &swfapi.CronSchedule{
+					Cron: "0 0 * * * *", // trigger every hour
+				},
+			},
+			ManualTriggers: []swfapi.ManualTrigger{{Name: "now"}},
+		},
+		Status: swfapi.ScheduledWorkflowStatus{
+			Trigger: swfapi.TriggerStatus{
+				LastTriggeredTime: lastTriggeredTime,
+				LastIndex:         commonutil.Int64Pointer(4),
+			},
+		},
+	})
+
+	// Runs now, even if the schedule is disabled and the concurrency is reached.
+	trigger, scheduledEpoch, shouldRunNow := schedule.GetNextManualTrigger(
+		int64(1) /* active workflow count */, nowEpoch, *time.UTC)
+	assert.Equal(t, "now", trigger.Name)
+	assert.Equal(t, nowEpoch, scheduledEpoch)
+	assert.True(t, shouldRunNow)
+
+	schedule.UpdateManualTriggerStatus(trigger.Name, scheduledEpoch)
+	assert.Equal(t, int64(5), schedule.lastIndex())
+	assert.Equal(t, lastTriggeredTime, schedule.Status.Trigger.LastTriggeredTime)
+
+	// The trigger is done.
+	trigger, _, _ = schedule.GetNextManualTrigger(0, nowEpoch+minute, *time.UTC)
+	assert.Nil(t, trigger)
+	schedule.PruneManualTriggers(nowEpoch+minute, *time.UTC)
+	assert.Empty(t, schedule.Spec.ManualTriggers)
+	assert.Empty(t, schedule.Status.ManualTriggers)
+	assert.Equal(t, []string{"now"}, schedule.Status.CompletedManualTriggers)
+}
+
+func TestScheduledWorkflow_GetNextManualTrigger_Backfill(t *testing.T) {
+	nowEpoch := int64(20 * hour)
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Unix(hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:        true,
+			MaxConcurrency: commonutil.Int64Pointer(2),
+			NoCatchup:      commonutil.BoolPointer(true),
+			Trigger: swfapi.Trigger{
+				CronSchedule: &swfapi.CronSchedule{
+					Cron: "0 0 * * * *", // trigger every hour
+				},
+			},
+			ManualTriggers: []swfapi.ManualTrigger{{
+				Name:              "backfill",
+				BackfillStartTime: commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(10*hour, 0).UTC())),
+				BackfillEndTime:   commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(12*hour+30*minute, 0).UTC())),
+			}},
+		},
+	})
+
+	var scheduledEpochs []int64
+	for i := 0; i < 3; i++ {
+		trigger, scheduledEpoch, shouldRunNow := schedule.GetNextManualTrigger(
+			int64(1) /* active workflow count */, nowEpoch, *time.UTC)
+		assert.Equal(t, "backfill", trigger.Name)
+		assert.True(t, shouldRunNow)
+		schedule.UpdateManualTriggerStatus(trigger.Name, scheduledEpoch)
+		schedule.PruneManualTriggers(nowEpoch, *time.UTC)
+		scheduledEpochs = append(scheduledEpochs, scheduledEpoch)
+	}
+	assert.Equal(t, []int64{10 * hour, 11 * hour, 12 * hour}, scheduledEpochs)
+	assert.Equal(t, int64(3), schedule.lastIndex())
+	assert.Nil(t, schedule.Status.Trigger.LastTriggeredTime)
+
+	trigger, _, _ := schedule.GetNextManualTrigger(0, nowEpoch, *time.UTC)
+	assert.Nil(t, trigger)
+	schedule.PruneManualTriggers(nowEpoch, *time.UTC)
+	assert.Empty(t, schedule.Spec.ManualTriggers)
+	assert.Empty(t, schedule.Status.ManualTriggers)
+	assert.Equal(t, []string{"backfill"}, schedule.Status.CompletedManualTriggers)
+}
+
+func TestScheduledWorkflow_GetNextManualTrigger_Completed(t *testing.T) {
+	nowEpoch := int64(10 * hour)
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:        true,
+			ManualTriggers: []swfapi.ManualTrigger{{Name: "now"}},
+		},
+		Status: swfapi.ScheduledWorkflowStatus{
+			CompletedManualTriggers: []string{"now"},
+		},
+	})
+
+	// A trigger that is requested again after it was pruned is not run twice.
+	trigger, _, _ := schedule.GetNextManualTrigger(0, nowEpoch, *time.UTC)
+	assert.Nil(t, trigger)
+	schedule.PruneManualTriggers(nowEpoch, *time.UTC)
+	assert.Empty(t, schedule.Spec.ManualTriggers)
+	assert.Equal(t, []string{"now"}, schedule.Status.CompletedManualTriggers)
+}
+
+func TestScheduledWorkflow_PruneManualTriggers_KeepsLatestCompleted(t *testing.T) {
+	nowEpoch := int64(10 * hour)
+	var completed []string
+	for i := 0; i < maxCompletedManualTriggers; i++ {
+		completed = append(completed, "trigger-"+strconv.Itoa(i))
+	}
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:        true,
+			ManualTriggers: []swfapi.ManualTrigger{{Name: "now"}},
+		},
+		Status: swfapi.ScheduledWorkflowStatus{
+			CompletedManualTriggers: completed,
+		},
+	})
+
+	schedule.UpdateManualTriggerStatus("now", nowEpoch)
+	schedule.PruneManualTriggers(nowEpoch, *time.UTC)
+	assert.Equal(t, append(completed[1:], "now"), schedule.Status.CompletedManualTriggers)
+}
+
+func TestScheduledWorkflow_GetNextManualTrigger_BackfillMaxConcurrency(t *testing.T) {
+	nowEpoch := int64(20 * hour)
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Unix(hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:        true,
+			MaxConcurrency: commonutil.Int64Pointer(2),
+			Trigger: swfapi.Trigger{
+				PeriodicSchedule: &swfapi.PeriodicSchedule{
+					IntervalSecond: int64(hour),
+				},
+			},
+			ManualTriggers: []swfapi.ManualTrigger{
+				{
+					Name:              "backfill",
+					BackfillStartTime: commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(10*hour, 0).UTC())),
+					BackfillEndTime:   commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(12*hour, 0).UTC())),
+				},
+				{Name: "now"},
+			},
+		},
+	})
+
+	// The backfill waits for the active workflows, the immediate trigger doesn't.
+	trigger, scheduledEpoch, shouldRunNow := schedule.GetNextManualTrigger(
+		int64(2) /* active workflow count */, nowEpoch, *time.UTC)
+	assert.Equal(t, "now", trigger.Name)
+	assert.Equal(t, nowEpoch, scheduledEpoch)
+	assert.True(t, shouldRunNow)
+	schedule.UpdateManualTriggerStatus(trigger.Name, scheduledEpoch)
+
+	trigger, scheduledEpoch, shouldRunNow = schedule.GetNextManualTrigger(
+		int64(2) /* active workflow count */, nowEpoch, *time.UTC)
+	assert.Equal(t, "backfill", trigger.Name)
+	assert.Equal(t, int64(10*hour), scheduledEpoch)
+	assert.False(t, shouldRunNow)
+
+	schedule.PruneManualTriggers(nowEpoch, *time.UTC)
+	assert.Len(t, schedule.Spec.ManualTriggers, 1)
+	assert.Equal(t, "backfill", schedule.Spec.ManualTriggers[0].Name)
+	assert.Empty(t, schedule.Status.ManualTriggers)
+}
+
 func TestScheduledWorkflow_GetNextScheduledEpoch_UpdateStatus_NoWorkflow(t *testing.T) {
 	// Must run now
 	scheduledEpoch := int64(10 * hour)
@@ -704,7 +1040,7 @@ func TestScheduledWorkflow_NewWorkflow(t *testing.T) {
 		},
 	}, commonutil.NewFakeUUIDGeneratorOrFatal("123e4567-e89b-12d3-a456-426655440001", nil)}
 
-	result, err := schedule.NewWorkflow(scheduledEpoch, nowEpoch)
+	result, err := schedule.NewWorkflow(scheduledEpoch, nowEpoch, "")
 	assert.Nil(t, err)
 
 	expected := &workflowapi.Workflow{
@@ -785,7 +1121,7 @@ func TestScheduledWorkflow_NewWorkflow_Parameterized(t *testing.T) {
 		},
 	}, commonutil.NewFakeUUIDGeneratorOrFatal("123e4567-e89b-12d3-a456-426655440001", nil)}
 
-	result, err := schedule.NewWorkflow(scheduledEpoch, nowEpoch)
+	result, err := schedule.NewWorkflow(scheduledEpoch, nowEpoch, "")
 	assert.Nil(t, err)
 	expected := &workflowapi.Workflow{
 		TypeMeta: metav1.TypeMeta{
@@ -824,3 +1160,71 @@ func TestScheduledWorkflow_NewWorkflow_Parameterized(t *testing.T) {
 
 	assert.Equal(t, expected, result.(*commonutil.Workflow).Get())
 }
+
+func TestScheduledWorkflow_NewWorkflow_UpstreamRun(t *testing.T) {
+	spec, err := json.Marshal(workflowapi.WorkflowSpec{
+		Arguments: workflowapi.Arguments{
+			Parameters: []workflowapi.Parameter{
+				{Name: "PARAM1", Value: workflowapi.AnyStringPtr("VALUE1")},
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	schedule := ScheduledWorkflow{&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "SCHEDULE1",
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled: true,
+			Trigger: swfapi.Trigger{
+				RunCompletion: &swfapi.RunCompletionTrigger{
+					UpstreamType: "Job",
+					UpstreamID:   "job1",
+				},
+			},
+			Workflow: &swfapi.WorkflowResource{
+				Parameters: []swfapi.Parameter{
+					{Name: "PARAM1", Value: "NEW_VALUE1_[[UpstreamRunUUID]]"},
+				},
+				Spec: string(spec),
+			},
+		},
+	}, commonutil.NewFakeUUIDGeneratorOrFatal("123e4567-e89b-12d3-a456-426655440001", nil)}
+
+	result, err := schedule.NewWorkflow(int64(10*hour), int64(11*hour), "upstream-run-1")
+	assert.Nil(t, err)
+	assert.Equal(t, []workflowapi.Parameter{
+		{Name: "PARAM1", Value: workflowapi.AnyStringPtr("NEW_VALUE1_upstream-run-1")},
+	}, result.(*commonutil.Workflow).Spec.Arguments.Parameters)
+}
+
+func TestScheduledWorkflow_GetNextScheduledEpoch_RunCompletion(t *testing.T) {
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Unix(9*hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled: true,
+			Trigger: swfapi.Trigger{
+				RunCompletion: &swfapi.RunCompletionTrigger{
+					UpstreamType: "Job",
+					UpstreamID:   "job1",
+				},
+			},
+		},
+	})
+
+	// Workflows are only created for manual triggers.
+	nextScheduledEpoch, mustRunNow := schedule.GetNextScheduledEpoch(
+		int64(0) /* active workflow count */, int64(10*hour), time.Location{})
+	assert.Equal(t, false, mustRunNow)
+	assert.Equal(t, int64(math.MaxInt64), nextScheduledEpoch)
+	assert.Equal(t, false, schedule.isOneOffRun())
+
+	schedule.Spec.ManualTriggers = []swfapi.ManualTrigger{{Name: "trigger1", UpstreamRunID: "run1"}}
+	trigger, _, shouldRunNow := schedule.GetNextManualTrigger(
+		int64(0) /* active workflow count */, int64(10*hour), time.Location{})
+	assert.Equal(t, true, shouldRunNow)
+	assert.Equal(t, "run1", trigger.UpstreamRunID)
+}
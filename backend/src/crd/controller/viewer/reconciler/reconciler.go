@@ -16,7 +16,7 @@
 // reconciler takes care of the main logic for ensuring every Viewer CRD
 // corresponds to a unique deployment and backing service. The service is
 // annotated such that it is compatible with Ambassador managed routing.
-// Currently, only supports Tensorboard viewers. Adding a new viewer CRD for
+// Viewer types are registered in viewerTemplates. Adding a new viewer CRD for
 // tensorboard with the name 'abc123' will result in the tensorboard instance
 // serving under the path '/tensorboard/abc123'.
 package reconciler
@@ -86,15 +86,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	}
 	glog.Infof("Got instance: %+v", view)
 
-	// Ignore other viewer types for now.
-	if view.Spec.Type != viewerV1beta1.ViewerTypeTensorboard {
+	// Ignore viewer types that are not registered.
+	tpl, ok := viewerTemplates[view.Spec.Type]
+	if !ok {
 		glog.Infof("Unsupported spec type: %q", view.Spec.Type)
 		// Return nil to indicate nothing more to do here.
 		return reconcile.Result{}, nil
 	}
 
-	if len(view.Spec.TensorboardSpec.TensorflowImage) == 0 {
-		view.Spec.TensorboardSpec.TensorflowImage = defaultTensorflowImage
+	if err := tpl.validate(view); err != nil {
+		utilruntime.HandleError(err)
+		// User error, don't requeue key.
+		return reconcile.Result{}, nil
 	}
 
 	// Check and maybe delete the oldest viewer before creating the next one.
@@ -104,7 +107,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	}
 
 	// Set up potential deployment.
-	dpl, err := deploymentFrom(view)
+	dpl, err := deploymentFrom(view, tpl)
 	if err != nil {
 		utilruntime.HandleError(err)
 		// User error, don't requeue key.
@@ -137,7 +140,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	glog.Infof("Created new deployment with spec: %+v", dpl)
 
 	// Set up a service for the deployment above.
-	svc := serviceFrom(view, dpl.Name)
+	svc := serviceFrom(view, dpl.Name, tpl.targetPort(view))
 	// Set the service to be owned by the view instance as well.
 	if err := controllerutil.SetControllerReference(view, svc, r.scheme); err != nil {
 		// Error means that the service is already owned by some other instance.
@@ -165,6 +168,48 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	return reconcile.Result{}, nil
 }
 
+// viewerTemplate describes how viewers of a type are launched. A new viewer
+// type is added with a variant of ViewerSpec for the type, and a template
+// registered in viewerTemplates.
+type viewerTemplate struct {
+	// validate checks the viewer spec of the type and fills in defaults.
+	validate func(view *viewerV1beta1.Viewer) error
+	// setPodSpec sets up the viewer in the pod spec of the viewer deployment.
+	setPodSpec func(view *viewerV1beta1.Viewer, s *corev1.PodSpec)
+	// targetPort is the container port the viewer service routes to.
+	targetPort func(view *viewerV1beta1.Viewer) int32
+}
+
+var viewerTemplates = map[viewerV1beta1.ViewerType]viewerTemplate{
+	viewerV1beta1.ViewerTypeTensorboard: {
+		validate:   validateTensorboard,
+		setPodSpec: setPodSpecForTensorboard,
+		targetPort: func(*viewerV1beta1.Viewer) int32 { return viewerTargetPort },
+	},
+	viewerV1beta1.ViewerTypeArtifact: {
+		validate:   validateArtifact,
+		setPodSpec: setPodSpecForArtifact,
+		targetPort: func(*viewerV1beta1.Viewer) int32 { return artifactServerPort },
+	},
+	viewerV1beta1.ViewerTypeCustom: {
+		validate:   validateCustom,
+		setPodSpec: setPodSpecForCustom,
+		targetPort: func(view *viewerV1beta1.Viewer) int32 { return view.Spec.CustomSpec.Port },
+	},
+}
+
+// pathPrefix is the path a viewer serves under.
+func pathPrefix(v *viewerV1beta1.Viewer) string {
+	return fmt.Sprintf("/%s/%s/", v.Spec.Type, v.Name)
+}
+
+func validateTensorboard(view *viewerV1beta1.Viewer) error {
+	if len(view.Spec.TensorboardSpec.TensorflowImage) == 0 {
+		view.Spec.TensorboardSpec.TensorflowImage = defaultTensorflowImage
+	}
+	return nil
+}
+
 func setPodSpecForTensorboard(view *viewerV1beta1.Viewer, s *corev1.PodSpec) {
 	if len(s.Containers) == 0 {
 		s.Containers = append(s.Containers, corev1.Container{})
@@ -176,7 +221,7 @@ func setPodSpecForTensorboard(view *viewerV1beta1.Viewer, s *corev1.PodSpec) {
 	c.Args = []string{
 		"tensorboard",
 		fmt.Sprintf("--logdir=%s", view.Spec.TensorboardSpec.LogDir),
-		fmt.Sprintf("--path_prefix=/tensorboard/%s/", view.Name),
+		fmt.Sprintf("--path_prefix=%s", pathPrefix(view)),
 	}
 	isTensorflowV1 := false
 	parts := strings.Split(view.Spec.TensorboardSpec.TensorflowImage, ":")
@@ -199,7 +244,7 @@ func setPodSpecForTensorboard(view *viewerV1beta1.Viewer, s *corev1.PodSpec) {
 
 }
 
-func deploymentFrom(view *viewerV1beta1.Viewer) (*appsv1.Deployment, error) {
+func deploymentFrom(view *viewerV1beta1.Viewer, tpl viewerTemplate) (*appsv1.Deployment, error) {
 	name := view.Name + "-deployment"
 	dpl := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
@@ -226,12 +271,10 @@ func deploymentFrom(view *viewerV1beta1.Viewer) (*appsv1.Deployment, error) {
 	dpl.Spec.Template.ObjectMeta.Labels["app"] = "viewer"
 	dpl.Spec.Template.ObjectMeta.Labels["viewer"] = view.Name
 
-	switch view.Spec.Type {
-	case viewerV1beta1.ViewerTypeTensorboard:
-		setPodSpecForTensorboard(view, &dpl.Spec.Template.Spec)
-	default:
+	if tpl.setPodSpec == nil {
 		return nil, fmt.Errorf("unknown viewer type: %q", view.Spec.Type)
 	}
+	tpl.setPodSpec(view, &dpl.Spec.Template.Spec)
 
 	return dpl, nil
 }
@@ -245,9 +288,9 @@ prefix: %s
 rewrite: %s
 service: %s`
 
-func serviceFrom(v *viewerV1beta1.Viewer, deploymentName string) *corev1.Service {
+func serviceFrom(v *viewerV1beta1.Viewer, deploymentName string, targetPort int32) *corev1.Service {
 	name := v.Name + "-service"
-	path := fmt.Sprintf("/%s/%s/", v.Spec.Type, v.Name)
+	path := pathPrefix(v)
 	mapping := fmt.Sprintf(mappingTpl, v.Name, path, path, name)
 
 	return &corev1.Service{
@@ -271,7 +314,7 @@ func serviceFrom(v *viewerV1beta1.Viewer, deploymentName string) *corev1.Service
 					Name:       "http",
 					Protocol:   corev1.ProtocolTCP,
 					Port:       80,
-					TargetPort: intstr.IntOrString{IntVal: viewerTargetPort}},
+					TargetPort: intstr.IntOrString{IntVal: targetPort}},
 			},
 		},
 	}
@@ -0,0 +1,134 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	viewerV1beta1 "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/viewer/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	artifactServerPort         = 8080
+	defaultArtifactServerImage = "python:3.7-slim"
+	// The downloader images are pinned, so that the viewers don't change with
+	// the latest tags.
+	gcsDownloaderImage   = "google/cloud-sdk:352.0.0-alpine"
+	minioDownloaderImage = "minio/mc:RELEASE.2022-10-12T18-12-50Z"
+	// artifactServerRoot is the directory served by the artifact server. The
+	// source is downloaded to the path prefix of the viewer in this directory,
+	// because ambassador doesn't rewrite the path prefix.
+	artifactServerRoot  = "/srv"
+	artifactVolumeName  = "artifact"
+	envViewerPathPrefix = "VIEWER_PATH_PREFIX"
+	containerNameSuffix = "-pod"
+)
+
+func validateArtifact(view *viewerV1beta1.Viewer) error {
+	spec := view.Spec.ArtifactSpec
+	if spec == nil || spec.Source == "" {
+		return fmt.Errorf("viewer %q of type %q has no artifactSpec.source", view.Name, view.Spec.Type)
+	}
+	if _, _, err := artifactDownloader(spec.Source, "/"); err != nil {
+		return fmt.Errorf("invalid viewer %q: %w", view.Name, err)
+	}
+	if spec.ServerImage == "" {
+		spec.ServerImage = defaultArtifactServerImage
+	}
+	return nil
+}
+
+// artifactDownloader returns the image and command that download source
+// to the dest directory.
+func artifactDownloader(source, dest string) (image string, command []string, err error) {
+	switch {
+	case strings.HasPrefix(source, "gs://"):
+		return gcsDownloaderImage, []string{"gsutil", "-m", "cp", "-r", source, dest}, nil
+	case strings.HasPrefix(source, "minio://"):
+		return minioDownloaderImage, []string{"mc", "cp", "--recursive", "minio/" + strings.TrimPrefix(source, "minio://"), dest}, nil
+	case strings.HasPrefix(source, "s3://"):
+		return minioDownloaderImage, []string{"mc", "cp", "--recursive", "s3/" + strings.TrimPrefix(source, "s3://"), dest}, nil
+	default:
+		return "", nil, fmt.Errorf("unsupported artifact source %q: only gs://, minio:// and s3:// are supported", source)
+	}
+}
+
+func setPodSpecForArtifact(view *viewerV1beta1.Viewer, s *corev1.PodSpec) {
+	if len(s.Containers) == 0 {
+		s.Containers = append(s.Containers, corev1.Container{})
+	}
+	c := &s.Containers[0]
+	// The downloader mounts the destination directory as a subpath of the
+	// volume, which creates it, so that no shell is needed to run the command.
+	subPath := strings.Trim(pathPrefix(view), "/")
+	dest := path.Join(artifactServerRoot, subPath)
+	image, command, _ := artifactDownloader(view.Spec.ArtifactSpec.Source, dest+"/")
+	mount := corev1.VolumeMount{Name: artifactVolumeName, MountPath: artifactServerRoot}
+	downloadMount := corev1.VolumeMount{Name: artifactVolumeName, MountPath: dest, SubPath: subPath}
+
+	s.Volumes = append(s.Volumes, corev1.Volume{
+		Name:         artifactVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+	// The downloader shares credentials configured for the viewer container.
+	s.InitContainers = append(s.InitContainers, corev1.Container{
+		Name:         view.Name + "-download",
+		Image:        image,
+		Command:      command,
+		Env:          c.Env,
+		EnvFrom:      c.EnvFrom,
+		VolumeMounts: append(append([]corev1.VolumeMount{}, c.VolumeMounts...), downloadMount),
+	})
+
+	c.Name = view.Name + containerNameSuffix
+	c.Image = view.Spec.ArtifactSpec.ServerImage
+	c.Args = []string{
+		"python3", "-m", "http.server", fmt.Sprint(artifactServerPort),
+		"--directory", artifactServerRoot,
+	}
+	c.VolumeMounts = append(c.VolumeMounts, mount)
+	c.Ports = []corev1.ContainerPort{
+		{ContainerPort: artifactServerPort},
+	}
+}
+
+func validateCustom(view *viewerV1beta1.Viewer) error {
+	spec := view.Spec.CustomSpec
+	if spec == nil || spec.Image == "" {
+		return fmt.Errorf("viewer %q of type %q has no customSpec.image", view.Name, view.Spec.Type)
+	}
+	if spec.Port <= 0 || spec.Port > 65535 {
+		return fmt.Errorf("viewer %q of type %q has invalid customSpec.port %d", view.Name, view.Spec.Type, spec.Port)
+	}
+	return nil
+}
+
+func setPodSpecForCustom(view *viewerV1beta1.Viewer, s *corev1.PodSpec) {
+	if len(s.Containers) == 0 {
+		s.Containers = append(s.Containers, corev1.Container{})
+	}
+	c := &s.Containers[0]
+	c.Name = view.Name + containerNameSuffix
+	c.Image = view.Spec.CustomSpec.Image
+	c.Command = view.Spec.CustomSpec.Command
+	c.Args = view.Spec.CustomSpec.Args
+	c.Env = append(c.Env, corev1.EnvVar{Name: envViewerPathPrefix, Value: pathPrefix(view)})
+	c.Ports = []corev1.ContainerPort{
+		{ContainerPort: view.Spec.CustomSpec.Port},
+	}
+}
@@ -62,6 +62,18 @@ type ScheduledWorkflowSpec struct {
 	// +optional
 	NoCatchup *bool `json:"noCatchup,omitempty"`
 
+	// Specifies how to treat a scheduled time at which MaxConcurrency workflows
+	// are still active.
+	// ConcurrencyPolicy defaults to Allow if not specified.
+	// +optional
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// Deadline in seconds for creating a workflow after its scheduled time.
+	// Scheduled times that are missed by more than the deadline are skipped.
+	// If StartingDeadlineSeconds is not specified, there is no deadline.
+	// +optional
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
 	// Max number of completed workflows to keep track of.
 	// If MaxHistory is not specified, MaxHistory is 10.
 	// MaxHistory cannot be smaller than 0.
@@ -76,10 +88,32 @@ type ScheduledWorkflowSpec struct {
 	// +optional
 	Workflow *WorkflowResource `json:"workflow,omitempty"`
 
+	// Requests to create workflows outside of the schedule. The controller
+	// removes a request once all its workflows are created.
+	// +optional
+	ManualTriggers []ManualTrigger `json:"manualTriggers,omitempty"`
+
 	// TODO: support additional resource types: K8 jobs, etc.
 
 }
 
+// ConcurrencyPolicy describes how the controller treats a scheduled time at
+// which MaxConcurrency workflows are still active.
+type ConcurrencyPolicy string
+
+const (
+	// AllowConcurrent waits for an active workflow to complete before creating
+	// the workflow.
+	AllowConcurrent ConcurrencyPolicy = "Allow"
+
+	// ForbidConcurrent skips the scheduled time.
+	ForbidConcurrent ConcurrencyPolicy = "Forbid"
+
+	// ReplaceConcurrent terminates the oldest active workflow and creates the
+	// workflow.
+	ReplaceConcurrent ConcurrencyPolicy = "Replace"
+)
+
 type WorkflowResource struct {
 	// List of parameters to substitute in the workflow template.
 	// The parameter values may include special strings that the controller will substitute:
@@ -116,6 +150,44 @@ type Trigger struct {
 
 	// Create workflows periodically.
 	PeriodicSchedule *PeriodicSchedule `json:"periodicSchedule,omitempty"`
+
+	// Create workflows when runs of an upstream resource complete. The
+	// workflows are requested as manual triggers by the API server.
+	RunCompletion *RunCompletionTrigger `json:"runCompletion,omitempty"`
+}
+
+type RunCompletionTrigger struct {
+	// Type of the upstream resource, either "Job" or "PipelineVersion".
+	UpstreamType string `json:"upstreamType,omitempty"`
+
+	// ID of the upstream resource.
+	UpstreamID string `json:"upstreamId,omitempty"`
+
+	// Terminal states of the upstream runs that trigger a workflow.
+	// +optional
+	States []string `json:"states,omitempty"`
+}
+
+// ManualTrigger is a request to create workflows outside of the schedule.
+type ManualTrigger struct {
+	// Name of the request, unique within the ScheduledWorkflow.
+	Name string `json:"name"`
+
+	// If no backfill window is specified, a single workflow is created
+	// immediately, even if MaxConcurrency is reached.
+	// Otherwise, a workflow is created for every time within
+	// [BackfillStartTime, BackfillEndTime] at which the schedule fires. The
+	// workflows are created in order and respect MaxConcurrency.
+	// +optional
+	BackfillStartTime *metav1.Time `json:"backfillStartTime,omitempty"`
+
+	// +optional
+	BackfillEndTime *metav1.Time `json:"backfillEndTime,omitempty"`
+
+	// ID of the upstream run whose completion caused the request, if any. It is
+	// substituted for [[UpstreamRunUUID]] in the workflow parameters.
+	// +optional
+	UpstreamRunID string `json:"upstreamRunId,omitempty"`
 }
 
 type CronSchedule struct {
@@ -133,6 +205,12 @@ type CronSchedule struct {
 	// time interval defined by StartTime and EndTime.
 	// +optional
 	Cron string `json:"cron,omitempty"`
+
+	// IANA time zone in which the cron string is interpreted, e.g.
+	// "America/New_York". If no time zone is specified, the time zone of the
+	// controller is used.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
 }
 
 type PeriodicSchedule struct {
@@ -164,6 +242,24 @@ type ScheduledWorkflowStatus struct {
 
 	// Status of workflow resources.
 	WorkflowHistory *WorkflowHistory `json:"workflowHistory,omitempty"`
+
+	// Progress of the manual triggers that are being processed.
+	// +optional
+	ManualTriggers []ManualTriggerStatus `json:"manualTriggers,omitempty"`
+
+	// Names of the latest manual triggers whose workflows are all created,
+	// oldest first. They are kept once the triggers are removed, so that a
+	// trigger is not requested twice.
+	// +optional
+	CompletedManualTriggers []string `json:"completedManualTriggers,omitempty"`
+}
+
+type ManualTriggerStatus struct {
+	// Name of the manual trigger.
+	Name string `json:"name"`
+
+	// Scheduled time of the last workflow created for the manual trigger.
+	LastScheduledTime *metav1.Time `json:"lastScheduledTime,omitempty"`
 }
 
 type ScheduledWorkflowConditionType string
@@ -205,6 +301,15 @@ type TriggerStatus struct {
 
 	// Index of the last workflow created.
 	LastIndex *int64 `json:"lastWorkflowIndex,omitempty"`
+
+	// Last scheduled time for which no workflow was created. The schedule
+	// moves past skipped times as if workflows were created for them.
+	// +optional
+	LastSkippedTime *metav1.Time `json:"lastSkippedTime,omitempty"`
+
+	// Reason why the last skipped time was skipped.
+	// +optional
+	LastSkippedReason string `json:"lastSkippedReason,omitempty"`
 }
 
 type WorkflowHistory struct {
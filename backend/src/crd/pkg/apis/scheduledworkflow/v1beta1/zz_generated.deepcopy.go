@@ -46,6 +46,50 @@ func (in *CronSchedule) DeepCopy() *CronSchedule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManualTrigger) DeepCopyInto(out *ManualTrigger) {
+	*out = *in
+	if in.BackfillStartTime != nil {
+		in, out := &in.BackfillStartTime, &out.BackfillStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.BackfillEndTime != nil {
+		in, out := &in.BackfillEndTime, &out.BackfillEndTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManualTrigger.
+func (in *ManualTrigger) DeepCopy() *ManualTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(ManualTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManualTriggerStatus) DeepCopyInto(out *ManualTriggerStatus) {
+	*out = *in
+	if in.LastScheduledTime != nil {
+		in, out := &in.LastScheduledTime, &out.LastScheduledTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManualTriggerStatus.
+func (in *ManualTriggerStatus) DeepCopy() *ManualTriggerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManualTriggerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Parameter) DeepCopyInto(out *Parameter) {
 	*out = *in
@@ -86,6 +130,27 @@ func (in *PeriodicSchedule) DeepCopy() *PeriodicSchedule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunCompletionTrigger) DeepCopyInto(out *RunCompletionTrigger) {
+	*out = *in
+	if in.States != nil {
+		in, out := &in.States, &out.States
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunCompletionTrigger.
+func (in *RunCompletionTrigger) DeepCopy() *RunCompletionTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(RunCompletionTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduledWorkflow) DeepCopyInto(out *ScheduledWorkflow) {
 	*out = *in
@@ -178,6 +243,11 @@ func (in *ScheduledWorkflowSpec) DeepCopyInto(out *ScheduledWorkflowSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.StartingDeadlineSeconds != nil {
+		in, out := &in.StartingDeadlineSeconds, &out.StartingDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
 	if in.MaxHistory != nil {
 		in, out := &in.MaxHistory, &out.MaxHistory
 		*out = new(int64)
@@ -189,6 +259,13 @@ func (in *ScheduledWorkflowSpec) DeepCopyInto(out *ScheduledWorkflowSpec) {
 		*out = new(WorkflowResource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ManualTriggers != nil {
+		in, out := &in.ManualTriggers, &out.ManualTriggers
+		*out = make([]ManualTrigger, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -218,6 +295,18 @@ func (in *ScheduledWorkflowStatus) DeepCopyInto(out *ScheduledWorkflowStatus) {
 		*out = new(WorkflowHistory)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ManualTriggers != nil {
+		in, out := &in.ManualTriggers, &out.ManualTriggers
+		*out = make([]ManualTriggerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CompletedManualTriggers != nil {
+		in, out := &in.CompletedManualTriggers, &out.CompletedManualTriggers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -244,6 +333,11 @@ func (in *Trigger) DeepCopyInto(out *Trigger) {
 		*out = new(PeriodicSchedule)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RunCompletion != nil {
+		in, out := &in.RunCompletion, &out.RunCompletion
+		*out = new(RunCompletionTrigger)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -273,6 +367,10 @@ func (in *TriggerStatus) DeepCopyInto(out *TriggerStatus) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.LastSkippedTime != nil {
+		in, out := &in.LastSkippedTime, &out.LastSkippedTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
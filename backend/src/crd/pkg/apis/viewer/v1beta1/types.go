@@ -44,8 +44,7 @@ type ViewerList struct {
 	Items []Viewer `json:"items"`
 }
 
-// ViewerType is the underlying type of the view. Currently, only Tensorboard is
-// explicitly supported by the Viewer CRD.
+// ViewerType is the underlying type of the view.
 type ViewerType string
 
 const (
@@ -53,6 +52,15 @@ const (
 	// underlying type is Tensorboard. An instance named `instance123` will serve
 	// under /tensorboard/instance123.
 	ViewerTypeTensorboard ViewerType = "tensorboard"
+	// ViewerTypeArtifact is the ViewerType constant used to indicate that the
+	// underlying type is a static file server of artifacts in the object store,
+	// e.g. HTML visualizations. An instance named `instance123` will serve under
+	// /artifact/instance123.
+	ViewerTypeArtifact ViewerType = "artifact"
+	// ViewerTypeCustom is the ViewerType constant used to indicate that the
+	// underlying type is a custom container image serving HTTP on a port. An
+	// instance named `instance123` will serve under /custom/instance123.
+	ViewerTypeCustom ViewerType = "custom"
 )
 
 // TensorboardSpec contains fields specific to launching a tensorboard instance.
@@ -63,12 +71,41 @@ type TensorboardSpec struct {
 	TensorflowImage string `json:"tensorflowImage"`
 }
 
+// ArtifactSpec contains fields specific to launching a viewer of artifacts in
+// the object store.
+type ArtifactSpec struct {
+	// Source is the URI of a file or directory in the object store, i.e.,
+	// gs://bucket/path, minio://bucket/path or s3://bucket/path. minio and s3
+	// sources are downloaded with the mc client, which is configured with the
+	// MC_HOST_minio and MC_HOST_s3 environment variables respectively.
+	// Environment variables, environment sources and volume mounts of the first
+	// container in PodTemplateSpec are used to download the source.
+	Source string `json:"source"`
+	// ServerImage is the image of the static file server. Optional.
+	ServerImage string `json:"serverImage,omitempty"`
+}
+
+// CustomSpec contains fields specific to launching a custom viewer image.
+type CustomSpec struct {
+	// Image is the image of the viewer.
+	Image   string   `json:"image"`
+	Command []string `json:"command,omitempty"`
+	Args    []string `json:"args,omitempty"`
+	// Port is the container port the viewer serves HTTP on. The viewer must serve
+	// under the path prefix in the VIEWER_PATH_PREFIX environment variable.
+	Port int32 `json:"port"`
+}
+
 // ViewerSpec is the spec for a Viewer resource.
 type ViewerSpec struct {
 	// Type is the type of the viewer.
 	Type ViewerType `json:"type"`
 	// TensorboardSpec is only checked if the Type is ViewerTypeTensorboard.
 	TensorboardSpec TensorboardSpec `json:"tensorboardSpec,omitempty"`
+	// ArtifactSpec is only checked if the Type is ViewerTypeArtifact.
+	ArtifactSpec *ArtifactSpec `json:"artifactSpec,omitempty"`
+	// CustomSpec is only checked if the Type is ViewerTypeCustom.
+	CustomSpec *CustomSpec `json:"customSpec,omitempty"`
 	// PodTemplateSpec is the template spec used to launch the viewer.
 	PodTemplateSpec v1.PodTemplateSpec `json:"podTemplateSpec"`
 }
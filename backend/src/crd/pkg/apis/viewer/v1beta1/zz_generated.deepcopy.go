@@ -22,6 +22,48 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactSpec) DeepCopyInto(out *ArtifactSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactSpec.
+func (in *ArtifactSpec) DeepCopy() *ArtifactSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomSpec) DeepCopyInto(out *CustomSpec) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomSpec.
+func (in *CustomSpec) DeepCopy() *CustomSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TensorboardSpec) DeepCopyInto(out *TensorboardSpec) {
 	*out = *in
@@ -102,6 +144,16 @@ func (in *ViewerList) DeepCopyObject() runtime.Object {
 func (in *ViewerSpec) DeepCopyInto(out *ViewerSpec) {
 	*out = *in
 	out.TensorboardSpec = in.TensorboardSpec
+	if in.ArtifactSpec != nil {
+		in, out := &in.ArtifactSpec, &out.ArtifactSpec
+		*out = new(ArtifactSpec)
+		**out = **in
+	}
+	if in.CustomSpec != nil {
+		in, out := &in.CustomSpec, &out.CustomSpec
+		*out = new(CustomSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	in.PodTemplateSpec.DeepCopyInto(&out.PodTemplateSpec)
 	return
 }
@@ -7,10 +7,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/peterhellberg/duration"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/cachekey"
@@ -140,25 +143,57 @@ func cacheDefaultEndpoint() string {
 	return defaultKfpApiEndpoint
 }
 
-func (c *Client) GetExecutionCache(fingerPrint, pipelineName, namespace string) (string, error) {
-	fingerPrintPredicate := &api.Predicate{
-		Op:    api.Predicate_EQUALS,
-		Key:   "fingerprint",
-		Value: &api.Predicate_StringValue{StringValue: fingerPrint},
+// MaxCacheStaleness resolves the maximum cache staleness in seconds of a task,
+// from the staleness of the task, and the default and maximum staleness of the
+// deployment. All of them are ISO 8601 durations, and are optional.
+// -1 means cached executions never go stale.
+func MaxCacheStaleness(taskStaleness, defaultStaleness, maximumStaleness string) (int64, error) {
+	task, err := stalenessToSeconds(taskStaleness)
+	if err != nil {
+		return 0, fmt.Errorf("invalid max cache staleness of the task: %w", err)
 	}
-	pipelineNamePredicate := &api.Predicate{
-		Op:    api.Predicate_EQUALS,
-		Key:   "pipelineName",
-		Value: &api.Predicate_StringValue{StringValue: pipelineName},
+	defaultValue, err := stalenessToSeconds(defaultStaleness)
+	if err != nil {
+		return 0, fmt.Errorf("invalid default cache staleness: %w", err)
 	}
-	namespacePredicate := &api.Predicate{
-		Op:    api.Predicate_EQUALS,
-		Key:   "namespace",
-		Value: &api.Predicate_StringValue{StringValue: namespace},
+	maximum, err := stalenessToSeconds(maximumStaleness)
+	if err != nil {
+		return 0, fmt.Errorf("invalid maximum cache staleness: %w", err)
+	}
+	staleness := task
+	if staleness < 0 {
+		staleness = defaultValue
+	}
+	if maximum >= 0 && (staleness < 0 || staleness > maximum) {
+		staleness = maximum
+	}
+	return staleness, nil
+}
+
+func stalenessToSeconds(staleness string) (int64, error) {
+	if staleness == "" {
+		return -1, nil
+	}
+	d, err := duration.Parse(staleness)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %q as an ISO 8601 duration: %w", staleness, err)
 	}
-	filter := api.Filter{Predicates: []*api.Predicate{fingerPrintPredicate, pipelineNamePredicate, namespacePredicate}}
+	if d < 0 {
+		return 0, fmt.Errorf("staleness %q is negative", staleness)
+	}
+	return int64(d / time.Second), nil
+}
 
-	taskFilterJson, err := protojson.Marshal(&filter)
+// GetExecutionCache returns the MLMD execution ID of the latest cached
+// execution with the fingerprint, that is no older than maxCacheStaleness
+// seconds. -1 means any cached execution can be reused, and 0 means no cached
+// execution can be reused.
+func (c *Client) GetExecutionCache(fingerPrint, pipelineName, namespace string, maxCacheStaleness int64) (string, error) {
+	if maxCacheStaleness == 0 {
+		return "", nil
+	}
+	filter := executionCacheFilter(fingerPrint, pipelineName, namespace, maxCacheStaleness, time.Now())
+	taskFilterJson, err := protojson.Marshal(filter)
 	if err != nil {
 		return "", fmt.Errorf("failed to convert filter into JSON: %w", err)
 	}
@@ -175,6 +210,33 @@ func (c *Client) GetExecutionCache(fingerPrint, pipelineName, namespace string)
 	}
 }
 
+func executionCacheFilter(fingerPrint, pipelineName, namespace string, maxCacheStaleness int64, now time.Time) *api.Filter {
+	fingerPrintPredicate := &api.Predicate{
+		Op:    api.Predicate_EQUALS,
+		Key:   "fingerprint",
+		Value: &api.Predicate_StringValue{StringValue: fingerPrint},
+	}
+	pipelineNamePredicate := &api.Predicate{
+		Op:    api.Predicate_EQUALS,
+		Key:   "pipelineName",
+		Value: &api.Predicate_StringValue{StringValue: pipelineName},
+	}
+	namespacePredicate := &api.Predicate{
+		Op:    api.Predicate_EQUALS,
+		Key:   "namespace",
+		Value: &api.Predicate_StringValue{StringValue: namespace},
+	}
+	filter := &api.Filter{Predicates: []*api.Predicate{fingerPrintPredicate, pipelineNamePredicate, namespacePredicate}}
+	if maxCacheStaleness > 0 {
+		filter.Predicates = append(filter.Predicates, &api.Predicate{
+			Op:    api.Predicate_GREATER_THAN_EQUALS,
+			Key:   "created_at",
+			Value: &api.Predicate_TimestampValue{TimestampValue: timestamppb.New(now.Add(-time.Duration(maxCacheStaleness) * time.Second))},
+		})
+	}
+	return filter
+}
+
 func (c *Client) CreateExecutionCache(ctx context.Context, task *api.Task) error {
 	req := &api.CreateTaskRequest{
 		Task: task,
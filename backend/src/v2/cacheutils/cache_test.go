@@ -4,14 +4,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/cachekey"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestGenerateCacheKey(t *testing.T) {
@@ -264,3 +267,55 @@ func TestGenerateFingerPrint(t *testing.T) {
 		})
 	}
 }
+
+func TestMaxCacheStaleness(t *testing.T) {
+	tests := []struct {
+		name      string
+		task      string
+		dflt      string
+		maximum   string
+		want      int64
+		wantError bool
+	}{
+		{name: "nothing configured", want: -1},
+		{name: "task staleness", task: "P1D", want: 86400},
+		{name: "default staleness", dflt: "PT1H", want: 3600},
+		{name: "task staleness overrides default", task: "PT30M", dflt: "PT1H", want: 1800},
+		{name: "task staleness is capped by maximum", task: "P30D", maximum: "P7D", want: 7 * 86400},
+		{name: "default staleness is capped by maximum", dflt: "P30D", maximum: "P7D", want: 7 * 86400},
+		{name: "maximum applies without staleness", maximum: "P7D", want: 7 * 86400},
+		{name: "zero disables cache", task: "P0D", dflt: "P1D", want: 0},
+		{name: "invalid task staleness", task: "30 days", wantError: true},
+		{name: "invalid maximum staleness", maximum: "-P1D", wantError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := MaxCacheStaleness(test.task, test.dflt, test.maximum)
+			if test.wantError {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestExecutionCacheFilter(t *testing.T) {
+	now := time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC)
+	filter := executionCacheFilter("fingerprint", "pipeline/my-pipeline", "kubeflow", 3600, now)
+	want := &api.Filter{Predicates: []*api.Predicate{
+		{Op: api.Predicate_EQUALS, Key: "fingerprint", Value: &api.Predicate_StringValue{StringValue: "fingerprint"}},
+		{Op: api.Predicate_EQUALS, Key: "pipelineName", Value: &api.Predicate_StringValue{StringValue: "pipeline/my-pipeline"}},
+		{Op: api.Predicate_EQUALS, Key: "namespace", Value: &api.Predicate_StringValue{StringValue: "kubeflow"}},
+		{Op: api.Predicate_GREATER_THAN_EQUALS, Key: "created_at", Value: &api.Predicate_TimestampValue{TimestampValue: timestamppb.New(now.Add(-time.Hour))}},
+	}}
+	if diff := cmp.Diff(want, filter, protocmp.Transform()); diff != "" {
+		t.Errorf("executionCacheFilter() mismatch (-want +got):\n%s", diff)
+	}
+
+	filter = executionCacheFilter("fingerprint", "pipeline/my-pipeline", "kubeflow", -1, now)
+	if diff := cmp.Diff(want.Predicates[:3], filter.Predicates, protocmp.Transform()); diff != "" {
+		t.Errorf("executionCacheFilter() without staleness mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -40,7 +40,7 @@ const (
 
 var (
 	// inputs
-	driverType        = flag.String(driverTypeArg, "", "task driver type, one of ROOT_DAG, DAG, CONTAINER")
+	driverType        = flag.String(driverTypeArg, "", "task driver type, one of ROOT_DAG, DAG, DAG_OUTPUTS, CONTAINER, RESOLVER")
 	pipelineName      = flag.String("pipeline_name", "", "pipeline context name")
 	runID             = flag.String("run_id", "", "pipeline run uid")
 	componentSpecJson = flag.String("component", "{}", "component spec")
@@ -52,9 +52,14 @@ var (
 	dagExecutionID    = flag.Int64("dag_execution_id", 0, "DAG execution ID")
 	containerSpecJson = flag.String("container", "{}", "container spec")
 
+	// resolver inputs
+	resolverSpecJson = flag.String("resolver", "{}", "resolver spec")
+
 	// config
-	mlmdServerAddress = flag.String("mlmd_server_address", "", "MLMD server address")
-	mlmdServerPort    = flag.String("mlmd_server_port", "", "MLMD server port")
+	mlmdServerAddress     = flag.String("mlmd_server_address", "", "MLMD server address")
+	mlmdServerPort        = flag.String("mlmd_server_port", "", "MLMD server port")
+	defaultCacheStaleness = flag.String("default_cache_staleness", "", "default max cache staleness of tasks, as an ISO 8601 duration")
+	maximumCacheStaleness = flag.String("maximum_cache_staleness", "", "upper bound of the max cache staleness of tasks, as an ISO 8601 duration")
 
 	// output paths
 	executionIDPath    = flag.String("execution_id_path", "", "Exeucution ID output path")
@@ -118,6 +123,11 @@ func drive() (err error) {
 	if err := jsonpb.UnmarshalString(*containerSpecJson, containerSpec); err != nil {
 		return fmt.Errorf("failed to unmarshal container spec, error: %w\ncontainerSpec: %v", err, containerSpecJson)
 	}
+	glog.Infof("input ResolverSpec:%s\n", prettyPrint(*resolverSpecJson))
+	resolverSpec := &pipelinespec.PipelineDeploymentConfig_ResolverSpec{}
+	if err := jsonpb.UnmarshalString(*resolverSpecJson, resolverSpec); err != nil {
+		return fmt.Errorf("failed to unmarshal resolver spec, error: %w\nresolverSpec: %v", err, resolverSpecJson)
+	}
 	var runtimeConfig *pipelinespec.PipelineJob_RuntimeConfig
 	if *runtimeConfigJson != "" {
 		glog.Infof("input RuntimeConfig:%s\n", prettyPrint(*runtimeConfigJson))
@@ -155,9 +165,16 @@ func drive() (err error) {
 		execution, driverErr = driver.RootDAG(ctx, options, client)
 	case "DAG":
 		execution, driverErr = driver.DAG(ctx, options, client)
+	case "DAG_OUTPUTS":
+		execution, driverErr = driver.DAGOutputs(ctx, options, client)
 	case "CONTAINER":
 		options.Container = containerSpec
+		options.DefaultCacheStaleness = *defaultCacheStaleness
+		options.MaximumCacheStaleness = *maximumCacheStaleness
 		execution, driverErr = driver.Container(ctx, options, client, cacheClient)
+	case "RESOLVER":
+		options.Resolver = resolverSpec
+		execution, driverErr = driver.Resolver(ctx, options, client)
 	default:
 		err = fmt.Errorf("unknown driverType %s", *driverType)
 	}
@@ -19,10 +19,14 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"os"
+	"strconv"
 
 	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
 	"github.com/kubeflow/pipelines/backend/src/v2/component"
 	"github.com/kubeflow/pipelines/backend/src/v2/config"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // TODO: use https://github.com/spf13/cobra as a framework to create more complex CLI tools with subcommands.
@@ -41,6 +45,10 @@ var (
 	podUID            = flag.String("pod_uid", "", "Kubernetes Pod UID.")
 	mlmdServerAddress = flag.String("mlmd_server_address", "", "The MLMD gRPC server address.")
 	mlmdServerPort    = flag.String("mlmd_server_port", "8080", "The MLMD gRPC server port.")
+	preCacheCheckJSON = flag.String("pre_cache_check", "", "The JSON-encoded pre-cache-check lifecycle hook of the container.")
+	enableCache       = flag.Bool("enable_cache", false, "Whether to look up the cache after the pre-cache-check lifecycle hook.")
+	maxCacheStaleness = flag.Int64("max_cache_staleness", -1, "The max cache staleness in seconds of the task, -1 means cached executions never go stale.")
+	containerImage    = flag.String("container_image", "", "The image of the container, used to compute the cache fingerprint.")
 )
 
 func main() {
@@ -64,6 +72,10 @@ func run() error {
 	if err != nil {
 		return err
 	}
+	retryAttempt, err := retryAttempt()
+	if err != nil {
+		return err
+	}
 	launcherV2Opts := &component.LauncherV2Options{
 		Namespace:         namespace,
 		PodName:           *podName,
@@ -72,6 +84,17 @@ func run() error {
 		MLMDServerPort:    *mlmdServerPort,
 		PipelineName:      *pipelineName,
 		RunID:             *runID,
+		RetryAttempt:      retryAttempt,
+		EnableCache:       *enableCache,
+		MaxCacheStaleness: *maxCacheStaleness,
+		ContainerImage:    *containerImage,
+	}
+	if *preCacheCheckJSON != "" {
+		hook := &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_Lifecycle_Exec{}
+		if err := protojson.Unmarshal([]byte(*preCacheCheckJSON), hook); err != nil {
+			return fmt.Errorf("failed to unmarshal pre-cache-check lifecycle hook: %w", err)
+		}
+		launcherV2Opts.PreCacheCheck = hook
 	}
 
 	switch *executorType {
@@ -106,6 +129,20 @@ func run() error {
 
 }
 
+// retryAttempt reads the retry attempt of the task from env. The env var is
+// only set when the task has a retry policy, otherwise it's the first attempt.
+func retryAttempt() (int, error) {
+	value, ok := os.LookupEnv(component.EnvRetryAttempt)
+	if !ok || value == "" {
+		return 0, nil
+	}
+	attempt, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse env %s=%q: %w", component.EnvRetryAttempt, value, err)
+	}
+	return attempt, nil
+}
+
 // Use WARNING default logging level to facilitate troubleshooting.
 func init() {
 	flag.Set("logtostderr", "true")
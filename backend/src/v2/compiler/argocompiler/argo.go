@@ -35,6 +35,10 @@ type Options struct {
 	DriverImage string
 	// optional
 	PipelineRoot string
+	// optional, the default and maximum cache staleness of tasks configured
+	// for the deployment, as ISO 8601 durations
+	DefaultCacheStaleness string
+	MaximumCacheStaleness string
 	// TODO(Bobgy): add an option -- dev mode, ImagePullPolicy should only be Always in dev mode.
 }
 
@@ -123,6 +127,8 @@ func Compile(jobArg *pipelinespec.PipelineJob, opts *Options) (*wfapi.Workflow,
 		if opts.PipelineRoot != "" {
 			job.RuntimeConfig.GcsOutputDirectory = opts.PipelineRoot
 		}
+		c.defaultCacheStaleness = opts.DefaultCacheStaleness
+		c.maximumCacheStaleness = opts.MaximumCacheStaleness
 	}
 
 	// compile
@@ -146,10 +152,9 @@ type workflowCompiler struct {
 	templates     map[string]*wfapi.Template
 	driverImage   string
 	launcherImage string
-}
-
-func (c *workflowCompiler) Resolver(name string, component *pipelinespec.ComponentSpec, resolver *pipelinespec.PipelineDeploymentConfig_ResolverSpec) error {
-	return fmt.Errorf("resolver not implemented yet")
+	// passed to the container driver when configured
+	defaultCacheStaleness string
+	maximumCacheStaleness string
 }
 
 var errAlreadyExists = fmt.Errorf("template already exists")
@@ -232,6 +237,7 @@ const (
 	paramTask           = "task"           // task spec
 	paramContainer      = "container"      // container spec
 	paramImporter       = "importer"       // importer spec
+	paramResolver       = "resolver"       // resolver spec
 	paramRuntimeConfig  = "runtime-config" // job runtime config, pipeline level inputs
 	paramParentDagID    = "parent-dag-id"
 	paramExecutionID    = "execution-id"
@@ -242,6 +248,11 @@ const (
 	paramCachedDecision = "cached-decision" // indicate hit cache or not
 	paramPodSpecPatch   = "pod-spec-patch"  // a strategic patch merged with the pod spec
 	paramCondition      = "condition"       // condition = false -> skip the task
+	// task retry policy
+	paramRetryMaxCount           = "retry-max-count"
+	paramRetryBackoffDuration    = "retry-backoff-duration"
+	paramRetryBackoffFactor      = "retry-backoff-factor"
+	paramRetryBackoffMaxDuration = "retry-backoff-max-duration"
 )
 
 func runID() string {
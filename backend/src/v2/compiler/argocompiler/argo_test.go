@@ -43,6 +43,22 @@ func Test_argo_compiler(t *testing.T) {
 			jobPath:      "../testdata/importer.json",
 			argoYAMLPath: "testdata/importer.yaml",
 		},
+		{
+			jobPath:      "../testdata/resolver.json",
+			argoYAMLPath: "testdata/resolver.yaml",
+		},
+		{
+			jobPath:      "../testdata/retry.json",
+			argoYAMLPath: "testdata/retry.yaml",
+		},
+		{
+			jobPath:      "../testdata/loop_parallelism.json",
+			argoYAMLPath: "testdata/loop_parallelism.yaml",
+		},
+		{
+			jobPath:      "../testdata/dag_outputs.json",
+			argoYAMLPath: "testdata/dag_outputs.yaml",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(fmt.Sprintf("%+v", tt), func(t *testing.T) {
@@ -15,10 +15,14 @@
 package argocompiler
 
 import (
+	"math"
+	"time"
+
 	wfapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
 	"github.com/kubeflow/pipelines/backend/src/v2/component"
 	k8score "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 const (
@@ -84,6 +88,25 @@ func (c *workflowCompiler) addContainerDriverTemplate() string {
 	if ok {
 		return name
 	}
+	args := []string{
+		"--type", "CONTAINER",
+		"--pipeline_name", c.spec.GetPipelineInfo().GetName(),
+		"--run_id", runID(),
+		"--dag_execution_id", inputValue(paramParentDagID),
+		"--component", inputValue(paramComponent),
+		"--task", inputValue(paramTask),
+		"--container", inputValue(paramContainer),
+		"--iteration_index", inputValue(paramIterationIndex),
+		"--cached_decision_path", outputPath(paramCachedDecision),
+		"--pod_spec_patch_path", outputPath(paramPodSpecPatch),
+		"--condition_path", outputPath(paramCondition),
+	}
+	if c.defaultCacheStaleness != "" {
+		args = append(args, "--default_cache_staleness", c.defaultCacheStaleness)
+	}
+	if c.maximumCacheStaleness != "" {
+		args = append(args, "--maximum_cache_staleness", c.maximumCacheStaleness)
+	}
 	t := &wfapi.Template{
 		Name: name,
 		Inputs: wfapi.Inputs{
@@ -103,21 +126,9 @@ func (c *workflowCompiler) addContainerDriverTemplate() string {
 			},
 		},
 		Container: &k8score.Container{
-			Image:   c.driverImage,
-			Command: []string{"driver"},
-			Args: []string{
-				"--type", "CONTAINER",
-				"--pipeline_name", c.spec.GetPipelineInfo().GetName(),
-				"--run_id", runID(),
-				"--dag_execution_id", inputValue(paramParentDagID),
-				"--component", inputValue(paramComponent),
-				"--task", inputValue(paramTask),
-				"--container", inputValue(paramContainer),
-				"--iteration_index", inputValue(paramIterationIndex),
-				"--cached_decision_path", outputPath(paramCachedDecision),
-				"--pod_spec_patch_path", outputPath(paramPodSpecPatch),
-				"--condition_path", outputPath(paramCondition),
-			},
+			Image:     c.driverImage,
+			Command:   []string{"driver"},
+			Args:      args,
 			Resources: driverResources,
 		},
 	}
@@ -133,6 +144,8 @@ type containerExecutorInputs struct {
 	cachedDecision string
 	// if false, the container will be skipped.
 	condition string
+	// optional, when the task should be retried on failure.
+	retryPolicy *pipelinespec.PipelineTaskSpec_RetryPolicy
 }
 
 // containerExecutorTask returns an argo workflows DAGTask.
@@ -144,15 +157,20 @@ func (c *workflowCompiler) containerExecutorTask(name string, inputs containerEx
 	if inputs.condition != "" {
 		when = inputs.condition + " != false"
 	}
+	params := []wfapi.Parameter{
+		{Name: paramPodSpecPatch, Value: wfapi.AnyStringPtr(inputs.podSpecPatch)},
+		{Name: paramCachedDecision, Value: wfapi.AnyStringPtr(inputs.cachedDecision), Default: wfapi.AnyStringPtr("false")},
+	}
+	retry := inputs.retryPolicy.GetMaxRetryCount() > 0
+	if retry {
+		params = append(params, retryParameters(inputs.retryPolicy)...)
+	}
 	return &wfapi.DAGTask{
 		Name:     name,
-		Template: c.addContainerExecutorTemplate(),
+		Template: c.addContainerExecutorTemplate(retry),
 		When:     when,
 		Arguments: wfapi.Arguments{
-			Parameters: []wfapi.Parameter{
-				{Name: paramPodSpecPatch, Value: wfapi.AnyStringPtr(inputs.podSpecPatch)},
-				{Name: paramCachedDecision, Value: wfapi.AnyStringPtr(inputs.cachedDecision), Default: wfapi.AnyStringPtr("false")},
-			},
+			Parameters: params,
 		},
 	}
 }
@@ -161,31 +179,50 @@ func (c *workflowCompiler) containerExecutorTask(name string, inputs containerEx
 // any container component task.
 // During runtime, it's expected that pod-spec-patch will specify command, args
 // and resources etc, that are different for different tasks.
-func (c *workflowCompiler) addContainerExecutorTemplate() string {
+// When retry is true, a variant of the template with an argo retry strategy
+// is added instead. Retry limit and backoff come from the task's retry policy
+// as input parameters, so the variant is shared by all tasks with retries.
+func (c *workflowCompiler) addContainerExecutorTemplate(retry bool) string {
 	// container template is parent of container implementation template
 	nameContainerExecutor := "system-container-executor"
 	nameContainerImpl := "system-container-impl"
+	if retry {
+		nameContainerExecutor = "retry-" + nameContainerExecutor
+		nameContainerImpl = "retry-" + nameContainerImpl
+	}
 	_, ok := c.templates[nameContainerExecutor]
 	if ok {
 		return nameContainerExecutor
 	}
+	inputs := []wfapi.Parameter{
+		{Name: paramPodSpecPatch},
+		{Name: paramCachedDecision, Default: wfapi.AnyStringPtr("false")},
+	}
+	implInputs := []wfapi.Parameter{
+		{Name: paramPodSpecPatch},
+	}
+	implArgs := []wfapi.Parameter{{
+		Name:  paramPodSpecPatch,
+		Value: wfapi.AnyStringPtr(inputParameter(paramPodSpecPatch)),
+	}}
+	if retry {
+		for _, name := range retryParameterNames {
+			inputs = append(inputs, wfapi.Parameter{Name: name})
+			implInputs = append(implInputs, wfapi.Parameter{Name: name})
+			implArgs = append(implArgs, wfapi.Parameter{Name: name, Value: wfapi.AnyStringPtr(inputParameter(name))})
+		}
+	}
 	container := &wfapi.Template{
 		Name: nameContainerExecutor,
 		Inputs: wfapi.Inputs{
-			Parameters: []wfapi.Parameter{
-				{Name: paramPodSpecPatch},
-				{Name: paramCachedDecision, Default: wfapi.AnyStringPtr("false")},
-			},
+			Parameters: inputs,
 		},
 		DAG: &wfapi.DAGTemplate{
 			Tasks: []wfapi.DAGTask{{
 				Name:     "executor",
 				Template: nameContainerImpl,
 				Arguments: wfapi.Arguments{
-					Parameters: []wfapi.Parameter{{
-						Name:  paramPodSpecPatch,
-						Value: wfapi.AnyStringPtr(inputParameter(paramPodSpecPatch)),
-					}},
+					Parameters: implArgs,
 				},
 				// When cached decision is true, the container
 				// implementation template will be skipped, but
@@ -197,13 +234,31 @@ func (c *workflowCompiler) addContainerExecutorTemplate() string {
 		},
 	}
 	c.templates[nameContainerExecutor] = container
+	envs := commonEnvs
+	var retryStrategy *wfapi.RetryStrategy
+	if retry {
+		limit := intstr.FromString(inputValue(paramRetryMaxCount))
+		factor := intstr.FromString(inputValue(paramRetryBackoffFactor))
+		retryStrategy = &wfapi.RetryStrategy{
+			Limit: &limit,
+			Backoff: &wfapi.Backoff{
+				Duration:    inputValue(paramRetryBackoffDuration),
+				Factor:      &factor,
+				MaxDuration: inputValue(paramRetryBackoffMaxDuration),
+			},
+		}
+		// The launcher records the attempt number in MLMD.
+		envs = append(append([]k8score.EnvVar{}, commonEnvs...), k8score.EnvVar{
+			Name:  component.EnvRetryAttempt,
+			Value: "{{retries}}",
+		})
+	}
 	executor := &wfapi.Template{
 		Name: nameContainerImpl,
 		Inputs: wfapi.Inputs{
-			Parameters: []wfapi.Parameter{
-				{Name: paramPodSpecPatch},
-			},
+			Parameters: implInputs,
 		},
+		RetryStrategy: retryStrategy,
 		// PodSpecPatch input param is where actual image, command and
 		// args come from. It is treated as a strategic merge patch on
 		// top of the Pod spec.
@@ -240,10 +295,49 @@ func (c *workflowCompiler) addContainerExecutorTemplate() string {
 				MountPath: component.VolumePathKFPLauncher,
 			}},
 			EnvFrom: []k8score.EnvFromSource{metadataEnvFrom},
-			Env:     commonEnvs,
+			Env:     envs,
 		},
 	}
 	c.templates[nameContainerImpl] = executor
 	c.wf.Spec.Templates = append(c.wf.Spec.Templates, *container, *executor)
 	return nameContainerExecutor
 }
+
+var retryParameterNames = []string{
+	paramRetryMaxCount,
+	paramRetryBackoffDuration,
+	paramRetryBackoffFactor,
+	paramRetryBackoffMaxDuration,
+}
+
+const (
+	defaultRetryBackoffFactor = 2
+	// Max allowed backoff max duration, as documented in the pipeline spec.
+	maxRetryBackoffMaxDuration = time.Hour
+)
+
+// retryParameters converts a task retry policy to the argo workflows task
+// parameters consumed by the retry container executor template.
+func retryParameters(policy *pipelinespec.PipelineTaskSpec_RetryPolicy) []wfapi.Parameter {
+	duration := policy.GetBackoffDuration().AsDuration()
+	// Argo workflows only supports integer backoff factors.
+	factor := int64(math.Round(policy.GetBackoffFactor()))
+	if policy.GetBackoffFactor() == 0 {
+		factor = defaultRetryBackoffFactor
+	} else if factor < 1 {
+		factor = 1
+	}
+	maxDuration := maxRetryBackoffMaxDuration
+	if policy.GetBackoffMaxDuration() != nil {
+		maxDuration = policy.GetBackoffMaxDuration().AsDuration()
+		if maxDuration <= 0 || maxDuration > maxRetryBackoffMaxDuration {
+			maxDuration = maxRetryBackoffMaxDuration
+		}
+	}
+	return []wfapi.Parameter{
+		{Name: paramRetryMaxCount, Value: wfapi.AnyStringPtr(policy.GetMaxRetryCount())},
+		{Name: paramRetryBackoffDuration, Value: wfapi.AnyStringPtr(duration.String())},
+		{Name: paramRetryBackoffFactor, Value: wfapi.AnyStringPtr(factor)},
+		{Name: paramRetryBackoffMaxDuration, Value: wfapi.AnyStringPtr(maxDuration.String())},
+	}
+}
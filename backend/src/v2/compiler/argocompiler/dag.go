@@ -16,6 +16,7 @@ package argocompiler
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	wfapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -48,13 +49,20 @@ func (c *workflowCompiler) DAG(name string, componentSpec *pipelinespec.Componen
 		},
 		DAG: &wfapi.DAGTemplate{},
 	}
-	for taskName, kfpTask := range dagSpec.GetTasks() {
+	// Iterate through tasks in deterministic order, so that compiled workflows
+	// are stable and can be used in snapshot tests.
+	taskNames := make([]string, 0, len(dagSpec.GetTasks()))
+	for taskName := range dagSpec.GetTasks() {
+		taskNames = append(taskNames, taskName)
+	}
+	sort.Strings(taskNames)
+	// argo tasks that finish each of the tasks in the DAG
+	var finalTasks []string
+	for _, taskName := range taskNames {
+		kfpTask := dagSpec.GetTasks()[taskName]
 		if kfpTask.GetParameterIterator() != nil && kfpTask.GetArtifactIterator() != nil {
 			return fmt.Errorf("invalid task %q: parameterIterator and artifactIterator cannot be specified at the same time", taskName)
 		}
-		if kfpTask.GetArtifactIterator() != nil {
-			return fmt.Errorf("artifact iterator not implemented yet")
-		}
 		tasks, err := c.task(taskName, kfpTask, taskInputs{
 			parentDagID: inputParameter(paramParentDagID),
 		})
@@ -62,6 +70,14 @@ func (c *workflowCompiler) DAG(name string, componentSpec *pipelinespec.Componen
 			return err
 		}
 		dag.DAG.Tasks = append(dag.DAG.Tasks, tasks...)
+		finalTasks = append(finalTasks, tasks[len(tasks)-1].Name)
+	}
+	if len(dagSpec.GetOutputs().GetParameters()) > 0 || len(dagSpec.GetOutputs().GetArtifacts()) > 0 {
+		outputs, err := c.dagOutputsTask(name, finalTasks)
+		if err != nil {
+			return err
+		}
+		dag.DAG.Tasks = append(dag.DAG.Tasks, *outputs)
 	}
 	_, err = c.addTemplate(dag, name)
 	if err != nil {
@@ -212,6 +228,7 @@ func (c *workflowCompiler) task(name string, task *pipelinespec.PipelineTaskSpec
 				podSpecPatch:   driverOutputs.podSpecPatch,
 				cachedDecision: driverOutputs.cached,
 				condition:      driverOutputs.condition,
+				retryPolicy:    task.GetRetryPolicy(),
 			})
 			executor.Depends = depends([]string{driverTaskName})
 			return []wfapi.DAGTask{*driver, *executor}, nil
@@ -227,7 +244,16 @@ func (c *workflowCompiler) task(name string, task *pipelinespec.PipelineTaskSpec
 			}
 			return []wfapi.DAGTask{*importer}, nil
 		case *pipelinespec.PipelineDeploymentConfig_ExecutorSpec_Resolver:
-			return nil, fmt.Errorf("resolver executors not implemented")
+			if task.GetTriggerPolicy().GetCondition() != "" {
+				// Same as importer, the resolver driver is the only step of the task,
+				// so there are no driver outputs a when condition can be based on.
+				return nil, fmt.Errorf("triggerPolicy.condition on resolver task is not supported")
+			}
+			resolver, err := c.resolverTask(name, task, taskSpecJson, inputs)
+			if err != nil {
+				return nil, err
+			}
+			return []wfapi.DAGTask{*resolver}, nil
 		case *pipelinespec.PipelineDeploymentConfig_ExecutorSpec_CustomJob:
 			return nil, fmt.Errorf("custom job executors is Google Cloud only, it's not supported")
 		default:
@@ -291,26 +317,123 @@ func (c *workflowCompiler) iteratorTask(name string, task *pipelinespec.Pipeline
 	if task.GetTriggerPolicy().GetCondition() != "" {
 		when = driverOutputs.condition + " != false"
 	}
-	tasks = []wfapi.DAGTask{
-		*driver,
-		{
-			Name:     name + "-iterations",
-			Template: iterationsTmplName,
-			Depends:  depends([]string{driverArgoName}),
-			When:     when,
-			Arguments: wfapi.Arguments{
-				Parameters: []wfapi.Parameter{{
-					Name:  paramParentDagID,
-					Value: wfapi.AnyStringPtr(driverOutputs.executionID),
-				}, {
-					Name:  paramIterationIndex,
-					Value: wfapi.AnyStringPtr(loopItem()),
-				}},
+	iterations := wfapi.DAGTask{
+		Name:     name + "-iterations",
+		Template: iterationsTmplName,
+		Depends:  depends([]string{driverArgoName}),
+		When:     when,
+		Arguments: wfapi.Arguments{
+			Parameters: []wfapi.Parameter{{
+				Name:  paramParentDagID,
+				Value: wfapi.AnyStringPtr(driverOutputs.executionID),
+			}, {
+				Name:  paramIterationIndex,
+				Value: wfapi.AnyStringPtr(loopItem()),
+			}},
+		},
+		WithSequence: &wfapi.Sequence{Count: &iterationCount},
+	}
+	parallelismLimit := task.GetIteratorPolicy().GetParallelismLimit()
+	if parallelismLimit < 0 {
+		return nil, fmt.Errorf("iteratorPolicy.parallelismLimit must be non-negative, got %v", parallelismLimit)
+	}
+	if parallelismLimit > 0 {
+		// Argo workflows parallelism limits the number of concurrently
+		// running tasks in a template, so the loop is wrapped in its
+		// own template to limit concurrent iterations only.
+		loop, err := c.loopTask(name, componentName, iterations, driverOutputs, int64(parallelismLimit))
+		if err != nil {
+			return nil, err
+		}
+		iterations = *loop
+	}
+	return []wfapi.DAGTask{*driver, iterations}, nil
+}
+
+// loopTask wraps the iterations task in a for-loop template with limited
+// parallelism, and returns a task that calls the for-loop template.
+func (c *workflowCompiler) loopTask(name, componentName string, iterations wfapi.DAGTask, driverOutputs *dagDriverOutputs, parallelism int64) (*wfapi.DAGTask, error) {
+	iterationCount := intstr.FromString(inputParameter(paramIterationCount))
+	loopTask := wfapi.DAGTask{
+		Name:     "iterations",
+		Template: iterations.Template,
+		Arguments: wfapi.Arguments{
+			Parameters: []wfapi.Parameter{{
+				Name:  paramParentDagID,
+				Value: wfapi.AnyStringPtr(inputParameter(paramParentDagID)),
+			}, {
+				Name:  paramIterationIndex,
+				Value: wfapi.AnyStringPtr(loopItem()),
+			}},
+		},
+		WithSequence: &wfapi.Sequence{Count: &iterationCount},
+	}
+	loopTmpl := &wfapi.Template{
+		Inputs: wfapi.Inputs{
+			Parameters: []wfapi.Parameter{
+				{Name: paramParentDagID},
+				{Name: paramIterationCount},
 			},
-			WithSequence: &wfapi.Sequence{Count: &iterationCount},
+		},
+		Parallelism: &parallelism,
+		DAG: &wfapi.DAGTemplate{
+			Tasks: []wfapi.DAGTask{loopTask},
 		},
 	}
-	return tasks, nil
+	loopTmplName, err := c.addTemplate(loopTmpl, componentName+"-"+name+"-for-loop")
+	if err != nil {
+		return nil, err
+	}
+	return &wfapi.DAGTask{
+		Name:     iterations.Name,
+		Template: loopTmplName,
+		Depends:  iterations.Depends,
+		When:     iterations.When,
+		Arguments: wfapi.Arguments{
+			Parameters: []wfapi.Parameter{{
+				Name:  paramParentDagID,
+				Value: wfapi.AnyStringPtr(driverOutputs.executionID),
+			}, {
+				Name:  paramIterationCount,
+				Value: wfapi.AnyStringPtr(driverOutputs.iterationCount),
+			}},
+		},
+	}, nil
+}
+
+// dagOutputsTask returns a task that publishes outputs of the DAG, after all
+// the other tasks in the DAG finish.
+func (c *workflowCompiler) dagOutputsTask(componentName string, finalTasks []string) (*wfapi.DAGTask, error) {
+	componentPlaceholder, err := c.useComponentSpec(componentName)
+	if err != nil {
+		return nil, err
+	}
+	// Tasks in condition branches that are not taken are skipped.
+	var builder strings.Builder
+	for index, task := range finalTasks {
+		if index > 0 {
+			builder.WriteString(" && ")
+		}
+		fmt.Fprintf(&builder, "(%s.Succeeded || %s.Skipped)", task, task)
+	}
+	return &wfapi.DAGTask{
+		Name:     "system-dag-outputs-driver",
+		Template: c.addDAGDriverTemplate(),
+		Depends:  builder.String(),
+		Arguments: wfapi.Arguments{
+			Parameters: []wfapi.Parameter{{
+				Name:  paramComponent,
+				Value: wfapi.AnyStringPtr(componentPlaceholder),
+			}, {
+				// inside the DAG template, parent DAG ID is ID of the DAG itself
+				Name:  paramParentDagID,
+				Value: wfapi.AnyStringPtr(inputParameter(paramParentDagID)),
+			}, {
+				Name:  paramDriverType,
+				Value: wfapi.AnyStringPtr("DAG_OUTPUTS"),
+			}},
+		},
+	}, nil
 }
 
 type dagDriverOutputs struct {
@@ -0,0 +1,115 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argocompiler
+
+import (
+	wfapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	k8score "k8s.io/api/core/v1"
+)
+
+func (c *workflowCompiler) Resolver(name string, componentSpec *pipelinespec.ComponentSpec, resolver *pipelinespec.PipelineDeploymentConfig_ResolverSpec) error {
+	err := c.saveComponentSpec(name, componentSpec)
+	if err != nil {
+		return err
+	}
+	return c.saveComponentImpl(name, resolver)
+}
+
+// resolverTask generates task for a resolver component.
+// A resolver does not have a user container, the driver runs the artifact
+// queries against MLMD and publishes matched artifacts as the task's outputs.
+func (c *workflowCompiler) resolverTask(name string, task *pipelinespec.PipelineTaskSpec, taskJSON string, inputs taskInputs) (*wfapi.DAGTask, error) {
+	componentPlaceholder, err := c.useComponentSpec(task.GetComponentRef().GetName())
+	if err != nil {
+		return nil, err
+	}
+	resolverPlaceholder, err := c.useComponentImpl(task.GetComponentRef().GetName())
+	if err != nil {
+		return nil, err
+	}
+	params := []wfapi.Parameter{{
+		Name:  paramComponent,
+		Value: wfapi.AnyStringPtr(componentPlaceholder),
+	}, {
+		Name:  paramTask,
+		Value: wfapi.AnyStringPtr(taskJSON),
+	}, {
+		Name:  paramResolver,
+		Value: wfapi.AnyStringPtr(resolverPlaceholder),
+	}, {
+		Name:  paramParentDagID,
+		Value: wfapi.AnyStringPtr(inputs.parentDagID),
+	}}
+	if inputs.iterationIndex != "" {
+		params = append(params, wfapi.Parameter{
+			Name:  paramIterationIndex,
+			Value: wfapi.AnyStringPtr(inputs.iterationIndex),
+		})
+	}
+	t := &wfapi.DAGTask{
+		Name:      name,
+		Template:  c.addResolverDriverTemplate(),
+		Arguments: wfapi.Arguments{Parameters: params},
+	}
+	// iterations belong to a sub-DAG, no need to add dependent tasks
+	if inputs.iterationIndex == "" {
+		t.Depends = depends(task.GetDependentTasks())
+	}
+	return t, nil
+}
+
+func (c *workflowCompiler) addResolverDriverTemplate() string {
+	name := "system-resolver-driver"
+	if _, alreadyExists := c.templates[name]; alreadyExists {
+		return name
+	}
+	t := &wfapi.Template{
+		Name: name,
+		Inputs: wfapi.Inputs{
+			Parameters: []wfapi.Parameter{
+				{Name: paramComponent},
+				{Name: paramTask},
+				{Name: paramResolver},
+				{Name: paramParentDagID},
+				{Name: paramIterationIndex, Default: wfapi.AnyStringPtr("-1")},
+			},
+		},
+		Outputs: wfapi.Outputs{
+			Parameters: []wfapi.Parameter{
+				{Name: paramExecutionID, ValueFrom: &wfapi.ValueFrom{Path: "/tmp/outputs/execution-id"}},
+			},
+		},
+		Container: &k8score.Container{
+			Image:   c.driverImage,
+			Command: []string{"driver"},
+			Args: []string{
+				"--type", "RESOLVER",
+				"--pipeline_name", c.spec.GetPipelineInfo().GetName(),
+				"--run_id", runID(),
+				"--dag_execution_id", inputValue(paramParentDagID),
+				"--component", inputValue(paramComponent),
+				"--task", inputValue(paramTask),
+				"--resolver", inputValue(paramResolver),
+				"--iteration_index", inputValue(paramIterationIndex),
+				"--execution_id_path", outputPath(paramExecutionID),
+			},
+			Resources: driverResources,
+		},
+	}
+	c.templates[name] = t
+	c.wf.Spec.Templates = append(c.wf.Spec.Templates, *t)
+	return name
+}
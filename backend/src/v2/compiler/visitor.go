@@ -97,8 +97,12 @@ func (state *pipelineDFS) dfs(name string, component *pipelinespec.ComponentSpec
 		if importer != nil {
 			return state.visitor.Importer(name, component, importer)
 		}
+		resolver := executor.GetResolver()
+		if resolver != nil {
+			return state.visitor.Resolver(name, component, resolver)
+		}
 
-		return componentError(fmt.Errorf("executor(label=%q): non-container and non-importer executor not implemented", executorLabel))
+		return componentError(fmt.Errorf("executor(label=%q): non-container, non-importer and non-resolver executor not implemented", executorLabel))
 	}
 	dag := component.GetDag()
 	if dag == nil { // impl can only be executor or dag
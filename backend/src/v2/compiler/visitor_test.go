@@ -50,6 +50,10 @@ func Test_AcceptTestVisitor(t *testing.T) {
 			specPath: "testdata/component_used_twice.json",
 			expected: []string{`container(name="comp-hello-world")`, `DAG(name="root")`},
 		},
+		{
+			specPath: "testdata/resolver.json",
+			expected: []string{`container(name="comp-deploy")`, `resolver(name="comp-latest-blessed-model")`, `DAG(name="root")`},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,89 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/v2/cacheutils"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+)
+
+// FingerPrint computes the cache fingerprint of a container task from its
+// executor input, output definitions, command and arguments, and image.
+func FingerPrint(executorInput *pipelinespec.ExecutorInput, outputDefinitions *pipelinespec.ComponentOutputsSpec, cmdArgs []string, image string) (string, error) {
+	outputParametersTypeMap := make(map[string]string)
+	for outputParamName, outputParamSpec := range outputDefinitions.GetParameters() {
+		outputParametersTypeMap[outputParamName] = outputParamSpec.GetParameterType().String()
+	}
+	cacheKey, err := cacheutils.GenerateCacheKey(executorInput.GetInputs(), executorInput.GetOutputs(), outputParametersTypeMap, cmdArgs, image)
+	if err != nil {
+		return "", fmt.Errorf("failure while generating CacheKey: %w", err)
+	}
+	return cacheutils.GenerateFingerPrint(cacheKey)
+}
+
+// ReuseCachedOutputs collects the outputs of the cached execution with ID
+// cachedMLMDExecutionID, so that they're published as the outputs of the task.
+func ReuseCachedOutputs(ctx context.Context, executorInput *pipelinespec.ExecutorInput, mlmd *metadata.Client, cachedMLMDExecutionID string) (*pipelinespec.ExecutorOutput, []*metadata.OutputArtifact, error) {
+	cachedMLMDExecutionIDInt64, err := strconv.ParseInt(cachedMLMDExecutionID, 10, 64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failure while transfering cachedMLMDExecutionID %s from string to int64: %w", cachedMLMDExecutionID, err)
+	}
+	execution, err := mlmd.GetExecution(ctx, cachedMLMDExecutionIDInt64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failure while getting execution of cachedMLMDExecutionID %v: %w", cachedMLMDExecutionIDInt64, err)
+	}
+	executorOutput := &pipelinespec.ExecutorOutput{
+		Artifacts: map[string]*pipelinespec.ArtifactList{},
+	}
+	_, outputs, err := execution.GetParameters()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to collect output parameters from cache: %w", err)
+	}
+	executorOutput.ParameterValues = outputs
+	outputArtifacts, err := collectOutputArtifactMetadataFromCache(ctx, executorInput, cachedMLMDExecutionIDInt64, mlmd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed collect output artifact metadata from cache: %w", err)
+	}
+	return executorOutput, outputArtifacts, nil
+}
+
+func collectOutputArtifactMetadataFromCache(ctx context.Context, executorInput *pipelinespec.ExecutorInput, cachedMLMDExecutionID int64, mlmd *metadata.Client) ([]*metadata.OutputArtifact, error) {
+	outputArtifacts, err := mlmd.GetOutputArtifactsByExecutionId(ctx, cachedMLMDExecutionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get MLMDOutputArtifactsByName by executionId %v: %w", cachedMLMDExecutionID, err)
+	}
+
+	// Register artifacts with MLMD.
+	registeredMLMDArtifacts := make([]*metadata.OutputArtifact, 0, len(executorInput.GetOutputs().GetArtifacts()))
+	for name, artifactList := range executorInput.GetOutputs().GetArtifacts() {
+		if len(artifactList.Artifacts) == 0 {
+			continue
+		}
+		artifact := artifactList.Artifacts[0]
+		outputArtifact, ok := outputArtifacts[name]
+		if !ok {
+			return nil, fmt.Errorf("unable to find artifact with name %v in mlmd output artifacts", name)
+		}
+		outputArtifact.Schema = artifact.GetType().GetInstanceSchema()
+		registeredMLMDArtifacts = append(registeredMLMDArtifacts, outputArtifact)
+	}
+	return registeredMLMDArtifacts, nil
+
+}
@@ -21,6 +21,8 @@ const (
 	// Env var names
 	EnvPodName = "KFP_POD_NAME"
 	EnvPodUID  = "KFP_POD_UID"
+	// Retry attempt of the task, only set when the task has a retry policy.
+	EnvRetryAttempt = "KFP_RETRY_ATTEMPT"
 
 	// Env vars in metadata-grpc-configmap
 	EnvMetadataHost = "METADATA_GRPC_SERVICE_HOST"
@@ -38,6 +38,17 @@ type LauncherV2Options struct {
 	MLMDServerPort,
 	PipelineName,
 	RunID string
+	// Retry attempt of the task, starting from 0.
+	RetryAttempt int
+	// Optional, the pre-cache-check lifecycle hook of the container. When set,
+	// the cache is looked up after running it, if EnableCache is set.
+	PreCacheCheck *pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_Lifecycle_Exec
+	EnableCache   bool
+	// Max cache staleness in seconds of the task, -1 means cached executions
+	// never go stale.
+	MaxCacheStaleness int64
+	// Image of the container, used to compute the cache fingerprint.
+	ContainerImage string
 }
 
 type LauncherV2 struct {
@@ -120,7 +131,23 @@ func (l *LauncherV2) Execute(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	defer func() {
+		// Record the failure, so that downstream exit handler tasks can
+		// get the final status of this task.
+		if err != nil {
+			if publishErr := l.metadataClient.PublishExecutionFailure(ctx, execution, err); publishErr != nil {
+				glog.Errorf("failed to publish execution failure to ML Metadata: %v", publishErr)
+			}
+		}
+	}()
 	fingerPrint := execution.FingerPrint()
+	if l.options.PreCacheCheck != nil {
+		var cached bool
+		cached, fingerPrint, err = l.preCacheCheck(ctx, execution)
+		if err != nil || cached {
+			return err
+		}
+	}
 	bucketConfig, err := objectstore.ParseBucketConfig(execution.GetPipeline().GetPipelineRoot())
 	if err != nil {
 		return err
@@ -160,6 +187,42 @@ func (l *LauncherV2) Execute(ctx context.Context) (err error) {
 	return nil
 }
 
+// preCacheCheck runs the pre-cache-check lifecycle hook, and then looks up the
+// cache with the executor input updated by the hook, when the task enables it.
+// A cached execution is published right away. Otherwise, the fingerprint the
+// execution is cached with is returned.
+func (l *LauncherV2) preCacheCheck(ctx context.Context, execution *metadata.Execution) (cached bool, fingerPrint string, err error) {
+	// The launcher runs in the user container, so the hook gets its image and
+	// environment.
+	if err := ExecutePreCacheCheck(ctx, l.options.PreCacheCheck, l.executorInput, nil); err != nil {
+		return false, "", err
+	}
+	if !l.options.EnableCache {
+		return false, "", nil
+	}
+	userCmdArgs := append([]string{l.command}, l.args...)
+	fingerPrint, err = FingerPrint(l.executorInput, l.component.GetOutputDefinitions(), userCmdArgs, l.options.ContainerImage)
+	if err != nil {
+		return false, "", fmt.Errorf("failure while getting fingerPrint: %w", err)
+	}
+	cachedMLMDExecutionID, err := l.cacheClient.GetExecutionCache(fingerPrint, "pipeline/"+l.options.PipelineName, l.options.Namespace, l.options.MaxCacheStaleness)
+	if err != nil {
+		return false, "", fmt.Errorf("failure while getting executionCache: %w", err)
+	}
+	if cachedMLMDExecutionID == "" {
+		return false, fingerPrint, nil
+	}
+	executorOutput, outputArtifacts, err := ReuseCachedOutputs(ctx, l.executorInput, l.metadataClient, cachedMLMDExecutionID)
+	if err != nil {
+		return false, "", err
+	}
+	if err := l.metadataClient.PublishExecution(ctx, execution, executorOutput.GetParameterValues(), outputArtifacts, pb.Execution_CACHED); err != nil {
+		return false, "", fmt.Errorf("failed to publish cached execution: %w", err)
+	}
+	glog.Infof("Cached")
+	return true, fingerPrint, nil
+}
+
 func (l *LauncherV2) Info() string {
 	content, err := protojson.Marshal(l.executorInput)
 	if err != nil {
@@ -190,6 +253,9 @@ func (o *LauncherV2Options) validate() error {
 	if empty(o.MLMDServerPort) {
 		return err("MLMDServerPort")
 	}
+	if o.RetryAttempt < 0 {
+		return fmt.Errorf("invalid launcher options: RetryAttempt must be non-negative, got %v", o.RetryAttempt)
+	}
 	return nil
 }
 
@@ -205,9 +271,10 @@ func (l *LauncherV2) prePublish(ctx context.Context) (execution *metadata.Execut
 		return nil, err
 	}
 	ecfg := &metadata.ExecutionConfig{
-		PodName:   l.options.PodName,
-		PodUID:    l.options.PodUID,
-		Namespace: l.options.Namespace,
+		PodName:      l.options.PodName,
+		PodUID:       l.options.PodUID,
+		Namespace:    l.options.Namespace,
+		RetryAttempt: l.options.RetryAttempt,
 	}
 	return l.metadataClient.PrePublishExecution(ctx, execution, ecfg)
 }
@@ -311,15 +378,9 @@ func execute(ctx context.Context, executorInput *pipelinespec.ExecutorInput, cmd
 	if err != nil {
 		return nil, err
 	}
-	for placeholder, replacement := range placeholders {
-		cmd = strings.ReplaceAll(cmd, placeholder, replacement)
-	}
+	cmd = resolvePlaceholders(cmd, placeholders)
 	for i := range args {
-		arg := args[i]
-		for placeholder, replacement := range placeholders {
-			arg = strings.ReplaceAll(arg, placeholder, replacement)
-		}
-		args[i] = arg
+		args[i] = resolvePlaceholders(args[i], placeholders)
 	}
 
 	// Run user program.
@@ -0,0 +1,130 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+)
+
+// ResolvePlaceholders replaces executor input placeholders like
+// {{$.inputs.parameters['text']}} in values with their runtime values.
+func ResolvePlaceholders(executorInput *pipelinespec.ExecutorInput, values []string) ([]string, error) {
+	placeholders, err := getPlaceholders(executorInput)
+	if err != nil {
+		return nil, err
+	}
+	resolved := make([]string, 0, len(values))
+	for _, value := range values {
+		resolved = append(resolved, resolvePlaceholders(value, placeholders))
+	}
+	return resolved, nil
+}
+
+func resolvePlaceholders(value string, placeholders map[string]string) string {
+	for placeholder, replacement := range placeholders {
+		value = strings.ReplaceAll(value, placeholder, replacement)
+	}
+	return value
+}
+
+// ExecutePreCacheCheck runs the pre-cache-check lifecycle hook of a container
+// and applies the executor output of the hook to executorInput, so that it
+// is used to compute the cache key and passed to the main container. It is
+// run by the launcher, so that the hook runs in the user container.
+//
+// The hook follows the I/O contract of the main container entrypoint:
+// * parameter values in the executor output override input parameters with
+// the same name.
+// * artifacts in the executor output are merged into output artifacts with
+// the same name.
+//
+// Input artifacts are not downloaded for the hook, it should read them by URI.
+// env are extra environment variables of the hook, in the form key=value.
+func ExecutePreCacheCheck(ctx context.Context, hook *pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_Lifecycle_Exec, executorInput *pipelinespec.ExecutorInput, env []string) (err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("failed to execute pre-cache-check lifecycle hook: %w", err)
+		}
+	}()
+	cmdArgs := make([]string, 0, len(hook.GetCommand())+len(hook.GetArgs()))
+	cmdArgs = append(cmdArgs, hook.GetCommand()...)
+	cmdArgs = append(cmdArgs, hook.GetArgs()...)
+	if len(cmdArgs) == 0 {
+		return fmt.Errorf("command is empty")
+	}
+	cmdArgs, err = ResolvePlaceholders(executorInput, cmdArgs)
+	if err != nil {
+		return err
+	}
+	if err := prepareOutputFolders(executorInput); err != nil {
+		return err
+	}
+	// Do not read a stale executor output, when the hook doesn't write one.
+	outputFile := executorInput.GetOutputs().GetOutputFile()
+	if outputFile != "" {
+		if err := os.Remove(outputFile); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove executor output file %q: %w", outputFile, err)
+		}
+	}
+
+	hookCmd := exec.CommandContext(ctx, cmdArgs[0], cmdArgs[1:]...)
+	hookCmd.Env = append(os.Environ(), env...)
+	hookCmd.Stdout = os.Stdout
+	hookCmd.Stderr = os.Stderr
+	defer glog.Flush()
+	if err := hookCmd.Run(); err != nil {
+		return err
+	}
+	if outputFile == "" {
+		return nil
+	}
+	executorOutput, err := getExecutorOutputFile(outputFile)
+	if err != nil {
+		return err
+	}
+	// The main container must not read the executor output of the hook.
+	if err := os.Remove(outputFile); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove executor output file %q: %w", outputFile, err)
+	}
+	applyPreCacheCheckOutput(executorInput, executorOutput)
+	return nil
+}
+
+func applyPreCacheCheckOutput(executorInput *pipelinespec.ExecutorInput, executorOutput *pipelinespec.ExecutorOutput) {
+	for name, value := range executorOutput.GetParameterValues() {
+		if _, ok := executorInput.GetInputs().GetParameterValues()[name]; !ok {
+			glog.Warningf("Ignoring parameter %q from pre-cache-check lifecycle hook, it is not an input parameter.", name)
+			continue
+		}
+		executorInput.Inputs.ParameterValues[name] = value
+	}
+	for name, list := range executorOutput.GetArtifacts() {
+		outputList, ok := executorInput.GetOutputs().GetArtifacts()[name]
+		if !ok || len(outputList.GetArtifacts()) == 0 {
+			glog.Warningf("Ignoring artifact %q from pre-cache-check lifecycle hook, it is not an output artifact.", name)
+			continue
+		}
+		if len(list.GetArtifacts()) > 0 {
+			mergeRuntimeArtifacts(list.Artifacts[0], outputList.Artifacts[0])
+		}
+	}
+}
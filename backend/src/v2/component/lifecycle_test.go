@@ -0,0 +1,177 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func newPreCacheCheckExecutorInput(outputFile string) *pipelinespec.ExecutorInput {
+	return &pipelinespec.ExecutorInput{
+		Inputs: &pipelinespec.ExecutorInput_Inputs{
+			ParameterValues: map[string]*structpb.Value{
+				"text":  structpb.NewStringValue("hello"),
+				"count": structpb.NewNumberValue(1),
+			},
+		},
+		Outputs: &pipelinespec.ExecutorInput_Outputs{
+			Artifacts: map[string]*pipelinespec.ArtifactList{
+				"model": {Artifacts: []*pipelinespec.RuntimeArtifact{{
+					Name: "model",
+					Uri:  "gs://bucket/model",
+					Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"framework": structpb.NewStringValue("tensorflow"),
+					}},
+				}}},
+			},
+			OutputFile: outputFile,
+		},
+	}
+}
+
+// executorOutputFile returns the path of an executor output file in a new
+// temporary directory, and a function that removes it.
+func executorOutputFile(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "lifecycle")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	return filepath.Join(dir, "executor_output.json"), func() { os.RemoveAll(dir) }
+}
+
+func Test_applyPreCacheCheckOutput(t *testing.T) {
+	executorInput := newPreCacheCheckExecutorInput("")
+	executorOutput := &pipelinespec.ExecutorOutput{
+		ParameterValues: map[string]*structpb.Value{
+			// Overrides the input parameter.
+			"text": structpb.NewStringValue("hello world"),
+			// Ignored, it is not an input parameter.
+			"unknown": structpb.NewStringValue("ignored"),
+		},
+		Artifacts: map[string]*pipelinespec.ArtifactList{
+			// Merged into the output artifact.
+			"model": {Artifacts: []*pipelinespec.RuntimeArtifact{{
+				Uri: "gs://bucket/other-model",
+				Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"version": structpb.NewStringValue("2"),
+				}},
+			}}},
+			// Ignored, it is not an output artifact.
+			"metrics": {Artifacts: []*pipelinespec.RuntimeArtifact{{Uri: "gs://bucket/metrics"}}},
+		},
+	}
+
+	applyPreCacheCheckOutput(executorInput, executorOutput)
+
+	want := newPreCacheCheckExecutorInput("")
+	want.Inputs.ParameterValues["text"] = structpb.NewStringValue("hello world")
+	model := want.Outputs.Artifacts["model"].Artifacts[0]
+	model.Uri = "gs://bucket/other-model"
+	model.Metadata.Fields["version"] = structpb.NewStringValue("2")
+	if diff := cmp.Diff(want, executorInput, protocmp.Transform()); diff != "" {
+		t.Errorf("applyPreCacheCheckOutput() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_applyPreCacheCheckOutput_EmptyOutput(t *testing.T) {
+	executorInput := newPreCacheCheckExecutorInput("")
+
+	applyPreCacheCheckOutput(executorInput, &pipelinespec.ExecutorOutput{})
+
+	if diff := cmp.Diff(newPreCacheCheckExecutorInput(""), executorInput, protocmp.Transform()); diff != "" {
+		t.Errorf("applyPreCacheCheckOutput() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// newExecutePreCacheCheckInput returns executor input without output
+// artifacts, which would be given local paths outside of the test directory.
+func newExecutePreCacheCheckInput(outputFile string) *pipelinespec.ExecutorInput {
+	executorInput := newPreCacheCheckExecutorInput(outputFile)
+	executorInput.Outputs.Artifacts = nil
+	return executorInput
+}
+
+func TestExecutePreCacheCheck(t *testing.T) {
+	outputFile, cleanup := executorOutputFile(t)
+	defer cleanup()
+	executorInput := newExecutePreCacheCheckInput(outputFile)
+	hook := &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_Lifecycle_Exec{
+		Command: []string{"sh", "-c"},
+		Args: []string{
+			`echo "{\"parameterValues\": {\"text\": \"{{$.inputs.parameters['text']}} $SUFFIX\"}}" > "$0"`,
+			outputFile,
+		},
+	}
+
+	err := ExecutePreCacheCheck(context.Background(), hook, executorInput, []string{"SUFFIX=world"})
+	assert.Nil(t, err)
+	assert.Equal(t, "hello world", executorInput.GetInputs().GetParameterValues()["text"].GetStringValue())
+	// The executor output of the hook is not left for the main container.
+	_, err = os.Stat(outputFile)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestExecutePreCacheCheck_NoExecutorOutput(t *testing.T) {
+	outputFile, cleanup := executorOutputFile(t)
+	defer cleanup()
+	// A stale executor output is not applied.
+	assert.Nil(t, ioutil.WriteFile(outputFile, []byte(`{"parameterValues": {"text": "stale"}}`), 0644))
+	executorInput := newExecutePreCacheCheckInput(outputFile)
+	hook := &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_Lifecycle_Exec{
+		Command: []string{"true"},
+	}
+
+	err := ExecutePreCacheCheck(context.Background(), hook, executorInput, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", executorInput.GetInputs().GetParameterValues()["text"].GetStringValue())
+}
+
+func TestExecutePreCacheCheck_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		hook *pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_Lifecycle_Exec
+	}{
+		{
+			name: "empty command",
+			hook: &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_Lifecycle_Exec{},
+		},
+		{
+			name: "failed command",
+			hook: &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_Lifecycle_Exec{
+				Command: []string{"sh", "-c", "exit 1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputFile, cleanup := executorOutputFile(t)
+			defer cleanup()
+			executorInput := newExecutePreCacheCheckInput(outputFile)
+			err := ExecutePreCacheCheck(context.Background(), tt.hook, executorInput, nil)
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), "failed to execute pre-cache-check lifecycle hook")
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 
+	"github.com/ghodss/yaml"
 	"github.com/golang/glog"
 	k8errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,8 +16,19 @@ const (
 	configMapName                = "kfp-launcher"
 	defaultPipelineRoot          = "minio://mlpipeline/v2/artifacts"
 	configKeyDefaultPipelineRoot = "defaultPipelineRoot"
+	configKeyAccelerators        = "accelerators"
 )
 
+// AcceleratorConfig configures how containers request an accelerator type.
+type AcceleratorConfig struct {
+	// Name of the extended resource requested for each accelerator, e.g.
+	// nvidia.com/gpu.
+	ResourceName string `json:"resourceName"`
+	// Optional, node selector that schedules Pods onto nodes with the
+	// accelerator type.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
 // Config is the KFP runtime configuration.
 type Config struct {
 	data map[string]string
@@ -45,6 +57,31 @@ func (c *Config) DefaultPipelineRoot() string {
 	return c.data[configKeyDefaultPipelineRoot]
 }
 
+// Config.Accelerators gets the configured accelerator types, keyed by
+// accelerator type in the pipeline spec. They are configured as YAML in the
+// optional key accelerators, e.g.
+//
+//	accelerators: |
+//	  NVIDIA_TESLA_T4:
+//	    resourceName: nvidia.com/gpu
+//	    nodeSelector:
+//	      cloud.google.com/gke-accelerator: nvidia-tesla-t4
+func (c *Config) Accelerators() (map[string]AcceleratorConfig, error) {
+	accelerators := make(map[string]AcceleratorConfig)
+	if c == nil || c.data[configKeyAccelerators] == "" {
+		return accelerators, nil
+	}
+	if err := yaml.Unmarshal([]byte(c.data[configKeyAccelerators]), &accelerators); err != nil {
+		return nil, fmt.Errorf("failed to parse %q in launcher config: %w", configKeyAccelerators, err)
+	}
+	for acceleratorType, accelerator := range accelerators {
+		if accelerator.ResourceName == "" {
+			return nil, fmt.Errorf("invalid %q in launcher config: resourceName of accelerator type %q is empty", configKeyAccelerators, acceleratorType)
+		}
+	}
+	return accelerators, nil
+}
+
 // InPodNamespace gets current namespace from inside a Kubernetes Pod.
 func InPodNamespace() (string, error) {
 	// The path is available in Pods.
@@ -16,6 +16,8 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/v2/expression"
 	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
 	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/structpb"
 	k8score "k8s.io/api/core/v1"
@@ -46,6 +48,15 @@ type Options struct {
 
 	// optional, required only by container driver
 	Container *pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec
+	// optional, used only by container driver. The default and maximum cache
+	// staleness of tasks configured for the deployment, as ISO 8601 durations.
+	// They are passed by the compiler rather than read from the launcher
+	// config, which can be edited by the users of the namespace.
+	DefaultCacheStaleness string
+	MaximumCacheStaleness string
+
+	// optional, required only by resolver driver
+	Resolver *pipelinespec.PipelineDeploymentConfig_ResolverSpec
 }
 
 // Identifying information used for error messages
@@ -105,15 +116,7 @@ func RootDAG(ctx context.Context, opts Options, mlmd *metadata.Client) (executio
 	if pipelineRoot != "" {
 		glog.Infof("PipelineRoot=%q", pipelineRoot)
 	} else {
-		restConfig, err := rest.InClusterConfig()
-		if err != nil {
-			return nil, fmt.Errorf("failed to initialize kubernetes client: %w", err)
-		}
-		k8sClient, err := kubernetes.NewForConfig(restConfig)
-		if err != nil {
-			return nil, fmt.Errorf("failed to initialize kubernetes client set: %w", err)
-		}
-		cfg, err := config.FromConfigMap(ctx, k8sClient, opts.Namespace)
+		cfg, err := launcherConfig(ctx, opts.Namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -209,6 +212,19 @@ func Container(ctx context.Context, opts Options, mlmd *metadata.Client, cacheCl
 		return nil, err
 	}
 	glog.Infof("parent DAG: %+v", dag.Execution)
+	// When the run is retried, the task may have an execution from a previous
+	// attempt. A succeeded attempt is kept, otherwise the execution is re-driven.
+	previous, err := mlmd.GetTaskExecutionInDAG(ctx, dag, pipeline, opts.Task.GetTaskInfo().GetName(), iterationIndex)
+	if err != nil {
+		return nil, err
+	}
+	switch previous.GetExecution().GetLastKnownState() {
+	case pb.Execution_COMPLETE, pb.Execution_CACHED:
+		glog.Infof("Task succeeded in a previous attempt, execution ID=%v", previous.GetID())
+		// Skip the executor like a cached execution.
+		cached := true
+		return &Execution{ID: previous.GetID(), Cached: &cached}, nil
+	}
 	expr, err := expression.New()
 	if err != nil {
 		return nil, err
@@ -239,17 +255,46 @@ func Container(ctx context.Context, opts Options, mlmd *metadata.Client, cacheCl
 	}
 	ecfg.TaskName = opts.Task.GetTaskInfo().GetName()
 	ecfg.ExecutionType = metadata.ContainerExecutionTypeName
+	// The launcher config is only loaded when the task needs it.
+	var cfg *config.Config
+	cfgLoaded := false
+	getConfig := func() (*config.Config, error) {
+		if cfgLoaded {
+			return cfg, nil
+		}
+		var err error
+		if cfg, err = launcherConfig(ctx, opts.Namespace); err != nil {
+			return nil, err
+		}
+		cfgLoaded = true
+		return cfg, nil
+	}
 	ecfg.ParentDagID = dag.Execution.GetID()
 	ecfg.IterationIndex = iterationIndex
 	ecfg.NotTriggered = !execution.WillTrigger()
 
-	if execution.WillTrigger() && opts.Task.GetCachingOptions().GetEnableCache() {
+	// The pre-cache-check lifecycle hook runs in the user container, and may
+	// update the executor input. So when there is one, the launcher runs it and
+	// looks up the cache afterwards instead.
+	hook := opts.Container.GetLifecycle().GetPreCacheCheck()
+	var launcherArgs []string
+	if execution.WillTrigger() && hook != nil {
+		launcherArgs, err = preCacheCheckLauncherArgs(opts, hook)
+		if err != nil {
+			return execution, err
+		}
+	}
+	if execution.WillTrigger() && hook == nil && opts.Task.GetCachingOptions().GetEnableCache() {
 		glog.Infof("Task {%s} enables cache", opts.Task.GetTaskInfo().GetName())
 		fingerPrint, err := getFingerPrint(opts, executorInput)
 		if err != nil {
 			return execution, fmt.Errorf("failure while getting fingerPrint: %w", err)
 		}
-		cachedMLMDExecutionID, err := cacheClient.GetExecutionCache(fingerPrint, "pipeline/"+opts.PipelineName, opts.Namespace)
+		maxCacheStaleness, err := cacheutils.MaxCacheStaleness(opts.Task.GetCachingOptions().GetMaxCacheStaleness(), opts.DefaultCacheStaleness, opts.MaximumCacheStaleness)
+		if err != nil {
+			return execution, err
+		}
+		cachedMLMDExecutionID, err := cacheClient.GetExecutionCache(fingerPrint, "pipeline/"+opts.PipelineName, opts.Namespace, maxCacheStaleness)
 		if err != nil {
 			return execution, fmt.Errorf("failure while getting executionCache: %w", err)
 		}
@@ -257,7 +302,13 @@ func Container(ctx context.Context, opts Options, mlmd *metadata.Client, cacheCl
 		ecfg.FingerPrint = fingerPrint
 	}
 	// TODO(Bobgy): change execution state to pending, because this is driver, execution hasn't started.
-	createdExecution, err := mlmd.CreateExecution(ctx, pipeline, ecfg)
+	var createdExecution *metadata.Execution
+	if previous != nil {
+		glog.Infof("Re-driving execution ID=%v of a previous attempt", previous.GetID())
+		createdExecution, err = mlmd.ResetExecution(ctx, pipeline, previous, ecfg)
+	} else {
+		createdExecution, err = mlmd.CreateExecution(ctx, pipeline, ecfg)
+	}
 	if err != nil {
 		return execution, err
 	}
@@ -270,7 +321,7 @@ func Container(ctx context.Context, opts Options, mlmd *metadata.Client, cacheCl
 	cached := false
 	execution.Cached = &cached
 	if opts.Task.GetCachingOptions().GetEnableCache() && ecfg.CachedMLMDExecutionID != "" {
-		executorOutput, outputArtifacts, err := reuseCachedOutputs(ctx, executorInput, opts.Component.GetOutputDefinitions(), mlmd, ecfg.CachedMLMDExecutionID)
+		executorOutput, outputArtifacts, err := component.ReuseCachedOutputs(ctx, executorInput, mlmd, ecfg.CachedMLMDExecutionID)
 		if err != nil {
 			return execution, err
 		}
@@ -285,13 +336,64 @@ func Container(ctx context.Context, opts Options, mlmd *metadata.Client, cacheCl
 		return execution, nil
 	}
 
-	execution.PodSpecPatch, err = makePodSpecPatch(opts.Container, opts.Component, executorInput, execution.ID, opts.PipelineName, opts.RunID)
+	var accelerators map[string]config.AcceleratorConfig
+	if opts.Container.GetResources().GetAccelerator() != nil {
+		cfg, err := getConfig()
+		if err != nil {
+			return execution, err
+		}
+		accelerators, err = cfg.Accelerators()
+		if err != nil {
+			return execution, err
+		}
+	}
+	execution.PodSpecPatch, err = makePodSpecPatch(opts.Container, opts.Component, executorInput, execution.ID, opts.PipelineName, opts.RunID, accelerators, launcherArgs)
 	if err != nil {
 		return execution, err
 	}
 	return execution, nil
 }
 
+// preCacheCheckLauncherArgs returns the launcher arguments that run the
+// pre-cache-check lifecycle hook, and look up the cache afterwards when the
+// task enables it.
+func preCacheCheckLauncherArgs(opts Options, hook *pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_Lifecycle_Exec) ([]string, error) {
+	if len(hook.GetCommand())+len(hook.GetArgs()) == 0 {
+		return nil, fmt.Errorf("pre-cache-check lifecycle hook has an empty command")
+	}
+	hookJSON, err := protojson.Marshal(hook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal pre-cache-check lifecycle hook: %w", err)
+	}
+	args := []string{"--pre_cache_check", string(hookJSON)}
+	if !opts.Task.GetCachingOptions().GetEnableCache() {
+		return args, nil
+	}
+	glog.Infof("Task {%s} enables cache, it is looked up after the pre-cache-check lifecycle hook", opts.Task.GetTaskInfo().GetName())
+	maxCacheStaleness, err := cacheutils.MaxCacheStaleness(opts.Task.GetCachingOptions().GetMaxCacheStaleness(), opts.DefaultCacheStaleness, opts.MaximumCacheStaleness)
+	if err != nil {
+		return nil, err
+	}
+	return append(args,
+		"--enable_cache",
+		"--max_cache_staleness", strconv.FormatInt(maxCacheStaleness, 10),
+		"--container_image", opts.Container.GetImage(),
+	), nil
+}
+
+// launcherConfig loads the launcher config of the namespace from the cluster.
+func launcherConfig(ctx context.Context, namespace string) (*config.Config, error) {
+	restConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize kubernetes client: %w", err)
+	}
+	k8sClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize kubernetes client set: %w", err)
+	}
+	return config.FromConfigMap(ctx, k8sClient, namespace)
+}
+
 // makePodSpecPatch generates a strategic merge patch for pod spec, it is merged
 // to container base template generated in compiler/container.go. Therefore, only
 // dynamic values are patched here. The volume mounts / configmap mounts are
@@ -303,6 +405,8 @@ func makePodSpecPatch(
 	executionID int64,
 	pipelineName string,
 	runID string,
+	accelerators map[string]config.AcceleratorConfig,
+	launcherArgs []string,
 ) (string, error) {
 	executorInputJSON, err := protojson.Marshal(executorInput)
 	if err != nil {
@@ -335,10 +439,14 @@ func makePodSpecPatch(
 		fmt.Sprintf("$(%s)", component.EnvMetadataHost),
 		"--mlmd_server_port",
 		fmt.Sprintf("$(%s)", component.EnvMetadataPort),
-		"--", // separater before user command and args
 	}
+	launcherCmd = append(launcherCmd, launcherArgs...)
+	launcherCmd = append(launcherCmd, "--") // separater before user command and args
+	// Requests default to limits in Kubernetes, but are set explicitly so that
+	// they are not affected by LimitRanges of the namespace.
 	res := k8score.ResourceRequirements{
-		Limits: map[k8score.ResourceName]k8sres.Quantity{},
+		Limits:   map[k8score.ResourceName]k8sres.Quantity{},
+		Requests: map[k8score.ResourceName]k8sres.Quantity{},
 	}
 	memoryLimit := container.GetResources().GetMemoryLimit()
 	if memoryLimit != 0 {
@@ -347,6 +455,7 @@ func makePodSpecPatch(
 			return "", err
 		}
 		res.Limits[k8score.ResourceMemory] = q
+		res.Requests[k8score.ResourceMemory] = q
 	}
 	cpuLimit := container.GetResources().GetCpuLimit()
 	if cpuLimit != 0 {
@@ -355,17 +464,31 @@ func makePodSpecPatch(
 			return "", err
 		}
 		res.Limits[k8score.ResourceCPU] = q
+		res.Requests[k8score.ResourceCPU] = q
+	}
+	env, err := resolveEnv(executorInput, container.GetEnv())
+	if err != nil {
+		return "", fmt.Errorf("failed to make podSpecPatch: %w", err)
 	}
+	var nodeSelector map[string]string
 	accelerator := container.GetResources().GetAccelerator()
-	if accelerator != nil {
-		return "", fmt.Errorf("accelerator resources are not supported yet: https://github.com/kubeflow/pipelines/issues/7043")
+	if accelerator.GetCount() > 0 {
+		resourceName, selector, err := acceleratorResource(accelerator.GetType(), accelerators)
+		if err != nil {
+			return "", fmt.Errorf("failed to make podSpecPatch: %w", err)
+		}
+		// Extended resources can only be requested in limits.
+		res.Limits[resourceName] = *k8sres.NewQuantity(accelerator.GetCount(), k8sres.DecimalSI)
+		nodeSelector = selector
 	}
 	podSpec := &k8score.PodSpec{
+		NodeSelector: nodeSelector,
 		Containers: []k8score.Container{{
 			Name:      "main", // argo task user container is always called "main"
 			Command:   launcherCmd,
 			Args:      userCmdArgs,
 			Image:     container.Image,
+			Env:       env,
 			Resources: res,
 		}},
 	}
@@ -376,6 +499,44 @@ func makePodSpecPatch(
 	return string(podSpecPatchBytes), nil
 }
 
+// resolveEnv resolves executor input placeholders in environment variables of
+// the container spec. $(VAR_NAME) references are left for Kubernetes to expand.
+func resolveEnv(executorInput *pipelinespec.ExecutorInput, envSpec []*pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_EnvVar) ([]k8score.EnvVar, error) {
+	if len(envSpec) == 0 {
+		return nil, nil
+	}
+	values := make([]string, 0, len(envSpec))
+	for _, e := range envSpec {
+		if e.GetName() == "" {
+			return nil, fmt.Errorf("environment variable name is empty")
+		}
+		values = append(values, e.GetValue())
+	}
+	values, err := component.ResolvePlaceholders(executorInput, values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve environment variables: %w", err)
+	}
+	env := make([]k8score.EnvVar, 0, len(envSpec))
+	for i, e := range envSpec {
+		env = append(env, k8score.EnvVar{Name: e.GetName(), Value: values[i]})
+	}
+	return env, nil
+}
+
+// acceleratorResource maps an accelerator type to the extended resource name
+// and node selector configured in the launcher config. Accelerator types that
+// are not configured are used as resource names directly, when they are
+// qualified resource names like nvidia.com/gpu.
+func acceleratorResource(acceleratorType string, accelerators map[string]config.AcceleratorConfig) (k8score.ResourceName, map[string]string, error) {
+	if accelerator, ok := accelerators[acceleratorType]; ok {
+		return k8score.ResourceName(accelerator.ResourceName), accelerator.NodeSelector, nil
+	}
+	if strings.Contains(acceleratorType, "/") {
+		return k8score.ResourceName(acceleratorType), nil, nil
+	}
+	return "", nil, fmt.Errorf("accelerator type %q is not configured, configure it in the accelerators of the kfp-launcher configmap or use an extended resource name like nvidia.com/gpu", acceleratorType)
+}
+
 // TODO(Bobgy): merge DAG driver and container driver, because they are very similar.
 func DAG(ctx context.Context, opts Options, mlmd *metadata.Client) (execution *Execution, err error) {
 	defer func() {
@@ -433,8 +594,16 @@ func DAG(ctx context.Context, opts Options, mlmd *metadata.Client) (execution *E
 	ecfg.ParentDagID = dag.Execution.GetID()
 	ecfg.IterationIndex = iterationIndex
 	ecfg.NotTriggered = !execution.WillTrigger()
-	if opts.Task.GetArtifactIterator() != nil {
-		return execution, fmt.Errorf("ArtifactIterator is not implemented")
+	// Fan out iterations over an artifact list
+	if execution.WillTrigger() && opts.Task.GetArtifactIterator() != nil && opts.IterationIndex < 0 {
+		iterator := opts.Task.GetArtifactIterator()
+		items, ok := executorInput.GetInputs().GetArtifacts()[iterator.GetItems().GetInputArtifact()]
+		if !ok {
+			return execution, fmt.Errorf("iterating on item input %q failed: cannot find input artifact %q", iterator.GetItemInput(), iterator.GetItems().GetInputArtifact())
+		}
+		count := len(items.GetArtifacts())
+		ecfg.IterationCount = &count
+		execution.IterationCount = &count
 	}
 	isIterator := opts.Task.GetParameterIterator() != nil && opts.IterationIndex < 0
 	// Fan out iterations
@@ -477,8 +646,20 @@ func DAG(ctx context.Context, opts Options, mlmd *metadata.Client) (execution *E
 		ecfg.IterationCount = &count
 		execution.IterationCount = &count
 	}
+	// When the run is retried, reuse the DAG execution of a previous attempt, so
+	// that tasks in the DAG find executions of their previous attempts.
+	previous, err := mlmd.GetTaskExecutionInDAG(ctx, dag, pipeline, ecfg.TaskName, iterationIndex)
+	if err != nil {
+		return execution, err
+	}
 	// TODO(Bobgy): change execution state to pending, because this is driver, execution hasn't started.
-	createdExecution, err := mlmd.CreateExecution(ctx, pipeline, ecfg)
+	var createdExecution *metadata.Execution
+	if previous != nil {
+		glog.Infof("Re-driving DAG execution ID=%v of a previous attempt", previous.GetID())
+		createdExecution, err = mlmd.ResetExecution(ctx, pipeline, previous, ecfg)
+	} else {
+		createdExecution, err = mlmd.CreateExecution(ctx, pipeline, ecfg)
+	}
 	if err != nil {
 		return execution, err
 	}
@@ -487,88 +668,264 @@ func DAG(ctx context.Context, opts Options, mlmd *metadata.Client) (execution *E
 	return execution, nil
 }
 
-// Get iteration items from a structpb.Value.
-// Return value may be
-// * a list of JSON serializable structs
-// * a list of structpb.Value
-func getItems(value *structpb.Value) (items []*structpb.Value, err error) {
-	switch v := value.GetKind().(type) {
-	case *structpb.Value_ListValue:
-		return v.ListValue.GetValues(), nil
-	case *structpb.Value_StringValue:
-		listValue := structpb.Value{}
-		if err = listValue.UnmarshalJSON([]byte(v.StringValue)); err != nil {
-			return nil, err
+// DAGOutputs resolves outputs of a DAG from outputs of the tasks in the DAG,
+// and publishes them to the DAG execution, so that downstream tasks can consume
+// outputs of the DAG like outputs of any other task.
+// It runs after all tasks in the DAG have finished.
+func DAGOutputs(ctx context.Context, opts Options, mlmd *metadata.Client) (execution *Execution, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("driver.DAGOutputs(%s) failed: %w", opts.info(), err)
 		}
-		return listValue.GetListValue().GetValues(), nil
-	default:
-		return nil, fmt.Errorf("value of type %T cannot be iterated", v)
+	}()
+	err = validateDAGOutputs(opts)
+	if err != nil {
+		return nil, err
 	}
-}
-
-func reuseCachedOutputs(ctx context.Context, executorInput *pipelinespec.ExecutorInput, outputDefinitions *pipelinespec.ComponentOutputsSpec, mlmd *metadata.Client, cachedMLMDExecutionID string) (*pipelinespec.ExecutorOutput, []*metadata.OutputArtifact, error) {
-	cachedMLMDExecutionIDInt64, err := strconv.ParseInt(cachedMLMDExecutionID, 10, 64)
+	pipeline, err := mlmd.GetPipeline(ctx, opts.PipelineName, opts.RunID, "", "", "")
 	if err != nil {
-		return nil, nil, fmt.Errorf("failure while transfering cachedMLMDExecutionID %s from string to int64: %w", cachedMLMDExecutionID, err)
+		return nil, err
 	}
-	execution, err := mlmd.GetExecution(ctx, cachedMLMDExecutionIDInt64)
+	dag, err := mlmd.GetDAG(ctx, opts.DAGExecutionID)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failure while getting execution of cachedMLMDExecutionID %v: %w", cachedMLMDExecutionIDInt64, err)
+		return nil, err
 	}
-	executorOutput := &pipelinespec.ExecutorOutput{
-		Artifacts: map[string]*pipelinespec.ArtifactList{},
+	glog.Infof("DAG: %+v", dag.Execution)
+	tasks, err := mlmd.GetExecutionsInDAG(ctx, dag, pipeline)
+	if err != nil {
+		return nil, err
 	}
-	_, outputs, err := execution.GetParameters()
+	// Tasks that did not run, e.g. in a condition branch that was not taken,
+	// do not produce any outputs.
+	for name, task := range tasks {
+		if task.GetExecution().GetLastKnownState() == pb.Execution_CANCELED {
+			delete(tasks, name)
+		}
+	}
+	outputsSpec := opts.Component.GetDag().GetOutputs()
+	outputParameters, err := resolveDAGOutputParameters(outputsSpec, tasks)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to collect output parameters from cache: %w", err)
+		return nil, err
 	}
-	executorOutput.ParameterValues = outputs
-	outputArtifacts, err := collectOutputArtifactMetadataFromCache(ctx, executorInput, cachedMLMDExecutionIDInt64, mlmd)
+	outputArtifacts, err := resolveDAGOutputArtifacts(ctx, outputsSpec, opts.Component.GetOutputDefinitions(), tasks, mlmd)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed collect output artifact metadata from cache: %w", err)
+		return nil, err
 	}
-	return executorOutput, outputArtifacts, nil
+	if err := mlmd.PublishExecution(ctx, dag.Execution, outputParameters, outputArtifacts, pb.Execution_COMPLETE); err != nil {
+		return nil, fmt.Errorf("failed to publish DAG execution: %w", err)
+	}
+	return &Execution{ID: dag.Execution.GetID()}, nil
 }
 
-func collectOutputArtifactMetadataFromCache(ctx context.Context, executorInput *pipelinespec.ExecutorInput, cachedMLMDExecutionID int64, mlmd *metadata.Client) ([]*metadata.OutputArtifact, error) {
-	outputArtifacts, err := mlmd.GetOutputArtifactsByExecutionId(ctx, cachedMLMDExecutionID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get MLMDOutputArtifactsByName by executionId %v: %w", cachedMLMDExecutionID, err)
+func resolveDAGOutputParameters(outputsSpec *pipelinespec.DagOutputsSpec, tasks map[string]*metadata.Execution) (map[string]*structpb.Value, error) {
+	outputParameters := make(map[string]*structpb.Value)
+	// selectParameter returns the selected output parameter, or nil when the
+	// producer task did not run.
+	selectParameter := func(selector *pipelinespec.DagOutputsSpec_ParameterSelectorSpec) (*structpb.Value, error) {
+		producer, ok := tasks[selector.GetProducerSubtask()]
+		if !ok {
+			return nil, nil
+		}
+		_, outputs, err := producer.GetParameters()
+		if err != nil {
+			return nil, fmt.Errorf("get producer output parameters: %w", err)
+		}
+		value, ok := outputs[selector.GetOutputParameterKey()]
+		if !ok {
+			return nil, fmt.Errorf("cannot find output parameter key %q in producer task %q", selector.GetOutputParameterKey(), selector.GetProducerSubtask())
+		}
+		return value, nil
 	}
+	for name, paramSpec := range outputsSpec.GetParameters() {
+		paramError := func(err error) error {
+			return fmt.Errorf("resolving DAG output parameter %s with spec %s: %w", name, paramSpec, err)
+		}
+		switch t := paramSpec.Kind.(type) {
+		case *pipelinespec.DagOutputsSpec_DagOutputParameterSpec_ValueFromParameter:
+			value, err := selectParameter(paramSpec.GetValueFromParameter())
+			if err != nil {
+				return nil, paramError(err)
+			}
+			if value == nil {
+				glog.Warningf("DAG output parameter %q is not produced, because producer task %q did not run", name, paramSpec.GetValueFromParameter().GetProducerSubtask())
+				continue
+			}
+			outputParameters[name] = value
+		case *pipelinespec.DagOutputsSpec_DagOutputParameterSpec_ValueFromOneof:
+			// Only one of the selected producer tasks is expected to run,
+			// e.g. in mutually exclusive condition branches.
+			var selected *pipelinespec.DagOutputsSpec_ParameterSelectorSpec
+			for _, selector := range paramSpec.GetValueFromOneof().GetParameterSelectors() {
+				value, err := selectParameter(selector)
+				if err != nil {
+					return nil, paramError(err)
+				}
+				if value == nil {
+					continue
+				}
+				if selected != nil {
+					return nil, paramError(fmt.Errorf("got multiple values from producer tasks %q and %q, expect one", selected.GetProducerSubtask(), selector.GetProducerSubtask()))
+				}
+				selected = selector
+				outputParameters[name] = value
+			}
+			if selected == nil {
+				glog.Warningf("DAG output parameter %q is not produced, because none of the producer tasks ran", name)
+			}
+		default:
+			return nil, paramError(fmt.Errorf("DAG output parameter spec of type %T not implemented yet", t))
+		}
+	}
+	return outputParameters, nil
+}
 
-	// Register artifacts with MLMD.
-	registeredMLMDArtifacts := make([]*metadata.OutputArtifact, 0, len(executorInput.GetOutputs().GetArtifacts()))
-	for name, artifactList := range executorInput.GetOutputs().GetArtifacts() {
-		if len(artifactList.Artifacts) == 0 {
-			continue
+func resolveDAGOutputArtifacts(ctx context.Context, outputsSpec *pipelinespec.DagOutputsSpec, outputDefinitions *pipelinespec.ComponentOutputsSpec, tasks map[string]*metadata.Execution, mlmd *metadata.Client) ([]*metadata.OutputArtifact, error) {
+	var outputArtifacts []*metadata.OutputArtifact
+	for name, artifactSpec := range outputsSpec.GetArtifacts() {
+		artifactError := func(err error) error {
+			return fmt.Errorf("resolving DAG output artifact %s with spec %s: %w", name, artifactSpec, err)
 		}
-		artifact := artifactList.Artifacts[0]
-		outputArtifact, ok := outputArtifacts[name]
+		schema := outputDefinitions.GetArtifacts()[name].GetArtifactType().GetInstanceSchema()
+		// Artifacts from all selected producer tasks that ran are aggregated
+		// into the output artifact list. With conditional branches, only one
+		// of the producer tasks runs.
+		found := false
+		for _, selector := range artifactSpec.GetArtifactSelectors() {
+			producer, ok := tasks[selector.GetProducerSubtask()]
+			if !ok {
+				continue
+			}
+			outputs, err := mlmd.GetOutputArtifactListsByExecutionID(ctx, producer.GetID())
+			if err != nil {
+				return nil, artifactError(err)
+			}
+			artifacts, ok := outputs[selector.GetOutputArtifactKey()]
+			if !ok {
+				return nil, artifactError(fmt.Errorf("cannot find output artifact key %q in producer task %q", selector.GetOutputArtifactKey(), selector.GetProducerSubtask()))
+			}
+			for _, artifact := range artifacts {
+				outputArtifacts = append(outputArtifacts, &metadata.OutputArtifact{
+					Name:     name,
+					Artifact: artifact.Artifact,
+					Schema:   schema,
+				})
+			}
+			found = true
+		}
+		if !found {
+			glog.Warningf("DAG output artifact %q is not produced, because none of the producer tasks ran", name)
+		}
+	}
+	return outputArtifacts, nil
+}
+
+// Resolver runs the artifact queries of a resolver component against MLMD,
+// and publishes matched artifacts as outputs of the resolver task, so that
+// downstream tasks can consume them as task output artifacts.
+func Resolver(ctx context.Context, opts Options, mlmd *metadata.Client) (execution *Execution, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("driver.Resolver(%s) failed: %w", opts.info(), err)
+		}
+	}()
+	err = validateResolver(opts)
+	if err != nil {
+		return nil, err
+	}
+	var iterationIndex *int
+	if opts.IterationIndex >= 0 {
+		index := opts.IterationIndex
+		iterationIndex = &index
+	}
+	pipeline, err := mlmd.GetPipeline(ctx, opts.PipelineName, opts.RunID, "", "", "")
+	if err != nil {
+		return nil, err
+	}
+	dag, err := mlmd.GetDAG(ctx, opts.DAGExecutionID)
+	if err != nil {
+		return nil, err
+	}
+	glog.Infof("parent DAG: %+v", dag.Execution)
+	// When the run is retried, the task may have an execution from a previous
+	// attempt. A succeeded attempt is kept, otherwise the execution is re-driven.
+	previous, err := mlmd.GetTaskExecutionInDAG(ctx, dag, pipeline, opts.Task.GetTaskInfo().GetName(), iterationIndex)
+	if err != nil {
+		return nil, err
+	}
+	if previous.GetExecution().GetLastKnownState() == pb.Execution_COMPLETE {
+		glog.Infof("Resolver succeeded in a previous attempt, execution ID=%v", previous.GetID())
+		return &Execution{ID: previous.GetID()}, nil
+	}
+	var outputArtifacts []*metadata.OutputArtifact
+	for name, query := range opts.Resolver.GetOutputArtifactQueries() {
+		outputSpec, ok := opts.Component.GetOutputDefinitions().GetArtifacts()[name]
 		if !ok {
-			return nil, fmt.Errorf("unable to find artifact with name %v in mlmd output artifacts", name)
+			return nil, fmt.Errorf("output artifact %q of the resolver is not defined in component output definitions", name)
+		}
+		artifacts, err := mlmd.QueryArtifacts(ctx, pipeline, query)
+		if err != nil {
+			return nil, fmt.Errorf("resolving output artifact %q: %w", name, err)
+		}
+		if len(artifacts) == 0 {
+			return nil, fmt.Errorf("resolving output artifact %q: no artifacts matched filter %q", name, query.GetFilter())
+		}
+		glog.Infof("output artifact %q resolved to %v artifact(s), latest ID=%v", name, len(artifacts), artifacts[0].GetId())
+		for _, artifact := range artifacts {
+			outputArtifacts = append(outputArtifacts, &metadata.OutputArtifact{
+				Name:     name,
+				Artifact: artifact,
+				Schema:   outputSpec.GetArtifactType().GetInstanceSchema(),
+			})
 		}
-		outputArtifact.Schema = artifact.GetType().GetInstanceSchema()
-		registeredMLMDArtifacts = append(registeredMLMDArtifacts, outputArtifact)
 	}
-	return registeredMLMDArtifacts, nil
+	ecfg := &metadata.ExecutionConfig{
+		TaskName:       opts.Task.GetTaskInfo().GetName(),
+		ExecutionType:  metadata.ResolverExecutionTypeName,
+		ParentDagID:    dag.Execution.GetID(),
+		IterationIndex: iterationIndex,
+	}
+	var createdExecution *metadata.Execution
+	if previous != nil {
+		glog.Infof("Re-driving resolver execution ID=%v of a previous attempt", previous.GetID())
+		createdExecution, err = mlmd.ResetExecution(ctx, pipeline, previous, ecfg)
+	} else {
+		createdExecution, err = mlmd.CreateExecution(ctx, pipeline, ecfg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	glog.Infof("Created execution: %s", createdExecution)
+	execution = &Execution{ID: createdExecution.GetID()}
+	if err := mlmd.PublishExecution(ctx, createdExecution, nil, outputArtifacts, pb.Execution_COMPLETE); err != nil {
+		return execution, fmt.Errorf("failed to publish resolver execution: %w", err)
+	}
+	return execution, nil
+}
 
+// Get iteration items from a structpb.Value.
+// Return value may be
+// * a list of JSON serializable structs
+// * a list of structpb.Value
+func getItems(value *structpb.Value) (items []*structpb.Value, err error) {
+	switch v := value.GetKind().(type) {
+	case *structpb.Value_ListValue:
+		return v.ListValue.GetValues(), nil
+	case *structpb.Value_StringValue:
+		listValue := structpb.Value{}
+		if err = listValue.UnmarshalJSON([]byte(v.StringValue)); err != nil {
+			return nil, err
+		}
+		return listValue.GetListValue().GetValues(), nil
+	default:
+		return nil, fmt.Errorf("value of type %T cannot be iterated", v)
+	}
 }
 
 func getFingerPrint(opts Options, executorInput *pipelinespec.ExecutorInput) (string, error) {
-	outputParametersTypeMap := make(map[string]string)
-	for outputParamName, outputParamSpec := range opts.Component.GetOutputDefinitions().GetParameters() {
-		outputParametersTypeMap[outputParamName] = outputParamSpec.GetParameterType().String()
-	}
 	userCmdArgs := make([]string, 0, len(opts.Container.Command)+len(opts.Container.Args))
 	userCmdArgs = append(userCmdArgs, opts.Container.Command...)
 	userCmdArgs = append(userCmdArgs, opts.Container.Args...)
-
-	cacheKey, err := cacheutils.GenerateCacheKey(executorInput.GetInputs(), executorInput.GetOutputs(), outputParametersTypeMap, userCmdArgs, opts.Container.Image)
-	if err != nil {
-		return "", fmt.Errorf("failure while generating CacheKey: %w", err)
-	}
-	fingerPrint, err := cacheutils.GenerateFingerPrint(cacheKey)
-	return fingerPrint, err
+	return component.FingerPrint(executorInput, opts.Component.GetOutputDefinitions(), userCmdArgs, opts.Container.Image)
 }
 
 func validateContainer(opts Options) (err error) {
@@ -583,6 +940,24 @@ func validateContainer(opts Options) (err error) {
 	return validateNonRoot(opts)
 }
 
+func validateResolver(opts Options) (err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("invalid resolver driver args: %w", err)
+		}
+	}()
+	if opts.Resolver == nil {
+		return fmt.Errorf("resolver spec is required")
+	}
+	if len(opts.Resolver.GetOutputArtifactQueries()) == 0 {
+		return fmt.Errorf("resolver spec must have at least one output artifact query")
+	}
+	if opts.Container != nil {
+		return fmt.Errorf("container spec is unnecessary")
+	}
+	return validateNonRoot(opts)
+}
+
 func validateDAG(opts Options) (err error) {
 	defer func() {
 		if err != nil {
@@ -592,9 +967,42 @@ func validateDAG(opts Options) (err error) {
 	if opts.Container != nil {
 		return fmt.Errorf("container spec is unnecessary")
 	}
+	if opts.Resolver != nil {
+		return fmt.Errorf("resolver spec is unnecessary")
+	}
 	return validateNonRoot(opts)
 }
 
+func validateDAGOutputs(opts Options) (err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("invalid DAG outputs driver args: %w", err)
+		}
+	}()
+	if opts.PipelineName == "" {
+		return fmt.Errorf("pipeline name is required")
+	}
+	if opts.RunID == "" {
+		return fmt.Errorf("KFP run ID is required")
+	}
+	if opts.Component.GetDag() == nil {
+		return fmt.Errorf("DAG component spec is required")
+	}
+	if opts.DAGExecutionID == 0 {
+		return fmt.Errorf("DAG execution ID is required")
+	}
+	if opts.RuntimeConfig != nil {
+		return fmt.Errorf("runtime config is unnecessary")
+	}
+	if opts.Container != nil {
+		return fmt.Errorf("container spec is unnecessary")
+	}
+	if opts.Resolver != nil {
+		return fmt.Errorf("resolver spec is unnecessary")
+	}
+	return nil
+}
+
 func validateNonRoot(opts Options) error {
 	if opts.PipelineName == "" {
 		return fmt.Errorf("pipeline name is required")
@@ -746,7 +1154,18 @@ func resolveInputs(ctx context.Context, dag *metadata.DAG, iterationIndex *int,
 		inputs.Artifacts = artifacts
 		switch {
 		case task.GetArtifactIterator() != nil:
-			return nil, fmt.Errorf("artifact iterator not implemented yet")
+			itemsInput := task.GetArtifactIterator().GetItems().GetInputArtifact()
+			if itemsInput == "" {
+				return nil, fmt.Errorf("cannot retrieve artifact iterator.")
+			}
+			items := inputs.Artifacts[itemsInput].GetArtifacts()
+			if *iterationIndex >= len(items) {
+				return nil, fmt.Errorf("bug: %v items found, but getting index %v", len(items), *iterationIndex)
+			}
+			delete(inputs.Artifacts, itemsInput)
+			inputs.Artifacts[task.GetArtifactIterator().GetItemInput()] = &pipelinespec.ArtifactList{
+				Artifacts: []*pipelinespec.RuntimeArtifact{items[*iterationIndex]},
+			}
 		case task.GetParameterIterator() != nil:
 			var itemsInput string
 			if task.GetParameterIterator().GetItems().GetInputParameter() != "" {
@@ -785,6 +1204,19 @@ func resolveInputs(ctx context.Context, dag *metadata.DAG, iterationIndex *int,
 		tasksCache = tasks
 		return tasks, nil
 	}
+	// get input artifacts of the parent DAG on demand
+	var dagInputArtifactsCache map[string]*pipelinespec.ArtifactList
+	getDAGInputArtifacts := func() (map[string]*pipelinespec.ArtifactList, error) {
+		if dagInputArtifactsCache != nil {
+			return dagInputArtifactsCache, nil
+		}
+		artifacts, err := mlmd.GetInputArtifactsByExecutionID(ctx, dag.Execution.GetID())
+		if err != nil {
+			return nil, err
+		}
+		dagInputArtifactsCache = artifacts
+		return artifacts, nil
+	}
 	for name, paramSpec := range task.GetInputs().GetParameters() {
 		paramError := func(err error) error {
 			return fmt.Errorf("resolving input parameter %s with spec %s: %w", name, paramSpec, err)
@@ -834,9 +1266,24 @@ func resolveInputs(ctx context.Context, dag *metadata.DAG, iterationIndex *int,
 			default:
 				return nil, paramError(fmt.Errorf("param runtime value spec of type %T not implemented", t))
 			}
-
-		// TODO(Bobgy): implement the following cases
-		// case *pipelinespec.TaskInputsSpec_InputParameterSpec_TaskFinalStatus_:
+		case *pipelinespec.TaskInputsSpec_InputParameterSpec_TaskFinalStatus_:
+			producerTask := paramSpec.GetTaskFinalStatus().GetProducerTask()
+			if producerTask == "" {
+				return nil, paramError(fmt.Errorf("producer task is empty"))
+			}
+			tasks, err := getDAGTasks()
+			if err != nil {
+				return nil, paramError(err)
+			}
+			producer, ok := tasks[producerTask]
+			if !ok {
+				return nil, paramError(fmt.Errorf("cannot find producer task %q", producerTask))
+			}
+			finalStatus, err := taskFinalStatus(ctx, producer, pipeline, mlmd)
+			if err != nil {
+				return nil, paramError(err)
+			}
+			inputs.ParameterValues[name] = finalStatus
 		default:
 			return nil, paramError(fmt.Errorf("parameter spec of type %T not implemented yet", t))
 		}
@@ -847,7 +1294,21 @@ func resolveInputs(ctx context.Context, dag *metadata.DAG, iterationIndex *int,
 		}
 		switch t := artifactSpec.Kind.(type) {
 		case *pipelinespec.TaskInputsSpec_InputArtifactSpec_ComponentInputArtifact:
-			return nil, artifactError(fmt.Errorf("component input artifact not implemented yet"))
+			componentInput := artifactSpec.GetComponentInputArtifact()
+			if componentInput == "" {
+				return nil, artifactError(fmt.Errorf("empty component input"))
+			}
+			// Input artifacts of a DAG are recorded as input events of
+			// the DAG execution, when its DAG driver runs.
+			dagInputArtifacts, err := getDAGInputArtifacts()
+			if err != nil {
+				return nil, artifactError(err)
+			}
+			artifactList, ok := dagInputArtifacts[componentInput]
+			if !ok {
+				return nil, artifactError(fmt.Errorf("parent DAG does not have input artifact %s", componentInput))
+			}
+			inputs.Artifacts[name] = artifactList
 
 		case *pipelinespec.TaskInputsSpec_InputArtifactSpec_TaskOutputArtifact:
 			taskOutput := artifactSpec.GetTaskOutputArtifact()
@@ -866,21 +1327,25 @@ func resolveInputs(ctx context.Context, dag *metadata.DAG, iterationIndex *int,
 				return nil, artifactError(fmt.Errorf("cannot find producer task %q", taskOutput.GetProducerTask()))
 			}
 			// TODO(Bobgy): cache results
-			outputs, err := mlmd.GetOutputArtifactsByExecutionId(ctx, producer.GetID())
+			outputs, err := mlmd.GetOutputArtifactListsByExecutionID(ctx, producer.GetID())
 			if err != nil {
 				return nil, artifactError(err)
 			}
-			artifact, ok := outputs[taskOutput.GetOutputArtifactKey()]
+			artifacts, ok := outputs[taskOutput.GetOutputArtifactKey()]
 			if !ok {
 				return nil, artifactError(fmt.Errorf("cannot find output artifact key %q in producer task %q", taskOutput.GetOutputArtifactKey(), taskOutput.GetProducerTask()))
 			}
-			runtimeArtifact, err := artifact.ToRuntimeArtifact()
-			if err != nil {
-				return nil, artifactError(err)
-			}
-			inputs.Artifacts[name] = &pipelinespec.ArtifactList{
-				Artifacts: []*pipelinespec.RuntimeArtifact{runtimeArtifact},
+			// An output can be a list of artifacts, e.g. the items of
+			// an artifact iterator, so all of them are passed on.
+			artifactList := &pipelinespec.ArtifactList{}
+			for _, artifact := range artifacts {
+				runtimeArtifact, err := artifact.ToRuntimeArtifact()
+				if err != nil {
+					return nil, artifactError(err)
+				}
+				artifactList.Artifacts = append(artifactList.Artifacts, runtimeArtifact)
 			}
+			inputs.Artifacts[name] = artifactList
 		default:
 			return nil, artifactError(fmt.Errorf("artifact spec of type %T not implemented yet", t))
 		}
@@ -889,6 +1354,35 @@ func resolveInputs(ctx context.Context, dag *metadata.DAG, iterationIndex *int,
 	return inputs, nil
 }
 
+// taskFinalStatus returns the PipelineTaskFinalStatus of a finished task as a
+// struct parameter value.
+func taskFinalStatus(ctx context.Context, producer *metadata.Execution, pipeline *metadata.Pipeline, mlmd *metadata.Client) (*structpb.Value, error) {
+	state, errorMessage, err := mlmd.GetTaskFinalState(ctx, producer, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	finalStatus := &pipelinespec.PipelineTaskFinalStatus{
+		State:                   state.String(),
+		PipelineJobResourceName: pipeline.GetRunID(),
+		PipelineTaskName:        producer.TaskName(),
+	}
+	if state == pipelinespec.PipelineStateEnum_FAILED {
+		finalStatus.Error = &status.Status{
+			Code:    int32(codes.Unknown),
+			Message: errorMessage,
+		}
+	}
+	b, err := protojson.Marshal(finalStatus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal task final status: %w", err)
+	}
+	value := &structpb.Value{}
+	if err := protojson.Unmarshal(b, value); err != nil {
+		return nil, fmt.Errorf("failed to convert task final status to struct: %w", err)
+	}
+	return value, nil
+}
+
 func provisionOutputs(pipelineRoot, taskName string, outputsSpec *pipelinespec.ComponentOutputsSpec) *pipelinespec.ExecutorInput_Outputs {
 	outputs := &pipelinespec.ExecutorInput_Outputs{
 		Artifacts:  make(map[string]*pipelinespec.ArtifactList),
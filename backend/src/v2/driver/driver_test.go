@@ -0,0 +1,558 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/v2/config"
+	"github.com/kubeflow/pipelines/backend/src/v2/expression"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
+	k8score "k8s.io/api/core/v1"
+	k8sres "k8s.io/apimachinery/pkg/api/resource"
+)
+
+func Test_makePodSpecPatch_resources(t *testing.T) {
+	type resourceSpec = pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_ResourceSpec
+	type acceleratorConfig = pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_ResourceSpec_AcceleratorConfig
+	t4 := map[string]config.AcceleratorConfig{
+		"NVIDIA_TESLA_T4": {
+			ResourceName: "nvidia.com/gpu",
+			NodeSelector: map[string]string{"cloud.google.com/gke-accelerator": "nvidia-tesla-t4"},
+		},
+	}
+	tests := []struct {
+		name             string
+		resources        *resourceSpec
+		accelerators     map[string]config.AcceleratorConfig
+		wantResources    k8score.ResourceRequirements
+		wantNodeSelector map[string]string
+		wantErr          bool
+	}{
+		{
+			name: "no resources",
+		},
+		{
+			name:      "cpu and memory",
+			resources: &resourceSpec{CpuLimit: 0.5, MemoryLimit: 2},
+			wantResources: k8score.ResourceRequirements{
+				Limits: k8score.ResourceList{
+					k8score.ResourceCPU:    k8sres.MustParse("500m"),
+					k8score.ResourceMemory: k8sres.MustParse("2G"),
+				},
+				Requests: k8score.ResourceList{
+					k8score.ResourceCPU:    k8sres.MustParse("500m"),
+					k8score.ResourceMemory: k8sres.MustParse("2G"),
+				},
+			},
+		},
+		{
+			name:         "configured accelerator",
+			resources:    &resourceSpec{Accelerator: &acceleratorConfig{Type: "NVIDIA_TESLA_T4", Count: 2}},
+			accelerators: t4,
+			wantResources: k8score.ResourceRequirements{
+				Limits: k8score.ResourceList{"nvidia.com/gpu": k8sres.MustParse("2")},
+			},
+			wantNodeSelector: map[string]string{"cloud.google.com/gke-accelerator": "nvidia-tesla-t4"},
+		},
+		{
+			name:      "extended resource name as accelerator type",
+			resources: &resourceSpec{CpuLimit: 1, Accelerator: &acceleratorConfig{Type: "amd.com/gpu", Count: 1}},
+			wantResources: k8score.ResourceRequirements{
+				Limits: k8score.ResourceList{
+					k8score.ResourceCPU: k8sres.MustParse("1"),
+					"amd.com/gpu":       k8sres.MustParse("1"),
+				},
+				Requests: k8score.ResourceList{
+					k8score.ResourceCPU: k8sres.MustParse("1"),
+				},
+			},
+		},
+		{
+			name:      "zero accelerators",
+			resources: &resourceSpec{Accelerator: &acceleratorConfig{Type: "NVIDIA_TESLA_T4"}},
+		},
+		{
+			name:      "unknown accelerator type",
+			resources: &resourceSpec{Accelerator: &acceleratorConfig{Type: "NVIDIA_TESLA_T4", Count: 1}},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			container := &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec{
+				Image:     "python:3.7",
+				Resources: tt.resources,
+			}
+			got, err := makePodSpecPatch(container, &pipelinespec.ComponentSpec{}, &pipelinespec.ExecutorInput{}, 1, "my-pipeline", "my-run", tt.accelerators, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("makePodSpecPatch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			podSpec := &k8score.PodSpec{}
+			if err := json.Unmarshal([]byte(got), podSpec); err != nil {
+				t.Fatalf("failed to parse pod spec patch %q: %v", got, err)
+			}
+			if len(podSpec.Containers) != 1 {
+				t.Fatalf("pod spec patch has %v containers, want 1: %s", len(podSpec.Containers), got)
+			}
+			if diff := cmp.Diff(tt.wantResources, podSpec.Containers[0].Resources, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("makePodSpecPatch() resources mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantNodeSelector, podSpec.NodeSelector, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("makePodSpecPatch() node selector mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_makePodSpecPatch_env(t *testing.T) {
+	container := &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec{
+		Image: "python:3.7",
+		Env: []*pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_EnvVar{
+			{Name: "MODE", Value: "train"},
+			{Name: "TEXT", Value: "text is {{$.inputs.parameters['text']}}"},
+			{Name: "DATA_DIR", Value: "$(HOME)/data"},
+		},
+	}
+	executorInput := &pipelinespec.ExecutorInput{
+		Inputs: &pipelinespec.ExecutorInput_Inputs{
+			ParameterValues: map[string]*structpb.Value{"text": structpb.NewStringValue("hello")},
+		},
+	}
+	got, err := makePodSpecPatch(container, &pipelinespec.ComponentSpec{}, executorInput, 1, "my-pipeline", "my-run", nil, nil)
+	if err != nil {
+		t.Fatalf("makePodSpecPatch() error = %v", err)
+	}
+	podSpec := &k8score.PodSpec{}
+	if err := json.Unmarshal([]byte(got), podSpec); err != nil {
+		t.Fatalf("failed to parse pod spec patch %q: %v", got, err)
+	}
+	want := []k8score.EnvVar{
+		{Name: "MODE", Value: "train"},
+		{Name: "TEXT", Value: "text is hello"},
+		{Name: "DATA_DIR", Value: "$(HOME)/data"},
+	}
+	if diff := cmp.Diff(want, podSpec.Containers[0].Env); diff != "" {
+		t.Errorf("makePodSpecPatch() env mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_preCacheCheckLauncherArgs(t *testing.T) {
+	hook := &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_Lifecycle_Exec{
+		Command: []string{"check"},
+		Args:    []string{"--text", "{{$.inputs.parameters['text']}}"},
+	}
+	tests := []struct {
+		name    string
+		caching *pipelinespec.PipelineTaskSpec_CachingOptions
+		want    []string
+	}{
+		{
+			name: "cache disabled",
+			want: []string{},
+		},
+		{
+			// The launcher looks up the cache after running the hook.
+			name:    "cache enabled",
+			caching: &pipelinespec.PipelineTaskSpec_CachingOptions{EnableCache: true, MaxCacheStaleness: "P1D"},
+			want:    []string{"--enable_cache", "--max_cache_staleness", "86400", "--container_image", "python:3.7"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := Options{
+				Task:      &pipelinespec.PipelineTaskSpec{CachingOptions: tt.caching},
+				Container: &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec{Image: "python:3.7"},
+			}
+			got, err := preCacheCheckLauncherArgs(opts, hook)
+			if err != nil {
+				t.Fatalf("preCacheCheckLauncherArgs() error = %v", err)
+			}
+			if len(got) < 2 || got[0] != "--pre_cache_check" {
+				t.Fatalf("preCacheCheckLauncherArgs() = %v, want the hook first", got)
+			}
+			gotHook := &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_Lifecycle_Exec{}
+			if err := protojson.Unmarshal([]byte(got[1]), gotHook); err != nil {
+				t.Fatalf("failed to parse pre-cache-check lifecycle hook %q: %v", got[1], err)
+			}
+			if diff := cmp.Diff(hook, gotHook, protocmp.Transform()); diff != "" {
+				t.Errorf("preCacheCheckLauncherArgs() hook mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.want, got[2:], cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("preCacheCheckLauncherArgs() args mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_preCacheCheckLauncherArgs_EmptyCommand(t *testing.T) {
+	opts := Options{
+		Task:      &pipelinespec.PipelineTaskSpec{},
+		Container: &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec{Image: "python:3.7"},
+	}
+	_, err := preCacheCheckLauncherArgs(opts, &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_Lifecycle_Exec{})
+	if err == nil {
+		t.Fatalf("preCacheCheckLauncherArgs() error = nil, want an error for an empty command")
+	}
+}
+
+func Test_makePodSpecPatch_launcherArgs(t *testing.T) {
+	container := &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec{
+		Image:   "python:3.7",
+		Command: []string{"python3"},
+		Args:    []string{"main.py"},
+	}
+	got, err := makePodSpecPatch(container, &pipelinespec.ComponentSpec{}, &pipelinespec.ExecutorInput{}, 1, "my-pipeline", "my-run", nil, []string{"--pre_cache_check", "{}"})
+	if err != nil {
+		t.Fatalf("makePodSpecPatch() error = %v", err)
+	}
+	podSpec := &k8score.PodSpec{}
+	if err := json.Unmarshal([]byte(got), podSpec); err != nil {
+		t.Fatalf("failed to parse pod spec patch %q: %v", got, err)
+	}
+	// The launcher arguments are passed before the user command.
+	command := podSpec.Containers[0].Command
+	want := []string{"--pre_cache_check", "{}", "--"}
+	if diff := cmp.Diff(want, command[len(command)-len(want):]); diff != "" {
+		t.Errorf("makePodSpecPatch() launcher command mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"python3", "main.py"}, podSpec.Containers[0].Args); diff != "" {
+		t.Errorf("makePodSpecPatch() args mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// newTestRootDAG creates a pipeline run with a root DAG in the fake MLMD
+// store, and a producer task in the root DAG that outputs the artifacts with
+// the given URIs as a list named "models". The same URI is the same artifact.
+func newTestRootDAG(t *testing.T, mlmd *metadata.Client, uris []string) (*metadata.Pipeline, *metadata.Execution) {
+	t.Helper()
+	ctx := context.Background()
+	pipeline, err := mlmd.GetPipeline(ctx, "my-pipeline", "my-run", "ns1", "run-resource", "gs://my-bucket/root")
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := mlmd.CreateExecution(ctx, pipeline, &metadata.ExecutionConfig{
+		TaskName:      "root",
+		ExecutionType: metadata.DagExecutionTypeName,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	producer, err := mlmd.CreateExecution(ctx, pipeline, &metadata.ExecutionConfig{
+		TaskName:      "producer",
+		ExecutionType: metadata.ContainerExecutionTypeName,
+		ParentDagID:   root.GetID(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	artifacts := make(map[string]*metadata.OutputArtifact)
+	var outputs []*metadata.OutputArtifact
+	for _, uri := range uris {
+		artifact, ok := artifacts[uri]
+		if !ok {
+			artifact, err = mlmd.RecordArtifact(ctx, "models", "title: system.Model\ntype: object\n",
+				&pipelinespec.RuntimeArtifact{Uri: uri}, pb.Artifact_LIVE)
+			if err != nil {
+				t.Fatal(err)
+			}
+			artifacts[uri] = artifact
+		}
+		outputs = append(outputs, artifact)
+	}
+	if err := mlmd.PublishExecution(ctx, producer, nil, outputs, pb.Execution_COMPLETE); err != nil {
+		t.Fatal(err)
+	}
+	return pipeline, root
+}
+
+func artifactURIs(list *pipelinespec.ArtifactList) []string {
+	var uris []string
+	for _, artifact := range list.GetArtifacts() {
+		uris = append(uris, artifact.GetUri())
+	}
+	return uris
+}
+
+// newArtifactIteratorTask returns a task that iterates over the "models"
+// output of the producer task.
+func newArtifactIteratorTask() *pipelinespec.PipelineTaskSpec {
+	return &pipelinespec.PipelineTaskSpec{
+		TaskInfo: &pipelinespec.PipelineTaskInfo{Name: "for-loop-1"},
+		Inputs: &pipelinespec.TaskInputsSpec{
+			Artifacts: map[string]*pipelinespec.TaskInputsSpec_InputArtifactSpec{
+				"pipelinechannel--models": {
+					Kind: &pipelinespec.TaskInputsSpec_InputArtifactSpec_TaskOutputArtifact{
+						TaskOutputArtifact: &pipelinespec.TaskInputsSpec_InputArtifactSpec_TaskOutputArtifactSpec{
+							ProducerTask:      "producer",
+							OutputArtifactKey: "models",
+						},
+					},
+				},
+			},
+		},
+		Iterator: &pipelinespec.PipelineTaskSpec_ArtifactIterator{
+			ArtifactIterator: &pipelinespec.ArtifactIteratorSpec{
+				Items:     &pipelinespec.ArtifactIteratorSpec_ItemsSpec{InputArtifact: "pipelinechannel--models"},
+				ItemInput: "pipelinechannel--models-loop-item",
+			},
+		},
+	}
+}
+
+func Test_DAG_ArtifactIterator(t *testing.T) {
+	ctx := context.Background()
+	mlmd := metadata.NewFakeClient()
+	uris := []string{"gs://my-bucket/a", "gs://my-bucket/b", "gs://my-bucket/a"}
+	_, root := newTestRootDAG(t, mlmd, uris)
+	opts := Options{
+		PipelineName:   "my-pipeline",
+		RunID:          "my-run",
+		Component:      &pipelinespec.ComponentSpec{},
+		Task:           newArtifactIteratorTask(),
+		DAGExecutionID: root.GetID(),
+		IterationIndex: -1,
+	}
+
+	// The iterator fans out over every item of the list, including repeated
+	// artifacts.
+	iterator, err := DAG(ctx, opts, mlmd)
+	if err != nil {
+		t.Fatalf("DAG() error = %v", err)
+	}
+	if iterator.IterationCount == nil || *iterator.IterationCount != len(uris) {
+		t.Fatalf("DAG() iteration count = %v, want %v", iterator.IterationCount, len(uris))
+	}
+	items := iterator.ExecutorInput.GetInputs().GetArtifacts()["pipelinechannel--models"]
+	if diff := cmp.Diff(uris, artifactURIs(items)); diff != "" {
+		t.Errorf("DAG() iterator items mismatch (-want +got):\n%s", diff)
+	}
+
+	// Each iteration gets its item from the inputs of the iterator execution.
+	for i, uri := range uris {
+		opts.DAGExecutionID = iterator.ID
+		opts.IterationIndex = i
+		iteration, err := DAG(ctx, opts, mlmd)
+		if err != nil {
+			t.Fatalf("DAG(iteration %v) error = %v", i, err)
+		}
+		artifacts := iteration.ExecutorInput.GetInputs().GetArtifacts()
+		if _, ok := artifacts["pipelinechannel--models"]; ok {
+			t.Errorf("DAG(iteration %v) got the items of the iterator", i)
+		}
+		item := artifacts["pipelinechannel--models-loop-item"]
+		if diff := cmp.Diff([]string{uri}, artifactURIs(item)); diff != "" {
+			t.Errorf("DAG(iteration %v) item mismatch (-want +got):\n%s", i, diff)
+		}
+	}
+}
+
+func Test_resolveInputs_ArtifactIterator_IndexOutOfRange(t *testing.T) {
+	ctx := context.Background()
+	mlmd := metadata.NewFakeClient()
+	_, root := newTestRootDAG(t, mlmd, []string{"gs://my-bucket/a"})
+	opts := Options{
+		PipelineName:   "my-pipeline",
+		RunID:          "my-run",
+		Component:      &pipelinespec.ComponentSpec{},
+		Task:           newArtifactIteratorTask(),
+		DAGExecutionID: root.GetID(),
+		IterationIndex: -1,
+	}
+	iterator, err := DAG(ctx, opts, mlmd)
+	if err != nil {
+		t.Fatalf("DAG() error = %v", err)
+	}
+
+	pipeline, err := mlmd.GetPipeline(ctx, "my-pipeline", "my-run", "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dag, err := mlmd.GetDAG(ctx, iterator.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expr, err := expression.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	iterationIndex := 1
+	_, err = resolveInputs(ctx, dag, &iterationIndex, pipeline, opts.Task, nil, mlmd, expr)
+	if err == nil {
+		t.Fatalf("resolveInputs() error = nil, want an error for an iteration index out of range")
+	}
+}
+
+func Test_resolveInputs_ComponentInputArtifact(t *testing.T) {
+	ctx := context.Background()
+	mlmd := metadata.NewFakeClient()
+	pipeline, root := newTestRootDAG(t, mlmd, nil)
+	uris := []string{"gs://my-bucket/a", "gs://my-bucket/b"}
+	var ids []int64
+	for _, uri := range uris {
+		artifact, err := mlmd.RecordArtifact(ctx, "models", "title: system.Model\ntype: object\n",
+			&pipelinespec.RuntimeArtifact{Uri: uri}, pb.Artifact_LIVE)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, artifact.Artifact.GetId())
+	}
+	// The input artifacts of the parent DAG are recorded when its driver runs.
+	parent, err := mlmd.CreateExecution(ctx, pipeline, &metadata.ExecutionConfig{
+		TaskName:         "parent",
+		ExecutionType:    metadata.DagExecutionTypeName,
+		ParentDagID:      root.GetID(),
+		InputArtifactIDs: map[string][]int64{"pipelinechannel--models": ids},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dag, err := mlmd.GetDAG(ctx, parent.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expr, err := expression.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	newTask := func(componentInput string) *pipelinespec.PipelineTaskSpec {
+		return &pipelinespec.PipelineTaskSpec{
+			TaskInfo: &pipelinespec.PipelineTaskInfo{Name: "consumer"},
+			Inputs: &pipelinespec.TaskInputsSpec{
+				Artifacts: map[string]*pipelinespec.TaskInputsSpec_InputArtifactSpec{
+					"models": {
+						Kind: &pipelinespec.TaskInputsSpec_InputArtifactSpec_ComponentInputArtifact{
+							ComponentInputArtifact: componentInput,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	inputs, err := resolveInputs(ctx, dag, nil, pipeline, newTask("pipelinechannel--models"), nil, mlmd, expr)
+	if err != nil {
+		t.Fatalf("resolveInputs() error = %v", err)
+	}
+	if diff := cmp.Diff(uris, artifactURIs(inputs.GetArtifacts()["models"])); diff != "" {
+		t.Errorf("resolveInputs() artifacts mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = resolveInputs(ctx, dag, nil, pipeline, newTask("pipelinechannel--datasets"), nil, mlmd, expr)
+	if err == nil {
+		t.Fatalf("resolveInputs() error = nil, want an error for an input artifact missing in the parent DAG")
+	}
+}
+
+func Test_Resolver_RetriedTask(t *testing.T) {
+	ctx := context.Background()
+	mlmd := metadata.NewFakeClient()
+	pipeline, root := newTestRootDAG(t, mlmd, []string{"gs://my-bucket/a"})
+	opts := Options{
+		PipelineName: "my-pipeline",
+		RunID:        "my-run",
+		Component: &pipelinespec.ComponentSpec{
+			OutputDefinitions: &pipelinespec.ComponentOutputsSpec{
+				Artifacts: map[string]*pipelinespec.ComponentOutputsSpec_ArtifactSpec{
+					"model": {ArtifactType: &pipelinespec.ArtifactTypeSchema{
+						Kind: &pipelinespec.ArtifactTypeSchema_SchemaTitle{SchemaTitle: "system.Model"},
+					}},
+				},
+			},
+		},
+		Task: &pipelinespec.PipelineTaskSpec{TaskInfo: &pipelinespec.PipelineTaskInfo{Name: "resolver"}},
+		Resolver: &pipelinespec.PipelineDeploymentConfig_ResolverSpec{
+			OutputArtifactQueries: map[string]*pipelinespec.PipelineDeploymentConfig_ResolverSpec_ArtifactQuerySpec{
+				"model": {},
+			},
+		},
+		DAGExecutionID: root.GetID(),
+		IterationIndex: -1,
+	}
+	// The resolver failed in the previous attempt of the run.
+	failed, err := mlmd.CreateExecution(ctx, pipeline, &metadata.ExecutionConfig{
+		TaskName:      "resolver",
+		ExecutionType: metadata.ResolverExecutionTypeName,
+		ParentDagID:   root.GetID(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mlmd.PublishExecution(ctx, failed, nil, nil, pb.Execution_FAILED); err != nil {
+		t.Fatal(err)
+	}
+	dag, err := mlmd.GetDAG(ctx, root.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The execution of the failed attempt is re-driven.
+	execution, err := Resolver(ctx, opts, mlmd)
+	if err != nil {
+		t.Fatalf("Resolver() error = %v", err)
+	}
+	if execution.ID != failed.GetID() {
+		t.Fatalf("Resolver() execution ID = %v, want the previous attempt %v", execution.ID, failed.GetID())
+	}
+	latest, err := mlmd.GetTaskExecutionInDAG(ctx, dag, pipeline, "resolver", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latest.GetID() != failed.GetID() || latest.GetExecution().GetLastKnownState() != pb.Execution_COMPLETE {
+		t.Fatalf("latest resolver execution = %v, want execution %v completed", latest.GetExecution(), failed.GetID())
+	}
+	outputs, err := mlmd.GetOutputArtifactListsByExecutionID(ctx, failed.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(outputs["model"]) != 1 {
+		t.Fatalf("resolver output artifacts = %v, want 1", len(outputs["model"]))
+	}
+
+	// The execution of a succeeded attempt is kept as is.
+	execution, err = Resolver(ctx, opts, mlmd)
+	if err != nil {
+		t.Fatalf("Resolver() error = %v", err)
+	}
+	if execution.ID != failed.GetID() {
+		t.Fatalf("Resolver() execution ID = %v, want the previous attempt %v", execution.ID, failed.GetID())
+	}
+	latest, err = mlmd.GetTaskExecutionInDAG(ctx, dag, pipeline, "resolver", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latest.GetID() != failed.GetID() {
+		t.Fatalf("latest resolver execution ID = %v, want %v", latest.GetID(), failed.GetID())
+	}
+	outputs, err = mlmd.GetOutputArtifactListsByExecutionID(ctx, failed.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(outputs["model"]) != 1 {
+		t.Fatalf("resolver output artifacts = %v, want 1 after the succeeded attempt is kept", len(outputs["model"]))
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -44,6 +45,7 @@ const (
 	pipelineContextTypeName    = "system.Pipeline"
 	pipelineRunContextTypeName = "system.PipelineRun"
 	ImporterExecutionTypeName  = "system.ImporterExecution"
+	ResolverExecutionTypeName  = "system.ResolverExecution"
 	mlmdClientSideMaxRetries   = 3
 )
 
@@ -118,6 +120,7 @@ type ExecutionConfig struct {
 	// ContainerExecution custom properties
 	Image, CachedMLMDExecutionID, FingerPrint string
 	PodName, PodUID, Namespace                string
+	RetryAttempt                              int // Retry attempt of the task, starting from 0.
 
 	// DAGExecution custom properties
 	IterationCount *int // Number of iterations for an iterator DAG.
@@ -181,6 +184,14 @@ func (p *Pipeline) GetCtxID() int64 {
 	return p.pipelineCtx.GetId()
 }
 
+// GetRunID returns the ID of the pipeline run.
+func (p *Pipeline) GetRunID() string {
+	if p == nil {
+		return ""
+	}
+	return p.pipelineRunCtx.GetName()
+}
+
 func (p *Pipeline) GetPipelineRoot() string {
 	if p == nil {
 		return ""
@@ -346,12 +357,18 @@ func doubleValue(f float64) *pb.Value {
 // "train" task, because artifact name is related to the context it's used.
 // Therefore, we should store artifact name as a property of the artifact's events
 // (connects artifact and execution) instead of the artifact's property.
-func eventPath(artifactName string) *pb.Event_Path {
+// eventPath returns the event path of the index-th artifact in an artifact
+// list with the specified name.
+func eventPath(artifactName string, index int) *pb.Event_Path {
 	return &pb.Event_Path{
 		Steps: []*pb.Event_Path_Step{{
 			Value: &pb.Event_Path_Step_Key{
 				Key: artifactName,
 			},
+		}, {
+			Value: &pb.Event_Path_Step_Index{
+				Index: int64(index),
+			},
 		}},
 	}
 }
@@ -363,6 +380,15 @@ func getArtifactName(eventPath *pb.Event_Path) (string, error) {
 	return eventPath.Steps[0].GetKey(), nil
 }
 
+// getArtifactIndex returns the index of an artifact in its artifact list.
+// Events recorded without an index step belong to single artifacts.
+func getArtifactIndex(eventPath *pb.Event_Path) int64 {
+	if len(eventPath.GetSteps()) < 2 {
+		return 0
+	}
+	return eventPath.GetSteps()[1].GetIndex()
+}
+
 // PublishExecution publishes the specified execution with the given output
 // parameters, artifacts and state.
 func (c *Client) PublishExecution(ctx context.Context, execution *Execution, outputParameters map[string]*structpb.Value, outputArtifacts []*OutputArtifact, state pb.Execution_State) error {
@@ -391,7 +417,11 @@ func (c *Client) PublishExecution(ctx context.Context, execution *Execution, out
 		Contexts:  contexts,
 	}
 
+	// index of each output artifact in its artifact list
+	indexes := make(map[string]int)
 	for _, oa := range outputArtifacts {
+		index := indexes[oa.Name]
+		indexes[oa.Name]++
 		aePair := &pb.PutExecutionRequest_ArtifactAndEvent{}
 		if oa.Artifact.GetId() == 0 {
 			glog.Infof("the id of output artifact is not set, will create new artifact when publishing execution")
@@ -399,14 +429,14 @@ func (c *Client) PublishExecution(ctx context.Context, execution *Execution, out
 				Artifact: oa.Artifact,
 				Event: &pb.Event{
 					Type: pb.Event_OUTPUT.Enum(),
-					Path: eventPath(oa.Name),
+					Path: eventPath(oa.Name, index),
 				},
 			}
 		} else {
 			aePair = &pb.PutExecutionRequest_ArtifactAndEvent{
 				Event: &pb.Event{
 					Type:       pb.Event_OUTPUT.Enum(),
-					Path:       eventPath(oa.Name),
+					Path:       eventPath(oa.Name, index),
 					ArtifactId: oa.Artifact.Id,
 				},
 			}
@@ -435,6 +465,8 @@ const (
 	keyParentDagID       = "parent_dag_id" // Parent DAG Execution ID.
 	keyIterationIndex    = "iteration_index"
 	keyIterationCount    = "iteration_count"
+	keyRetryAttempt      = "retry_attempt"
+	keyErrorMessage      = "error_message"
 )
 
 // CreateExecution creates a new MLMD execution under the specified Pipeline.
@@ -449,6 +481,83 @@ func (c *Client) CreateExecution(ctx context.Context, pipeline *Pipeline, config
 		return nil, err
 	}
 
+	req := &pb.PutExecutionRequest{
+		Execution: executionFromConfig(typeID, config),
+		Contexts:  []*pb.Context{pipeline.pipelineCtx, pipeline.pipelineRunCtx},
+	}
+
+	for name, ids := range config.InputArtifactIDs {
+		for i, id := range ids {
+			thisId := id // thisId will be referenced below, so we need a local immutable var
+			aePair := &pb.PutExecutionRequest_ArtifactAndEvent{
+				Event: &pb.Event{
+					ArtifactId: &thisId,
+					Path:       eventPath(name, i),
+					Type:       pb.Event_INPUT.Enum(),
+				},
+			}
+			req.ArtifactEventPairs = append(req.ArtifactEventPairs, aePair)
+		}
+	}
+
+	res, err := c.svc.PutExecution(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.getExecution(ctx, pipeline, res.GetExecutionId())
+}
+
+// ResetExecution resets the execution of a previous attempt of a task, when
+// the run is retried. The execution is updated with the config of the new
+// attempt, so that the task is re-driven without duplicating its execution.
+// Input artifacts of a task don't change between attempts, so input events are
+// kept as is.
+func (c *Client) ResetExecution(ctx context.Context, pipeline *Pipeline, execution *Execution, config *ExecutionConfig) (*Execution, error) {
+	if config == nil {
+		return nil, fmt.Errorf("metadata.ResetExecution got config == nil")
+	}
+	previous := execution.GetExecution()
+	e := executionFromConfig(previous.GetTypeId(), config)
+	e.Id = previous.Id
+	if e.Name == nil {
+		e.Name = previous.Name
+	}
+	if _, ok := previous.GetCustomProperties()[keyErrorMessage]; ok {
+		// Clear the error of the failed attempt.
+		e.CustomProperties[keyErrorMessage] = stringValue("")
+	}
+	_, err := c.svc.PutExecution(ctx, &pb.PutExecutionRequest{
+		Execution: e,
+		Contexts:  []*pb.Context{pipeline.pipelineCtx, pipeline.pipelineRunCtx},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to reset execution %v: %w", previous.GetId(), err)
+	}
+	return c.getExecution(ctx, pipeline, previous.GetId())
+}
+
+func (c *Client) getExecution(ctx context.Context, pipeline *Pipeline, id int64) (*Execution, error) {
+	getReq := &pb.GetExecutionsByIDRequest{
+		ExecutionIds: []int64{id},
+	}
+
+	getRes, err := c.svc.GetExecutionsByID(ctx, getReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(getRes.Executions) != 1 {
+		return nil, fmt.Errorf("Expected to get one Execution, got %d instead. Request: %v", len(getRes.Executions), getReq)
+	}
+
+	return &Execution{
+		pipeline:  pipeline,
+		execution: getRes.Executions[0],
+	}, nil
+}
+
+// executionFromConfig makes a new MLMD execution from config.
+func executionFromConfig(typeID int64, config *ExecutionConfig) *pb.Execution {
 	e := &pb.Execution{
 		TypeId: &typeID,
 		CustomProperties: map[string]*pb.Value{
@@ -495,48 +604,7 @@ func (c *Client) CreateExecution(ctx context.Context, pipeline *Pipeline, config
 			},
 		}}
 	}
-
-	req := &pb.PutExecutionRequest{
-		Execution: e,
-		Contexts:  []*pb.Context{pipeline.pipelineCtx, pipeline.pipelineRunCtx},
-	}
-
-	for name, ids := range config.InputArtifactIDs {
-		for _, id := range ids {
-			thisId := id // thisId will be referenced below, so we need a local immutable var
-			aePair := &pb.PutExecutionRequest_ArtifactAndEvent{
-				Event: &pb.Event{
-					ArtifactId: &thisId,
-					Path:       eventPath(name),
-					Type:       pb.Event_INPUT.Enum(),
-				},
-			}
-			req.ArtifactEventPairs = append(req.ArtifactEventPairs, aePair)
-		}
-	}
-
-	res, err := c.svc.PutExecution(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-
-	getReq := &pb.GetExecutionsByIDRequest{
-		ExecutionIds: []int64{res.GetExecutionId()},
-	}
-
-	getRes, err := c.svc.GetExecutionsByID(ctx, getReq)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(getRes.Executions) != 1 {
-		return nil, fmt.Errorf("Expected to get one Execution, got %d instead. Request: %v", len(getRes.Executions), getReq)
-	}
-
-	return &Execution{
-		pipeline:  pipeline,
-		execution: getRes.Executions[0],
-	}, nil
+	return e
 }
 
 // PrePublishExecution updates an existing MLMD execution with Pod info.
@@ -548,6 +616,11 @@ func (c *Client) PrePublishExecution(ctx context.Context, execution *Execution,
 	e.CustomProperties[keyPodName] = stringValue(config.PodName)
 	e.CustomProperties[keyPodUID] = stringValue(config.PodUID)
 	e.CustomProperties[keyNamespace] = stringValue(config.Namespace)
+	e.CustomProperties[keyRetryAttempt] = intValue(int64(config.RetryAttempt))
+	if _, ok := e.CustomProperties[keyErrorMessage]; ok {
+		// Clear the error of a failed attempt, when the task is retried.
+		e.CustomProperties[keyErrorMessage] = stringValue("")
+	}
 	e.LastKnownState = pb.Execution_RUNNING.Enum()
 
 	_, err := c.svc.PutExecution(ctx, &pb.PutExecutionRequest{
@@ -623,13 +696,70 @@ func (c *Client) GetPipelineFromExecution(ctx context.Context, id int64) (*Pipel
 
 // GetExecutionsInDAG gets all executions in the DAG, and organize them
 // into a map, keyed by task name.
+// When a run is retried, a task may have executions of multiple attempts, the
+// latest attempt is returned.
 func (c *Client) GetExecutionsInDAG(ctx context.Context, dag *DAG, pipeline *Pipeline) (executionsMap map[string]*Execution, err error) {
 	defer func() {
 		if err != nil {
 			err = fmt.Errorf("failed to get executions in %s: %w", dag.Info(), err)
 		}
 	}()
+	executions, err := c.getExecutionsInDAG(ctx, dag, pipeline)
+	if err != nil {
+		return nil, err
+	}
 	executionsMap = make(map[string]*Execution)
+	for _, execution := range executions {
+		taskName := execution.TaskName()
+		if existing, ok := executionsMap[taskName]; ok && !isLaterAttempt(execution, existing) {
+			continue
+		}
+		executionsMap[taskName] = execution
+	}
+	return executionsMap, nil
+}
+
+// GetTaskExecutionInDAG returns the execution of the latest attempt of a task
+// in the DAG, or nil when the task has no execution yet.
+// iterationIndex is nil when the task is not an iteration.
+func (c *Client) GetTaskExecutionInDAG(ctx context.Context, dag *DAG, pipeline *Pipeline, taskName string, iterationIndex *int) (execution *Execution, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("failed to get execution of task %q in %s: %w", taskName, dag.Info(), err)
+		}
+	}()
+	executions, err := c.getExecutionsInDAG(ctx, dag, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range executions {
+		if e.TaskName() != taskName || !sameIterationIndex(e, iterationIndex) {
+			continue
+		}
+		if execution == nil || isLaterAttempt(e, execution) {
+			execution = e
+		}
+	}
+	return execution, nil
+}
+
+// isLaterAttempt returns whether execution a is from a later attempt of the
+// task than execution b. MLMD execution IDs are increasing.
+func isLaterAttempt(a, b *Execution) bool {
+	return a.GetID() > b.GetID()
+}
+
+func sameIterationIndex(execution *Execution, iterationIndex *int) bool {
+	value, ok := execution.GetExecution().GetCustomProperties()[keyIterationIndex]
+	if iterationIndex == nil {
+		return !ok
+	}
+	return ok && value.GetIntValue() == int64(*iterationIndex)
+}
+
+// getExecutionsInDAG lists executions of all tasks in the DAG, including
+// executions of every attempt of retried tasks.
+func (c *Client) getExecutionsInDAG(ctx context.Context, dag *DAG, pipeline *Pipeline) ([]*Execution, error) {
 	// Documentation on query syntax:
 	// https://github.com/google/ml-metadata/blob/839c3501a195d340d2855b6ffdb2c4b0b49862c9/ml_metadata/proto/metadata_store.proto#L831
 	parentDAGFilter := fmt.Sprintf("custom_properties.parent_dag_id.int_value = %v", dag.Execution.GetID())
@@ -646,20 +776,15 @@ func (c *Client) GetExecutionsInDAG(ctx context.Context, dag *DAG, pipeline *Pip
 		return nil, err
 	}
 	execs := res.GetExecutions()
+	executions := make([]*Execution, 0, len(execs))
 	for _, e := range execs {
 		execution := &Execution{execution: e}
-		taskName := execution.TaskName()
-		if taskName == "" {
+		if execution.TaskName() == "" {
 			return nil, fmt.Errorf("empty task name for execution ID: %v", execution.GetID())
 		}
-		existing, ok := executionsMap[taskName]
-		if ok {
-			// TODO(Bobgy): to support retry, we need to handle multiple tasks with the same task name.
-			return nil, fmt.Errorf("two tasks have the same task name %q, id1=%v id2=%v", taskName, existing.GetID(), execution.GetID())
-		}
-		executionsMap[taskName] = execution
+		executions = append(executions, execution)
 	}
-	return executionsMap, nil
+	return executions, nil
 }
 
 // GetEventsByArtifactIDs ...
@@ -695,87 +820,120 @@ func (c *Client) GetArtifacts(ctx context.Context, ids []int64) ([]*pb.Artifact,
 	return res.Artifacts, nil
 }
 
-// GetOutputArtifactsByExecutionId ...
-// TODO: Support multiple artifacts someday, probably through the v2 engine.
+// GetOutputArtifactsByExecutionId returns the first output artifact of each
+// output artifact list of an execution, keyed by output name.
 func (c *Client) GetOutputArtifactsByExecutionId(ctx context.Context, executionId int64) (map[string]*OutputArtifact, error) {
-	getEventsByExecutionIDsReq := &pb.GetEventsByExecutionIDsRequest{ExecutionIds: []int64{executionId}}
-	getEventsByExecutionIDsRes, err := c.svc.GetEventsByExecutionIDs(ctx, getEventsByExecutionIDsReq)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get events with execution id %v: %w", executionId, err)
-	}
-	var outputArtifactsIDs []int64
-	outputArtifactNamesById := make(map[int64]string)
-	for _, event := range getEventsByExecutionIDsRes.Events {
-		if *event.Type == pb.Event_OUTPUT {
-			outputArtifactsIDs = append(outputArtifactsIDs, event.GetArtifactId())
-			artifactName, err := getArtifactName(event.Path)
-			if err != nil {
-				return nil, err
-			}
-			outputArtifactNamesById[event.GetArtifactId()] = artifactName
-		}
-	}
-	outputArtifacts, err := c.GetArtifacts(ctx, outputArtifactsIDs)
+	lists, err := c.GetOutputArtifactListsByExecutionID(ctx, executionId)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get output artifacts: %w", err)
+		return nil, err
 	}
 	outputArtifactsByName := make(map[string]*OutputArtifact)
-	for _, outputArtifact := range outputArtifacts {
-		name, ok := outputArtifactNamesById[outputArtifact.GetId()]
-		if !ok {
-			return nil, fmt.Errorf("failed to get name of artifact with id %v", outputArtifact.GetId())
-		}
-		outputArtifactsByName[name] = &OutputArtifact{
-			Name:     name,
-			Artifact: outputArtifact,
-			Schema:   "", // TODO(Bobgy): figure out how to get schema
-		}
+	for name, list := range lists {
+		outputArtifactsByName[name] = list[0]
 	}
 	return outputArtifactsByName, nil
 }
 
+// GetOutputArtifactListsByExecutionID returns output artifacts of an execution,
+// keyed by output name. Artifacts of each output are ordered by their index in
+// the output artifact list.
+func (c *Client) GetOutputArtifactListsByExecutionID(ctx context.Context, executionID int64) (map[string][]*OutputArtifact, error) {
+	artifacts, err := c.getArtifactListsByExecutionID(ctx, executionID, pb.Event_OUTPUT)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get output artifacts of execution id %v: %w", executionID, err)
+	}
+	outputArtifacts := make(map[string][]*OutputArtifact)
+	for name, list := range artifacts {
+		for _, artifact := range list {
+			outputArtifacts[name] = append(outputArtifacts[name], &OutputArtifact{
+				Name:     name,
+				Artifact: artifact,
+				Schema:   "", // TODO(Bobgy): figure out how to get schema
+			})
+		}
+	}
+	return outputArtifacts, nil
+}
+
 func (c *Client) GetInputArtifactsByExecutionID(ctx context.Context, executionID int64) (inputs map[string]*pipelinespec.ArtifactList, err error) {
 	defer func() {
 		if err != nil {
 			err = fmt.Errorf("GetInputArtifactsByExecution(id=%v) failed: %w", executionID, err)
 		}
 	}()
-	eventsReq := &pb.GetEventsByExecutionIDsRequest{ExecutionIds: []int64{executionID}}
-	eventsRes, err := c.svc.GetEventsByExecutionIDs(ctx, eventsReq)
+	artifacts, err := c.getArtifactListsByExecutionID(ctx, executionID, pb.Event_INPUT)
 	if err != nil {
 		return nil, err
 	}
-	var artifactIDs []int64
-	nameByID := make(map[int64]string)
-	for _, event := range eventsRes.Events {
-		if *event.Type == pb.Event_INPUT {
-			artifactIDs = append(artifactIDs, event.GetArtifactId())
-			name, err := getArtifactName(event.Path)
+	inputs = make(map[string]*pipelinespec.ArtifactList)
+	for name, list := range artifacts {
+		artifactList := &pipelinespec.ArtifactList{}
+		for _, artifact := range list {
+			runtimeArtifact, err := toRuntimeArtifact(artifact)
 			if err != nil {
 				return nil, err
 			}
-			nameByID[event.GetArtifactId()] = name
+			artifactList.Artifacts = append(artifactList.Artifacts, runtimeArtifact)
 		}
+		inputs[name] = artifactList
+	}
+	return inputs, nil
+}
+
+// getArtifactListsByExecutionID returns artifacts linked to an execution by
+// events of the specified type, keyed by artifact name and ordered by their
+// index in the artifact list. The lists are built from the event paths, since
+// the same artifact may be linked under several names or indexes.
+func (c *Client) getArtifactListsByExecutionID(ctx context.Context, executionID int64, eventType pb.Event_Type) (map[string][]*pb.Artifact, error) {
+	eventsRes, err := c.svc.GetEventsByExecutionIDs(ctx, &pb.GetEventsByExecutionIDsRequest{ExecutionIds: []int64{executionID}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events with execution id %v: %w", executionID, err)
+	}
+	var events []*pb.Event
+	var artifactIDs []int64
+	seenArtifactIDs := make(map[int64]bool)
+	for _, event := range eventsRes.Events {
+		if event.GetType() != eventType {
+			continue
+		}
+		events = append(events, event)
+		if !seenArtifactIDs[event.GetArtifactId()] {
+			seenArtifactIDs[event.GetArtifactId()] = true
+			artifactIDs = append(artifactIDs, event.GetArtifactId())
+		}
+	}
+	if len(events) == 0 {
+		return map[string][]*pb.Artifact{}, nil
 	}
 	artifacts, err := c.GetArtifacts(ctx, artifactIDs)
 	if err != nil {
 		return nil, err
 	}
-	inputs = make(map[string]*pipelinespec.ArtifactList)
+	artifactByID := make(map[int64]*pb.Artifact)
 	for _, artifact := range artifacts {
-		name, ok := nameByID[artifact.GetId()]
+		artifactByID[artifact.GetId()] = artifact
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		indexI := getArtifactIndex(events[i].GetPath())
+		indexJ := getArtifactIndex(events[j].GetPath())
+		if indexI != indexJ {
+			return indexI < indexJ
+		}
+		return events[i].GetArtifactId() < events[j].GetArtifactId()
+	})
+	lists := make(map[string][]*pb.Artifact)
+	for _, event := range events {
+		artifact, ok := artifactByID[event.GetArtifactId()]
 		if !ok {
-			return nil, fmt.Errorf("failed to get name of artifact with id %v", artifact.GetId())
+			return nil, fmt.Errorf("failed to get artifact with id %v", event.GetArtifactId())
 		}
-		runtimeArtifact, err := toRuntimeArtifact(artifact)
+		name, err := getArtifactName(event.GetPath())
 		if err != nil {
 			return nil, err
 		}
-		inputs[name] = &pipelinespec.ArtifactList{
-			Artifacts: []*pipelinespec.RuntimeArtifact{runtimeArtifact},
-		}
+		lists[name] = append(lists[name], artifact)
 	}
-	return inputs, nil
+	return lists, nil
 }
 
 // Only supports schema titles for now.
@@ -0,0 +1,409 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewFakeClient returns a Client backed by an in-memory MLMD store, for tests
+// that don't have a MLMD server.
+func NewFakeClient() *Client {
+	return &Client{svc: newFakeMetadataStore()}
+}
+
+// fakeMetadataStore implements the parts of the MLMD service used by Client.
+// Calling any other method panics.
+type fakeMetadataStore struct {
+	pb.MetadataStoreServiceClient
+
+	mu             sync.Mutex
+	lastID         int64
+	artifactTypes  map[string]int64
+	executionTypes map[string]int64
+	contextTypes   map[string]int64
+	contexts       []*pb.Context
+	parentContexts map[int64][]int64
+	executions     []*pb.Execution
+	artifacts      []*pb.Artifact
+	events         []*pb.Event
+	// context IDs by execution ID and by artifact ID
+	associations map[int64][]int64
+	attributions map[int64][]int64
+}
+
+func newFakeMetadataStore() *fakeMetadataStore {
+	return &fakeMetadataStore{
+		artifactTypes:  make(map[string]int64),
+		executionTypes: make(map[string]int64),
+		contextTypes:   make(map[string]int64),
+		parentContexts: make(map[int64][]int64),
+		associations:   make(map[int64][]int64),
+		attributions:   make(map[int64][]int64),
+	}
+}
+
+func (s *fakeMetadataStore) nextID() int64 {
+	s.lastID++
+	return s.lastID
+}
+
+func (s *fakeMetadataStore) putType(types map[string]int64, name string) int64 {
+	if id, ok := types[name]; ok {
+		return id
+	}
+	id := s.nextID()
+	types[name] = id
+	return id
+}
+
+func (s *fakeMetadataStore) PutArtifactType(ctx context.Context, in *pb.PutArtifactTypeRequest, opts ...grpc.CallOption) (*pb.PutArtifactTypeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &pb.PutArtifactTypeResponse{TypeId: proto.Int64(s.putType(s.artifactTypes, in.GetArtifactType().GetName()))}, nil
+}
+
+func (s *fakeMetadataStore) GetArtifactType(ctx context.Context, in *pb.GetArtifactTypeRequest, opts ...grpc.CallOption) (*pb.GetArtifactTypeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id, ok := s.artifactTypes[in.GetTypeName()]
+	if !ok {
+		return &pb.GetArtifactTypeResponse{}, nil
+	}
+	return &pb.GetArtifactTypeResponse{ArtifactType: &pb.ArtifactType{Id: proto.Int64(id), Name: proto.String(in.GetTypeName())}}, nil
+}
+
+func (s *fakeMetadataStore) PutExecutionType(ctx context.Context, in *pb.PutExecutionTypeRequest, opts ...grpc.CallOption) (*pb.PutExecutionTypeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &pb.PutExecutionTypeResponse{TypeId: proto.Int64(s.putType(s.executionTypes, in.GetExecutionType().GetName()))}, nil
+}
+
+func (s *fakeMetadataStore) PutContextType(ctx context.Context, in *pb.PutContextTypeRequest, opts ...grpc.CallOption) (*pb.PutContextTypeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &pb.PutContextTypeResponse{TypeId: proto.Int64(s.putType(s.contextTypes, in.GetContextType().GetName()))}, nil
+}
+
+func (s *fakeMetadataStore) GetContextType(ctx context.Context, in *pb.GetContextTypeRequest, opts ...grpc.CallOption) (*pb.GetContextTypeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id, ok := s.contextTypes[in.GetTypeName()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "context type %q not found", in.GetTypeName())
+	}
+	return &pb.GetContextTypeResponse{ContextType: &pb.ContextType{Id: proto.Int64(id), Name: proto.String(in.GetTypeName())}}, nil
+}
+
+func (s *fakeMetadataStore) PutContexts(ctx context.Context, in *pb.PutContextsRequest, opts ...grpc.CallOption) (*pb.PutContextsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &pb.PutContextsResponse{}
+	for _, context := range in.GetContexts() {
+		for _, existing := range s.contexts {
+			if existing.GetTypeId() == context.GetTypeId() && existing.GetName() == context.GetName() {
+				return nil, status.Errorf(codes.AlreadyExists, "context %q already exists", context.GetName())
+			}
+		}
+		context = proto.Clone(context).(*pb.Context)
+		context.Id = proto.Int64(s.nextID())
+		s.contexts = append(s.contexts, context)
+		res.ContextIds = append(res.ContextIds, context.GetId())
+	}
+	return res, nil
+}
+
+func (s *fakeMetadataStore) GetContextByTypeAndName(ctx context.Context, in *pb.GetContextByTypeAndNameRequest, opts ...grpc.CallOption) (*pb.GetContextByTypeAndNameResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	typeID, ok := s.contextTypes[in.GetTypeName()]
+	if !ok {
+		return &pb.GetContextByTypeAndNameResponse{}, nil
+	}
+	for _, context := range s.contexts {
+		if context.GetTypeId() == typeID && context.GetName() == in.GetContextName() {
+			return &pb.GetContextByTypeAndNameResponse{Context: proto.Clone(context).(*pb.Context)}, nil
+		}
+	}
+	return &pb.GetContextByTypeAndNameResponse{}, nil
+}
+
+func (s *fakeMetadataStore) GetContextsByID(ctx context.Context, in *pb.GetContextsByIDRequest, opts ...grpc.CallOption) (*pb.GetContextsByIDResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &pb.GetContextsByIDResponse{Contexts: s.getContexts(in.GetContextIds())}, nil
+}
+
+func (s *fakeMetadataStore) getContexts(ids []int64) []*pb.Context {
+	var contexts []*pb.Context
+	for _, id := range ids {
+		for _, context := range s.contexts {
+			if context.GetId() == id {
+				contexts = append(contexts, proto.Clone(context).(*pb.Context))
+			}
+		}
+	}
+	return contexts
+}
+
+func (s *fakeMetadataStore) PutParentContexts(ctx context.Context, in *pb.PutParentContextsRequest, opts ...grpc.CallOption) (*pb.PutParentContextsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, parent := range in.GetParentContexts() {
+		for _, id := range s.parentContexts[parent.GetChildId()] {
+			if id == parent.GetParentId() {
+				return nil, status.Errorf(codes.AlreadyExists, "parent context %v of context %v already exists",
+					parent.GetParentId(), parent.GetChildId())
+			}
+		}
+		s.parentContexts[parent.GetChildId()] = append(s.parentContexts[parent.GetChildId()], parent.GetParentId())
+	}
+	return &pb.PutParentContextsResponse{}, nil
+}
+
+// PutExecution creates or updates the execution, with its events and context
+// associations. Updated executions replace the stored ones.
+func (s *fakeMetadataStore) PutExecution(ctx context.Context, in *pb.PutExecutionRequest, opts ...grpc.CallOption) (*pb.PutExecutionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	execution := proto.Clone(in.GetExecution()).(*pb.Execution)
+	if execution.Id == nil {
+		execution.Id = proto.Int64(s.nextID())
+		s.executions = append(s.executions, execution)
+	} else {
+		i := s.findExecution(execution.GetId())
+		if i < 0 {
+			return nil, status.Errorf(codes.NotFound, "execution %v not found", execution.GetId())
+		}
+		s.executions[i] = execution
+	}
+	res := &pb.PutExecutionResponse{ExecutionId: execution.Id}
+	var artifactIDs []int64
+	for _, pair := range in.GetArtifactEventPairs() {
+		event := proto.Clone(pair.GetEvent()).(*pb.Event)
+		if pair.GetArtifact() != nil {
+			event.ArtifactId = proto.Int64(s.putArtifact(pair.GetArtifact()))
+		}
+		event.ExecutionId = execution.Id
+		s.events = append(s.events, event)
+		res.ArtifactIds = append(res.ArtifactIds, event.GetArtifactId())
+		artifactIDs = append(artifactIDs, event.GetArtifactId())
+	}
+	for _, context := range in.GetContexts() {
+		s.associations[execution.GetId()] = appendID(s.associations[execution.GetId()], context.GetId())
+		for _, id := range artifactIDs {
+			s.attributions[id] = appendID(s.attributions[id], context.GetId())
+		}
+		res.ContextIds = append(res.ContextIds, context.GetId())
+	}
+	return res, nil
+}
+
+func appendID(ids []int64, id int64) []int64 {
+	for _, existing := range ids {
+		if existing == id {
+			return ids
+		}
+	}
+	return append(ids, id)
+}
+
+func (s *fakeMetadataStore) findExecution(id int64) int {
+	for i, execution := range s.executions {
+		if execution.GetId() == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *fakeMetadataStore) GetExecutionsByID(ctx context.Context, in *pb.GetExecutionsByIDRequest, opts ...grpc.CallOption) (*pb.GetExecutionsByIDResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &pb.GetExecutionsByIDResponse{}
+	for _, id := range in.GetExecutionIds() {
+		if i := s.findExecution(id); i >= 0 {
+			res.Executions = append(res.Executions, proto.Clone(s.executions[i]).(*pb.Execution))
+		}
+	}
+	return res, nil
+}
+
+// GetExecutionsByContext only supports filtering executions by an integer
+// custom property, e.g. "custom_properties.parent_dag_id.int_value = 1".
+func (s *fakeMetadataStore) GetExecutionsByContext(ctx context.Context, in *pb.GetExecutionsByContextRequest, opts ...grpc.CallOption) (*pb.GetExecutionsByContextResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var filterKey string
+	var filterValue int64
+	if filter := in.GetOptions().GetFilterQuery(); filter != "" {
+		if _, err := fmt.Sscanf(filter, "custom_properties.%s = %d", &filterKey, &filterValue); err != nil {
+			return nil, status.Errorf(codes.Unimplemented, "unsupported filter query %q", filter)
+		}
+		const suffix = ".int_value"
+		if len(filterKey) <= len(suffix) || filterKey[len(filterKey)-len(suffix):] != suffix {
+			return nil, status.Errorf(codes.Unimplemented, "unsupported filter query %q", filter)
+		}
+		filterKey = filterKey[:len(filterKey)-len(suffix)]
+	}
+	res := &pb.GetExecutionsByContextResponse{}
+	for _, execution := range s.executions {
+		associated := false
+		for _, id := range s.associations[execution.GetId()] {
+			associated = associated || id == in.GetContextId()
+		}
+		if !associated {
+			continue
+		}
+		if filterKey != "" {
+			value, ok := execution.GetCustomProperties()[filterKey]
+			if !ok || value.GetIntValue() != filterValue {
+				continue
+			}
+		}
+		res.Executions = append(res.Executions, proto.Clone(execution).(*pb.Execution))
+	}
+	return res, nil
+}
+
+func (s *fakeMetadataStore) GetContextsByExecution(ctx context.Context, in *pb.GetContextsByExecutionRequest, opts ...grpc.CallOption) (*pb.GetContextsByExecutionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &pb.GetContextsByExecutionResponse{Contexts: s.getContexts(s.associations[in.GetExecutionId()])}, nil
+}
+
+func (s *fakeMetadataStore) putArtifact(artifact *pb.Artifact) int64 {
+	artifact = proto.Clone(artifact).(*pb.Artifact)
+	if artifact.Id != nil {
+		for i, existing := range s.artifacts {
+			if existing.GetId() == artifact.GetId() {
+				s.artifacts[i] = artifact
+				return artifact.GetId()
+			}
+		}
+	}
+	artifact.Id = proto.Int64(s.nextID())
+	s.artifacts = append(s.artifacts, artifact)
+	return artifact.GetId()
+}
+
+func (s *fakeMetadataStore) PutArtifacts(ctx context.Context, in *pb.PutArtifactsRequest, opts ...grpc.CallOption) (*pb.PutArtifactsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &pb.PutArtifactsResponse{}
+	for _, artifact := range in.GetArtifacts() {
+		res.ArtifactIds = append(res.ArtifactIds, s.putArtifact(artifact))
+	}
+	return res, nil
+}
+
+func (s *fakeMetadataStore) GetArtifactsByID(ctx context.Context, in *pb.GetArtifactsByIDRequest, opts ...grpc.CallOption) (*pb.GetArtifactsByIDResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &pb.GetArtifactsByIDResponse{}
+	for _, id := range in.GetArtifactIds() {
+		for _, artifact := range s.artifacts {
+			if artifact.GetId() == id {
+				res.Artifacts = append(res.Artifacts, proto.Clone(artifact).(*pb.Artifact))
+			}
+		}
+	}
+	return res, nil
+}
+
+// GetArtifacts only supports filtering by the pipeline context, which is the
+// filter of an artifact query without predicates.
+func (s *fakeMetadataStore) GetArtifacts(ctx context.Context, in *pb.GetArtifactsRequest, opts ...grpc.CallOption) (*pb.GetArtifactsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var contextID int64
+	filter := in.GetOptions().GetFilterQuery()
+	if _, err := fmt.Sscanf(filter, "contexts_pipeline.id = %d", &contextID); err != nil {
+		return nil, status.Errorf(codes.Unimplemented, "unsupported filter query %q", filter)
+	}
+	res := &pb.GetArtifactsResponse{}
+	// Artifact IDs are increasing, so the most recently created come last.
+	for i := len(s.artifacts) - 1; i >= 0; i-- {
+		artifact := s.artifacts[i]
+		attributed := false
+		for _, id := range s.attributions[artifact.GetId()] {
+			attributed = attributed || id == contextID
+		}
+		if !attributed {
+			continue
+		}
+		if limit := in.GetOptions().GetMaxResultSize(); limit > 0 && len(res.Artifacts) >= int(limit) {
+			break
+		}
+		res.Artifacts = append(res.Artifacts, proto.Clone(artifact).(*pb.Artifact))
+	}
+	return res, nil
+}
+
+func (s *fakeMetadataStore) GetArtifactsByURI(ctx context.Context, in *pb.GetArtifactsByURIRequest, opts ...grpc.CallOption) (*pb.GetArtifactsByURIResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &pb.GetArtifactsByURIResponse{}
+	for _, uri := range in.GetUris() {
+		for _, artifact := range s.artifacts {
+			if artifact.GetUri() == uri {
+				res.Artifacts = append(res.Artifacts, proto.Clone(artifact).(*pb.Artifact))
+			}
+		}
+	}
+	return res, nil
+}
+
+func (s *fakeMetadataStore) GetContextsByArtifact(ctx context.Context, in *pb.GetContextsByArtifactRequest, opts ...grpc.CallOption) (*pb.GetContextsByArtifactResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &pb.GetContextsByArtifactResponse{Contexts: s.getContexts(s.attributions[in.GetArtifactId()])}, nil
+}
+
+func (s *fakeMetadataStore) GetEventsByExecutionIDs(ctx context.Context, in *pb.GetEventsByExecutionIDsRequest, opts ...grpc.CallOption) (*pb.GetEventsByExecutionIDsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &pb.GetEventsByExecutionIDsResponse{}
+	for _, event := range s.events {
+		for _, id := range in.GetExecutionIds() {
+			if event.GetExecutionId() == id {
+				res.Events = append(res.Events, proto.Clone(event).(*pb.Event))
+			}
+		}
+	}
+	return res, nil
+}
+
+func (s *fakeMetadataStore) GetEventsByArtifactIDs(ctx context.Context, in *pb.GetEventsByArtifactIDsRequest, opts ...grpc.CallOption) (*pb.GetEventsByArtifactIDsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &pb.GetEventsByArtifactIDsResponse{}
+	for _, event := range s.events {
+		for _, id := range in.GetArtifactIds() {
+			if event.GetArtifactId() == id {
+				res.Events = append(res.Events, proto.Clone(event).(*pb.Event))
+			}
+		}
+	}
+	return res, nil
+}
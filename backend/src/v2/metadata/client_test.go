@@ -24,6 +24,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
 	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
 	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
 	"google.golang.org/grpc"
@@ -252,6 +253,88 @@ func Test_DAG(t *testing.T) {
 	}
 }
 
+func Test_GetArtifactListsByExecutionID_DuplicateArtifacts(t *testing.T) {
+	client := metadata.NewFakeClient()
+	ctx := context.Background()
+	pipeline, err := client.GetPipeline(ctx, "pipeline-name", "run-id", "ns1", "workflow/pipeline-1234", pipelineRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recordArtifact := func(uri string) *metadata.OutputArtifact {
+		artifact, err := client.RecordArtifact(ctx, "models", "title: system.Model\ntype: object\n",
+			&pipelinespec.RuntimeArtifact{Uri: uri}, pb.Artifact_LIVE)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return artifact
+	}
+	a := recordArtifact("gs://bucket/a")
+	b := recordArtifact("gs://bucket/b")
+	// The same artifact is linked at several indexes and under several names.
+	outputs := []*metadata.OutputArtifact{
+		{Name: "models", Artifact: a.Artifact},
+		{Name: "models", Artifact: b.Artifact},
+		{Name: "models", Artifact: a.Artifact},
+		{Name: "best", Artifact: a.Artifact},
+	}
+	producer, err := client.CreateExecution(ctx, pipeline, &metadata.ExecutionConfig{
+		TaskName:      "producer",
+		ExecutionType: metadata.ContainerExecutionTypeName,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.PublishExecution(ctx, producer, nil, outputs, pb.Execution_COMPLETE); err != nil {
+		t.Fatal(err)
+	}
+	consumer, err := client.CreateExecution(ctx, pipeline, &metadata.ExecutionConfig{
+		TaskName:      "consumer",
+		ExecutionType: metadata.ContainerExecutionTypeName,
+		InputArtifactIDs: map[string][]int64{
+			"models": {a.Artifact.GetId(), b.Artifact.GetId(), a.Artifact.GetId()},
+			"best":   {a.Artifact.GetId()},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outputLists, err := client.GetOutputArtifactListsByExecutionID(ctx, producer.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotOutputURIs := make(map[string][]string)
+	for name, list := range outputLists {
+		for _, artifact := range list {
+			if artifact.Name != name {
+				t.Errorf("output artifact of list %q is named %q", name, artifact.Name)
+			}
+			gotOutputURIs[name] = append(gotOutputURIs[name], artifact.Artifact.GetUri())
+		}
+	}
+	wantURIs := map[string][]string{
+		"models": {"gs://bucket/a", "gs://bucket/b", "gs://bucket/a"},
+		"best":   {"gs://bucket/a"},
+	}
+	if diff := cmp.Diff(wantURIs, gotOutputURIs); diff != "" {
+		t.Errorf("GetOutputArtifactListsByExecutionID() URIs mismatch (-want +got):\n%s", diff)
+	}
+
+	inputs, err := client.GetInputArtifactsByExecutionID(ctx, consumer.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotInputURIs := make(map[string][]string)
+	for name, list := range inputs {
+		for _, artifact := range list.GetArtifacts() {
+			gotInputURIs[name] = append(gotInputURIs[name], artifact.GetUri())
+		}
+	}
+	if diff := cmp.Diff(wantURIs, gotInputURIs); diff != "" {
+		t.Errorf("GetInputArtifactsByExecutionID() URIs mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func newLocalClientOrFatal(t *testing.T) *metadata.Client {
 	t.Helper()
 	client, err := metadata.NewClient("localhost", "8080")
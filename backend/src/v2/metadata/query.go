@@ -0,0 +1,117 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// Default number of artifacts returned by an artifact query, when limit is not
+// specified in the query.
+const defaultArtifactQueryLimit = 1
+
+var (
+	inContextPredicate = regexp.MustCompile(`^in_context\(\s*("[^"]*"|'[^']*')\s*\)$`)
+	keyValuePredicate  = regexp.MustCompile(`^([A-Za-z_]+)\s*(=|!=)\s*(.+)$`)
+	// Keys in the KFP artifact query syntax, mapped to MLMD filter query attributes.
+	artifactQueryKeys = map[string]string{
+		"artifact_type": "type",
+		"uri":           "uri",
+		"state":         "state",
+		"name":          "name",
+	}
+)
+
+// QueryArtifacts runs an artifact query of a resolver against MLMD and returns
+// the matched artifacts, most recently created first.
+func (c *Client) QueryArtifacts(ctx context.Context, pipeline *Pipeline, query *pipelinespec.PipelineDeploymentConfig_ResolverSpec_ArtifactQuerySpec) (artifacts []*pb.Artifact, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("QueryArtifacts(filter=%q) failed: %w", query.GetFilter(), err)
+		}
+	}()
+	filter, err := artifactQueryToFilter(query.GetFilter(), pipeline.GetCtxID())
+	if err != nil {
+		return nil, err
+	}
+	limit := query.GetLimit()
+	if limit <= 0 {
+		limit = defaultArtifactQueryLimit
+	}
+	res, err := c.svc.GetArtifacts(ctx, &pb.GetArtifactsRequest{
+		Options: &pb.ListOperationOptions{
+			MaxResultSize: proto.Int32(limit),
+			FilterQuery:   proto.String(filter),
+			OrderByField: &pb.ListOperationOptions_OrderByField{
+				Field: pb.ListOperationOptions_OrderByField_CREATE_TIME.Enum(),
+				IsAsc: proto.Bool(false),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.GetArtifacts(), nil
+}
+
+// artifactQueryToFilter converts the filter of an ArtifactQuerySpec into an
+// MLMD filter query.
+//
+// Supported predicates are `in_context("<context name>")`,
+// `artifact_type="<artifact type name>"`, `uri="<uri>"`, `state=<state>` and
+// `name="value"`, combined with `AND`. Other predicates are passed through to
+// MLMD as is, so that MLMD filter syntax like
+// `custom_properties.<name>.string_value="value"` can be used too.
+// If no `in_context` predicate is set, the query is scoped to the pipeline
+// context with ID pipelineCtxID.
+func artifactQueryToFilter(query string, pipelineCtxID int64) (string, error) {
+	var predicates []string
+	hasContext := false
+	for i, predicate := range strings.Split(query, " AND ") {
+		predicate = strings.TrimSpace(predicate)
+		if predicate == "" {
+			if strings.TrimSpace(query) == "" {
+				break
+			}
+			return "", fmt.Errorf("invalid artifact query %q: empty predicate", query)
+		}
+		if match := inContextPredicate.FindStringSubmatch(predicate); match != nil {
+			hasContext = true
+			predicates = append(predicates, fmt.Sprintf("contexts_c%v.name = %s", i, match[1]))
+			continue
+		}
+		if strings.HasPrefix(predicate, "in_context") {
+			return "", fmt.Errorf("invalid artifact query %q: malformed predicate %q", query, predicate)
+		}
+		if match := keyValuePredicate.FindStringSubmatch(predicate); match != nil {
+			if attribute, ok := artifactQueryKeys[match[1]]; ok {
+				predicates = append(predicates, fmt.Sprintf("%s %s %s", attribute, match[2], strings.TrimSpace(match[3])))
+				continue
+			}
+		}
+		predicates = append(predicates, predicate)
+	}
+	if !hasContext {
+		predicates = append(predicates, fmt.Sprintf("contexts_pipeline.id = %v", pipelineCtxID))
+	}
+	return strings.Join(predicates, " AND "), nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import "testing"
+
+func Test_artifactQueryToFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "empty query is scoped to pipeline context",
+			query: "",
+			want:  "contexts_pipeline.id = 5",
+		},
+		{
+			name:  "artifact type and state",
+			query: `artifact_type="system.Model" AND state=LIVE`,
+			want:  `type = "system.Model" AND state = LIVE AND contexts_pipeline.id = 5`,
+		},
+		{
+			name:  "uri and name",
+			query: `uri="gs://bucket/model" AND name = "my-model"`,
+			want:  `uri = "gs://bucket/model" AND name = "my-model" AND contexts_pipeline.id = 5`,
+		},
+		{
+			name:  "in context replaces pipeline scope",
+			query: `in_context("training-pipeline") AND artifact_type="system.Model"`,
+			want:  `contexts_c0.name = "training-pipeline" AND type = "system.Model"`,
+		},
+		{
+			name:  "MLMD filter syntax is passed through",
+			query: `artifact_type="system.Model" AND custom_properties.blessed.string_value="true"`,
+			want:  `type = "system.Model" AND custom_properties.blessed.string_value="true" AND contexts_pipeline.id = 5`,
+		},
+		{
+			name:    "malformed in context",
+			query:   `in_context(training-pipeline)`,
+			wantErr: true,
+		},
+		{
+			name:    "empty predicate",
+			query:   `state=LIVE AND  AND uri="gs://a"`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := artifactQueryToFilter(tt.query, 5)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("artifactQueryToFilter(%q) error = %v, wantErr %v", tt.query, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("artifactQueryToFilter(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
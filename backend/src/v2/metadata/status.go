@@ -0,0 +1,144 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+)
+
+// Error message of a task whose execution never reached a final state, e.g.
+// because its Pod was killed before the launcher could publish the execution.
+const errorMessageNotCompleted = "task execution did not complete"
+
+// ErrorMessage returns the error message recorded for a failed execution.
+func (e *Execution) ErrorMessage() string {
+	if e == nil {
+		return ""
+	}
+	return e.execution.GetCustomProperties()[keyErrorMessage].GetStringValue()
+}
+
+// PublishExecutionFailure marks the execution as failed, and records the
+// error that caused the failure.
+func (c *Client) PublishExecutionFailure(ctx context.Context, execution *Execution, executionErr error) error {
+	e := execution.execution
+	if e.CustomProperties == nil {
+		e.CustomProperties = make(map[string]*pb.Value)
+	}
+	if executionErr != nil {
+		e.CustomProperties[keyErrorMessage] = stringValue(executionErr.Error())
+	}
+	return c.PublishExecution(ctx, execution, nil, nil, pb.Execution_FAILED)
+}
+
+// GetTaskFinalState returns the final state of a task that has finished
+// running, together with an error message when the task failed.
+// DAG executions are not published when they finish, so the state of a DAG
+// task is aggregated from the tasks in the DAG.
+func (c *Client) GetTaskFinalState(ctx context.Context, execution *Execution, pipeline *Pipeline) (state pipelinespec.PipelineStateEnum_PipelineTaskState, errorMessage string, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("failed to get final state of task %q: %w", execution.TaskName(), err)
+		}
+	}()
+	state, errorMessage, final := finalStateOf(execution.GetExecution())
+	if final {
+		return state, errorMessage, nil
+	}
+	dagTypeID, err := c.getExecutionTypeID(ctx, dagExecutionType)
+	if err != nil {
+		return state, "", err
+	}
+	if execution.GetExecution().GetTypeId() != dagTypeID {
+		return pipelinespec.PipelineStateEnum_FAILED, errorMessageNotCompleted, nil
+	}
+	executions, err := c.getExecutionsInDAG(ctx, &DAG{Execution: execution}, pipeline)
+	if err != nil {
+		return state, "", err
+	}
+	// Iterations of a ParallelFor DAG share the same task name.
+	for _, task := range latestAttempts(executions) {
+		taskState, taskErrorMessage, err := c.GetTaskFinalState(ctx, task, pipeline)
+		if err != nil {
+			return state, "", err
+		}
+		if taskState == pipelinespec.PipelineStateEnum_FAILED {
+			return taskState, fmt.Sprintf("task %q failed: %s", task.TaskName(), taskErrorMessage), nil
+		}
+	}
+	return pipelinespec.PipelineStateEnum_SUCCEEDED, "", nil
+}
+
+// latestAttempts returns the execution of the latest attempt of each task and
+// iteration, sorted by task name and iteration index, so that results derived
+// from the order are stable.
+func latestAttempts(executions []*Execution) []*Execution {
+	type taskKey struct {
+		name           string
+		iterationIndex int64
+	}
+	keyOf := func(e *Execution) taskKey {
+		index := int64(-1)
+		if value, ok := e.GetExecution().GetCustomProperties()[keyIterationIndex]; ok {
+			index = value.GetIntValue()
+		}
+		return taskKey{name: e.TaskName(), iterationIndex: index}
+	}
+	latest := make(map[taskKey]*Execution)
+	for _, e := range executions {
+		key := keyOf(e)
+		if existing, ok := latest[key]; ok && !isLaterAttempt(e, existing) {
+			continue
+		}
+		latest[key] = e
+	}
+	tasks := make([]*Execution, 0, len(latest))
+	for _, e := range latest {
+		tasks = append(tasks, e)
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		a, b := keyOf(tasks[i]), keyOf(tasks[j])
+		if a.name != b.name {
+			return a.name < b.name
+		}
+		return a.iterationIndex < b.iterationIndex
+	})
+	return tasks
+}
+
+// finalStateOf maps the state of an MLMD execution to a pipeline task state.
+// final is false when the execution has not been published with a final state.
+func finalStateOf(execution *pb.Execution) (state pipelinespec.PipelineStateEnum_PipelineTaskState, errorMessage string, final bool) {
+	switch execution.GetLastKnownState() {
+	case pb.Execution_COMPLETE, pb.Execution_CACHED:
+		return pipelinespec.PipelineStateEnum_SUCCEEDED, "", true
+	case pb.Execution_CANCELED:
+		// Executions are created as canceled when their trigger condition is false.
+		return pipelinespec.PipelineStateEnum_SKIPPED, "", true
+	case pb.Execution_FAILED:
+		errorMessage = execution.GetCustomProperties()[keyErrorMessage].GetStringValue()
+		if errorMessage == "" {
+			errorMessage = "task execution failed"
+		}
+		return pipelinespec.PipelineStateEnum_FAILED, errorMessage, true
+	default:
+		return pipelinespec.PipelineStateEnum_TASK_STATE_UNSPECIFIED, "", false
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+)
+
+func Test_finalStateOf(t *testing.T) {
+	tests := []struct {
+		name             string
+		execution        *pb.Execution
+		wantState        pipelinespec.PipelineStateEnum_PipelineTaskState
+		wantErrorMessage string
+		wantFinal        bool
+	}{
+		{
+			name:      "complete",
+			execution: &pb.Execution{LastKnownState: pb.Execution_COMPLETE.Enum()},
+			wantState: pipelinespec.PipelineStateEnum_SUCCEEDED,
+			wantFinal: true,
+		},
+		{
+			name:      "cached",
+			execution: &pb.Execution{LastKnownState: pb.Execution_CACHED.Enum()},
+			wantState: pipelinespec.PipelineStateEnum_SUCCEEDED,
+			wantFinal: true,
+		},
+		{
+			name:      "not triggered",
+			execution: &pb.Execution{LastKnownState: pb.Execution_CANCELED.Enum()},
+			wantState: pipelinespec.PipelineStateEnum_SKIPPED,
+			wantFinal: true,
+		},
+		{
+			name: "failed with error message",
+			execution: &pb.Execution{
+				LastKnownState: pb.Execution_FAILED.Enum(),
+				CustomProperties: map[string]*pb.Value{
+					keyErrorMessage: stringValue("exit status 1"),
+				},
+			},
+			wantState:        pipelinespec.PipelineStateEnum_FAILED,
+			wantErrorMessage: "exit status 1",
+			wantFinal:        true,
+		},
+		{
+			name:             "failed without error message",
+			execution:        &pb.Execution{LastKnownState: pb.Execution_FAILED.Enum()},
+			wantState:        pipelinespec.PipelineStateEnum_FAILED,
+			wantErrorMessage: "task execution failed",
+			wantFinal:        true,
+		},
+		{
+			name:      "running",
+			execution: &pb.Execution{LastKnownState: pb.Execution_RUNNING.Enum()},
+			wantState: pipelinespec.PipelineStateEnum_TASK_STATE_UNSPECIFIED,
+			wantFinal: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, errorMessage, final := finalStateOf(tt.execution)
+			if state != tt.wantState || errorMessage != tt.wantErrorMessage || final != tt.wantFinal {
+				t.Errorf("finalStateOf() = (%v, %q, %v), want (%v, %q, %v)", state, errorMessage, final, tt.wantState, tt.wantErrorMessage, tt.wantFinal)
+			}
+		})
+	}
+}
+
+func Test_latestAttempts(t *testing.T) {
+	execution := func(id int64, taskName string, iterationIndex int64) *Execution {
+		e := &pb.Execution{
+			Id:               &id,
+			CustomProperties: map[string]*pb.Value{keyTaskName: stringValue(taskName)},
+		}
+		if iterationIndex >= 0 {
+			e.CustomProperties[keyIterationIndex] = intValue(iterationIndex)
+		}
+		return &Execution{execution: e}
+	}
+	executions := []*Execution{
+		execution(5, "train", -1),
+		execution(1, "preprocess", -1),
+		execution(2, "train", -1),
+		execution(3, "for-loop", 1),
+		execution(4, "for-loop", 0),
+		execution(6, "for-loop", 1),
+	}
+	var got []int64
+	for _, e := range latestAttempts(executions) {
+		got = append(got, e.GetID())
+	}
+	want := []int64{4, 6, 1, 5}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("latestAttempts() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -57,6 +57,10 @@ require (
 )
 
 replace (
+	// The backend is built with the api module of this repository, since it
+	// uses pipeline spec fields, such as the task retry policy, that aren't in
+	// a published version of the api module yet.
+	github.com/kubeflow/pipelines/api => ./api
 	k8s.io/kubernetes => k8s.io/kubernetes v1.11.1
 	sigs.k8s.io/controller-tools => sigs.k8s.io/controller-tools v0.2.9
 )
@@ -1282,6 +1282,34 @@ class TestSetRetryCompilation(unittest.TestCase):
         self.assertEqual(retry_policy.backoff_max_duration.seconds, 3600)
 
 
+class TestSetCachingOptionsCompilation(unittest.TestCase):
+
+    def test_set_caching_options(self):
+
+        @dsl.component
+        def hello_world(text: str) -> str:
+            """Hello world component."""
+            return text
+
+        @dsl.pipeline(name='hello-world', description='A simple intro pipeline')
+        def pipeline_hello_world(text: str = 'hi there'):
+            """Hello world pipeline."""
+
+            hello_world(text=text).set_caching_options(
+                enable_caching=True, max_cache_staleness='P30D')
+
+        with tempfile.TemporaryDirectory() as tempdir:
+            package_path = os.path.join(tempdir, 'pipeline.yaml')
+            compiler.Compiler().compile(
+                pipeline_func=pipeline_hello_world, package_path=package_path)
+            pipeline_spec = pipeline_spec_from_file(package_path)
+
+        caching_options = pipeline_spec.root.dag.tasks[
+            'hello-world'].caching_options
+        self.assertTrue(caching_options.enable_cache)
+        self.assertEqual(caching_options.max_cache_staleness, 'P30D')
+
+
 from google.protobuf import json_format
 
 
@@ -131,6 +131,9 @@ def build_task_spec_for_task(
         utils.sanitize_component_name(task.name))
     pipeline_task_spec.caching_options.enable_cache = (
         task._task_spec.enable_caching)
+    if task._task_spec.max_cache_staleness is not None:
+        pipeline_task_spec.caching_options.max_cache_staleness = (
+            task._task_spec.max_cache_staleness)
 
     if task._task_spec.retry_policy is not None:
         pipeline_task_spec.retry_policy.CopyFrom(
@@ -344,16 +344,27 @@ class PipelineTask:
 
         return resolved_container_spec
 
-    def set_caching_options(self, enable_caching: bool) -> 'PipelineTask':
+    def set_caching_options(
+            self,
+            enable_caching: bool,
+            max_cache_staleness: Optional[str] = None) -> 'PipelineTask':
         """Sets caching options for the task.
 
         Args:
             enable_caching: Whether to enable caching.
+            max_cache_staleness: The maximum age of a cached execution that can be reused by the task, as an ISO 8601 duration, e.g. ``'P30D'``. ``'P0D'`` disables reusing cached executions. If not specified, the default cache staleness of the deployment is used.
 
         Returns:
             Self return to allow chained setting calls.
         """
+        if max_cache_staleness is not None and re.match(
+                r'^P(?!$)(\d+Y)?(\d+M)?(\d+W)?(\d+D)?(T(?=\d)(\d+H)?(\d+M)?(\d+S)?)?$',
+                max_cache_staleness) is None:
+            raise ValueError(
+                'Invalid max_cache_staleness string. Should be an ISO 8601'
+                f' duration, e.g. P30D or PT12H. Got: {max_cache_staleness}')
         self._task_spec.enable_caching = enable_caching
+        self._task_spec.max_cache_staleness = max_cache_staleness
         return self
 
     def set_cpu_limit(self, cpu: str) -> 'PipelineTask':
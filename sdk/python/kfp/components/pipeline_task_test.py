@@ -152,6 +152,33 @@ class PipelineTaskTest(parameterized.TestCase):
         )
         task.set_caching_options(False)
         self.assertEqual(False, task._task_spec.enable_caching)
+        self.assertIsNone(task._task_spec.max_cache_staleness)
+
+    @parameterized.parameters('P30D', 'PT12H', 'P1DT12H', 'P0D')
+    def test_set_caching_options_with_valid_max_cache_staleness(
+            self, max_cache_staleness: str):
+        task = pipeline_task.PipelineTask(
+            component_spec=structures.ComponentSpec.load_from_component_yaml(
+                V2_YAML),
+            args={'input1': 'value'},
+        )
+        task.set_caching_options(True, max_cache_staleness=max_cache_staleness)
+        self.assertEqual(True, task._task_spec.enable_caching)
+        self.assertEqual(max_cache_staleness,
+                         task._task_spec.max_cache_staleness)
+
+    @parameterized.parameters('30D', 'P', 'PT', 'P1H', '30 days')
+    def test_set_caching_options_with_invalid_max_cache_staleness(
+            self, max_cache_staleness: str):
+        task = pipeline_task.PipelineTask(
+            component_spec=structures.ComponentSpec.load_from_component_yaml(
+                V2_YAML),
+            args={'input1': 'value'},
+        )
+        with self.assertRaisesRegex(ValueError,
+                                    'Invalid max_cache_staleness string'):
+            task.set_caching_options(
+                True, max_cache_staleness=max_cache_staleness)
 
     @parameterized.parameters(
         {
@@ -394,6 +394,9 @@ class TaskSpec(base_model.BaseModel):
             from the [items][] collection.
         enable_caching (optional): whether or not to enable caching for the task.
             Default is True.
+        max_cache_staleness (optional): the maximum age of a cached execution
+            that can be reused by the task, as an ISO 8601 duration, e.g. P30D.
+            If not specified, the default of the deployment is used.
         display_name (optional): the display name of the task. If not specified,
             the task name will be used as the display name.
     """
@@ -406,6 +409,7 @@ class TaskSpec(base_model.BaseModel):
     iterator_items: Optional[Any] = None
     iterator_item_input: Optional[str] = None
     enable_caching: bool = True
+    max_cache_staleness: Optional[str] = None
     display_name: Optional[str] = None
     retry_policy: Optional[RetryPolicy] = None
 